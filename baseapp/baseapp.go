@@ -3,8 +3,10 @@ package baseapp
 import (
 	"fmt"
 	"io"
-	"runtime/debug"
+	"os"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
@@ -45,6 +47,7 @@ type BaseApp struct {
 	name        string               // application name from abci.Info
 	db          dbm.DB               // common DB backend
 	cms         sdk.CommitMultiStore // Main (uncached) state
+	storeLoader StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
 	router      Router               // handle any kind of message
 	queryRouter QueryRouter          // router for redirecting query calls
 	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
@@ -54,6 +57,7 @@ type BaseApp struct {
 
 	// may be nil
 	anteHandler      sdk.AnteHandler  // ante handler for fee and auth
+	postHandler      sdk.PostHandler  // post handler run after the messages, e.g. for gas refunds
 	initChainer      sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker     sdk.BeginBlocker // logic to run before any txs
 	endBlocker       sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -61,6 +65,31 @@ type BaseApp struct {
 	pubkeyPeerFilter sdk.PeerFilter   // filter peers by public key
 	fauxMerkleMode   bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// custom handlers of the panics recovered in runTx
+	runTxRecoveryHandlers []RecoveryHandler
+
+	// handlers of the queries under custom top-level paths
+	queryPathHandlers map[string]QueryPathHandler
+
+	// trace of the KV mutations committed by each block, may be nil
+	writeAheadTrace *writeAheadTrace
+
+	// metrics of the processing of the blocks and transactions
+	metrics *Metrics
+
+	// profiles of the gas consumed by the messages of the last blocks, may be
+	// nil
+	gasProfiler *gasProfiler
+
+	// tracer of the lifecycle of the transactions, may be nil
+	txTracer TxTracer
+
+	// observers of the operations on the stores of the CommitMultiStore
+	storeObservers storeObservers
+
+	// statistics of the operations on the stores, may be nil
+	storeStats *storeStatsObserver
+
 	//--------------------
 	// Volatile
 	// checkState is set on initialization and reset on Commit.
@@ -70,6 +99,9 @@ type BaseApp struct {
 	deliverState *state          // for DeliverTx
 	voteInfos    []abci.VoteInfo // absent validators from begin block
 
+	// start of the processing of the current block, set in BeginBlock
+	blockStartTime time.Time
+
 	// consensus params
 	// TODO move this in the future to baseapp param store on main store.
 	consensusParams *abci.ConsensusParams
@@ -77,6 +109,10 @@ type BaseApp struct {
 	// spam prevention
 	minimumFees sdk.Coins
 
+	// block height and unix time at which to halt the node, before committing
+	haltHeight uint64
+	haltTime   uint64
+
 	// flag for sealing
 	sealed bool
 }
@@ -94,11 +130,14 @@ func NewBaseApp(name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecod
 		name:           name,
 		db:             db,
 		cms:            store.NewCommitMultiStore(db),
+		storeLoader:    DefaultStoreLoader,
 		router:         NewRouter(),
 		queryRouter:    NewQueryRouter(),
 		txDecoder:      txDecoder,
 		fauxMerkleMode: false,
+		metrics:        NopMetrics(),
 	}
+	app.queryRouter.AddRoute(MetricsQueryRoute, app.queryMetrics)
 	for _, option := range options {
 		option(app)
 	}
@@ -146,10 +185,20 @@ func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
 }
 
+// StoreLoader loads the latest version of the CommitMultiStore. It can be
+// overridden to migrate the stores of the application, e.g. to add and delete
+// stores at the height of a planned upgrade.
+type StoreLoader func(ms sdk.CommitMultiStore) error
+
+// DefaultStoreLoader loads the latest version of the multistore
+func DefaultStoreLoader(ms sdk.CommitMultiStore) error {
+	return ms.LoadLatestVersion()
+}
+
 // load latest application version
 // panics if called more than once on a running baseapp
 func (app *BaseApp) LoadLatestVersion(mainKey *sdk.KVStoreKey) error {
-	err := app.cms.LoadLatestVersion()
+	err := app.storeLoader(app.cms)
 	if err != nil {
 		return err
 	}
@@ -215,6 +264,34 @@ func (app *BaseApp) initFromMainStore(mainKey *sdk.KVStoreKey) error {
 
 func (app *BaseApp) setMinimumFees(fees sdk.Coins) { app.minimumFees = fees }
 
+func (app *BaseApp) setHaltHeight(haltHeight uint64) { app.haltHeight = haltHeight }
+
+func (app *BaseApp) setHaltTime(haltTime uint64) { app.haltTime = haltTime }
+
+// setMetrics sets the metrics of the app, observing the operations on the
+// stores of the CommitMultiStore
+func (app *BaseApp) setMetrics(metrics *Metrics) {
+	app.metrics = metrics
+	app.addStoreObserver(metrics)
+}
+
+// setStoreStats enables the statistics of the operations on the stores
+func (app *BaseApp) setStoreStats() {
+	app.storeStats = newStoreStatsObserver()
+	app.addStoreObserver(app.storeStats)
+}
+
+// addStoreObserver adds an observer of the operations on the stores of the
+// CommitMultiStore
+func (app *BaseApp) addStoreObserver(observer sdk.StoreObserver) {
+	app.storeObservers = append(app.storeObservers, observer)
+	if len(app.storeObservers) == 1 {
+		app.cms.SetStoreObserver(observer)
+		return
+	}
+	app.cms.SetStoreObserver(app.storeObservers)
+}
+
 // NewContext returns a new Context with the correct store, the given header, and nil txBytes.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
@@ -223,6 +300,18 @@ func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	return sdk.NewContext(app.deliverState.ms, header, false, app.Logger)
 }
 
+// NewContextAtHeight returns a new check Context on the state committed at the
+// given height, cache wrapping the commit-multistore. The state of a previous
+// height is read from the stores at its version, so it fails if the height is
+// no longer stored, e.g. pruned.
+func (app *BaseApp) NewContextAtHeight(height int64) (sdk.Context, error) {
+	cms, err := app.cacheMultiStoreAtHeight(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cms, abci.Header{Height: height}, true, app.Logger), nil
+}
+
 type state struct {
 	ms  sdk.CacheMultiStore
 	ctx sdk.Context
@@ -318,7 +407,15 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 	app.deliverState.ctx = app.deliverState.ctx.
 		WithBlockGasMeter(sdk.NewInfiniteGasMeter())
 
-	res = app.initChainer(app.deliverState.ctx, req)
+	if app.writeAheadTrace == nil {
+		res = app.initChainer(app.deliverState.ctx, req)
+		return
+	}
+
+	app.writeAheadTrace.mark("init_chain", "chain_id", req.ChainId)
+	initCtx, msCache := app.writeAheadTrace.cacheContext(app.deliverState.ctx)
+	res = app.initChainer(initCtx, req)
+	msCache.Write()
 
 	// NOTE: we don't commit, but BeginBlock for block 1
 	// starts from this deliverState
@@ -371,6 +468,15 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return handleQueryCustom(app, path, req)
 	}
 
+	// custom top-level paths registered by the application
+	if handler, ok := app.queryPathHandlers[path[0]]; ok {
+		ctx, err := app.queryContext(req.Height)
+		if err != nil {
+			return err.QueryResult()
+		}
+		return handler(ctx, path[1:], req)
+	}
+
 	msg := "unknown query path"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
@@ -393,6 +499,12 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Codespace: string(sdk.CodespaceRoot),
 				Value:     []byte(version.GetVersion()),
 			}
+		case "codes":
+			return abci.ResponseQuery{
+				Code:      uint32(sdk.CodeOK),
+				Codespace: string(sdk.CodespaceRoot),
+				Value:     codec.Cdc.MustMarshalJSON(sdk.RegisteredCodes()),
+			}
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -405,7 +517,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			Value:     value,
 		}
 	}
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be either simulate, version or codes, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -454,12 +566,12 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
 	}
 
-	// Cache wrap the commit-multistore for safety.
-	ctx := sdk.NewContext(app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.Logger).
-		WithMinimumFees(app.minimumFees)
-
 	// Passes the rest of the path as an argument to the querier.
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets []string{"proposal", "test"} as the path
+	ctx, err := app.queryContext(req.Height)
+	if err != nil {
+		return err.QueryResult()
+	}
 	resBytes, err := querier(ctx, path[2:], req)
 	if err != nil {
 		return abci.ResponseQuery{
@@ -469,13 +581,54 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		}
 	}
 	return abci.ResponseQuery{
-		Code:  uint32(sdk.CodeOK),
-		Value: resBytes,
+		Code:   uint32(sdk.CodeOK),
+		Value:  resBytes,
+		Height: ctx.BlockHeight(),
+	}
+}
+
+// queryContext returns the context of the queries at the given height, or at
+// the latest height if zero, cache wrapping the commit-multistore for safety.
+// The state of a previous height is read from the stores at its version, if
+// it's still stored.
+func (app *BaseApp) queryContext(height int64) (sdk.Context, sdk.Error) {
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	cms, err := app.cacheMultiStoreAtHeight(height)
+	if err != nil {
+		return sdk.Context{}, sdk.ErrUnknownRequest(fmt.Sprintf("cannot query: %v", err))
+	}
+	ctx := sdk.NewContext(cms, app.checkState.ctx.BlockHeader(), true, app.Logger).
+		WithMinimumFees(app.minimumFees)
+	return ctx.WithBlockHeight(height), nil
+}
+
+// cacheMultiStoreAtHeight cache wraps the commit-multistore at the given
+// height, reading the stores at its version if it's not the latest height.
+func (app *BaseApp) cacheMultiStoreAtHeight(height int64) (sdk.CacheMultiStore, error) {
+	latest := app.LastBlockHeight()
+	if height == latest {
+		return app.cms.CacheMultiStore(), nil
+	}
+	if height < 1 || height > latest {
+		return nil, fmt.Errorf("invalid height %d, the latest height is %d", height, latest)
 	}
+
+	cms, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("height %d is no longer stored: %v", height, err)
+	}
+	return cms, nil
 }
 
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
+	app.blockStartTime = time.Now()
+	if app.gasProfiler != nil {
+		app.gasProfiler.beginBlock()
+	}
+
 	if app.cms.TracingEnabled() {
 		app.cms.ResetTraceContext()
 		app.cms.WithTracingContext(sdk.TraceContext(
@@ -506,8 +659,19 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(gasMeter)
 
+	// the events emitted in BeginBlock are returned as tags
+	ctx := app.deliverState.ctx.WithEventManager(sdk.NewEventManager())
+	var msCache sdk.CacheMultiStore
+	if app.writeAheadTrace != nil {
+		app.writeAheadTrace.mark("begin_block", "height", req.Header.Height)
+		ctx, msCache = app.writeAheadTrace.cacheContext(ctx)
+	}
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		res = app.beginBlocker(ctx, req)
+	}
+	res.Tags = append(res.Tags, ctx.EventManager().Events().ToTags()...)
+	if msCache != nil {
+		msCache.Write()
 	}
 
 	// set the signed validators for addition to context in deliverTx
@@ -533,8 +697,10 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 
 	return abci.ResponseCheckTx{
 		Code:      uint32(result.Code),
+		Codespace: string(result.Codespace),
 		Data:      result.Data,
 		Log:       result.Log,
+		Info:      codeInfo(result),
 		GasWanted: int64(result.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
 		Tags:      result.Tags,
@@ -552,6 +718,8 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 	} else {
 		result = app.runTx(runTxModeDeliver, txBytes, tx)
 	}
+	app.metrics.Txs.Add(1)
+	app.metrics.TxGasUsed.Observe(float64(result.GasUsed))
 
 	// Even though the Result.Code is not OK, there are still effects,
 	// namely fee deductions and sequence incrementing.
@@ -562,10 +730,21 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 		Codespace: string(result.Codespace),
 		Data:      result.Data,
 		Log:       result.Log,
+		Info:      codeInfo(result),
 		GasWanted: int64(result.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
-		Tags:      result.Tags,
+		Tags:      result.Tags.AppendTags(result.Events.ToTags()),
+	}
+}
+
+// codeInfo returns the registered description of the error code of a failed
+// result, if any.
+func codeInfo(result sdk.Result) string {
+	if result.IsOK() {
+		return ""
 	}
+	description, _ := sdk.CodeDescription(result.Codespace, result.Code)
+	return description
 }
 
 // Basic validator for msgs
@@ -592,6 +771,9 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Con
 		WithTxBytes(txBytes).
 		WithVoteInfos(app.voteInfos).
 		WithConsensusParams(app.consensusParams)
+	if len(txBytes) != 0 {
+		ctx = ctx.WithTxID(fmt.Sprintf("%X", tmhash.Sum(txBytes)))
+	}
 	if mode == runTxModeSimulate {
 		ctx, _ = ctx.CacheContext()
 	}
@@ -601,9 +783,10 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Con
 // Iterates through msgs and executes them
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (result sdk.Result) {
 	// accumulate results
-	logs := make([]string, 0, len(msgs))
+	logs := make(sdk.ABCIMessageLogs, 0, len(msgs))
 	var data []byte   // NOTE: we just append them all (?!)
 	var tags sdk.Tags // also just append them all
+	var events sdk.Events
 	var code sdk.CodeType
 	var codespace sdk.CodespaceType
 	for msgIdx, msg := range msgs {
@@ -614,10 +797,41 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msgRoute).Result()
 		}
 
+		// each message gets its own event manager
+		msgCtx := ctx.WithEventManager(sdk.NewEventManager())
+
+		// each message gets its own cache when tracing the writes ahead
+		var msgCache sdk.CacheMultiStore
+		if mode == runTxModeDeliver && app.writeAheadTrace != nil {
+			app.writeAheadTrace.mark("msg", "index", msgIdx, "route", msgRoute)
+			msgCtx, msgCache = app.writeAheadTrace.cacheContext(msgCtx)
+		}
+
 		var msgResult sdk.Result
+		msgStart := time.Now()
+		startingGas := msgCtx.GasMeter().GasConsumed()
 		// Skip actual execution for CheckTx
 		if mode != runTxModeCheck {
-			msgResult = handler(ctx, msg)
+			msgResult = handler(msgCtx, msg)
+		}
+		msgGas := msgCtx.GasMeter().GasConsumed() - startingGas
+		if mode != runTxModeCheck && app.txTracer != nil {
+			app.txTracer.TraceTxSpan(TxTraceSpan{
+				Step: TxTraceStepMsg, Height: ctx.BlockHeight(), TxHash: ctx.TxID(),
+				MsgIndex: msgIdx, Route: msgRoute, Type: msg.Type(),
+				Start: msgStart, Duration: time.Since(msgStart),
+				GasUsed: msgGas, Code: uint32(msgResult.Code),
+			})
+		}
+		if mode == runTxModeDeliver {
+			app.metrics.Msgs.With("route", msgRoute, "type", msg.Type()).Add(1)
+			app.metrics.MsgGasUsed.With("route", msgRoute, "type", msg.Type()).Observe(float64(msgGas))
+			if app.gasProfiler != nil {
+				app.gasProfiler.addMsg(msgRoute, msg.Type(), msgGas)
+			}
+		}
+		if msgCache != nil && msgResult.IsOK() {
+			msgCache.Write()
 		}
 
 		// NOTE: GasWanted is determined by ante handler and
@@ -628,16 +842,28 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		tags = append(tags, sdk.MakeTag(sdk.TagAction, []byte(msg.Type())))
 		tags = append(tags, msgResult.Tags...)
 
+		// Append the events of the message, preceded by the message event
+		events = events.AppendEvent(sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, msgRoute),
+		))
+		events = events.AppendEvents(msgCtx.EventManager().Events())
+
+		// Construct usable logs in multi-message transactions.
+		logs = append(logs, sdk.ABCIMessageLog{
+			MsgIndex: msgIdx,
+			Success:  msgResult.IsOK(),
+			Log:      msgResult.Log,
+			Data:     msgResult.Data,
+			Events:   msgCtx.EventManager().Events(),
+		})
+
 		// Stop execution and return on first failed message.
 		if !msgResult.IsOK() {
-			logs = append(logs, fmt.Sprintf("Msg %d failed: %s", msgIdx, msgResult.Log))
 			code = msgResult.Code
 			codespace = msgResult.Codespace
 			break
 		}
-
-		// Construct usable logs in multi-message transactions.
-		logs = append(logs, fmt.Sprintf("Msg %d: %s", msgIdx, msgResult.Log))
 	}
 
 	// Set the final gas values.
@@ -645,10 +871,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		Code:      code,
 		Codespace: codespace,
 		Data:      data,
-		Log:       strings.Join(logs, "\n"),
+		Log:       logs.String(),
 		GasUsed:   ctx.GasMeter().GasConsumed(),
 		// TODO: FeeAmount/FeeDenom
-		Tags: tags,
+		Tags:   tags,
+		Events: events,
 	}
 
 	return result
@@ -694,13 +921,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
+	start := time.Now()
 
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
-		result = sdk.ErrOutOfGas("no block gas left to run tx").Result()
+		result = sdk.ErrOutOfBlockGas("no block gas left to run tx").Result()
 		return
 	}
 
@@ -709,33 +937,45 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		startingGas = ctx.BlockGasMeter().GasConsumed()
 	}
 
+	// The messages of a failed transaction are not persisted, so their writes
+	// are rolled back from the write-ahead trace, from the savepoint if set.
+	var wat *writeAheadTrace
+	savepoint := -1
+	if mode == runTxModeDeliver && app.writeAheadTrace != nil {
+		wat = app.writeAheadTrace
+		wat.mark("tx", "hash", fmt.Sprintf("%X", tmhash.Sum(txBytes)))
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			case sdk.ErrorOutOfGas:
-				log := fmt.Sprintf("out of gas in location: %v", rType.Descriptor)
-				result = sdk.ErrOutOfGas(log).Result()
-			default:
-				log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
-				result = sdk.ErrInternal(log).Result()
+			result = app.runTxRecovery(r)
+			if savepoint >= 0 {
+				wat.rollback(savepoint)
 			}
 		}
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+
+		if app.txTracer != nil {
+			app.txTracer.TraceTxSpan(TxTraceSpan{
+				Step: txTraceStep(mode), Height: ctx.BlockHeight(), TxHash: ctx.TxID(),
+				Start: start, Duration: time.Since(start),
+				GasUsed: result.GasUsed, GasWanted: result.GasWanted, Code: uint32(result.Code),
+			})
+		}
 	}()
 
-	// If BlockGasMeter() panics it will be caught by the above recover and
-	// return an error - in any case BlockGasMeter will consume gas past
-	// the limit.
+	// The BlockGasMeter consumes gas past the limit if the transaction exceeds
+	// the maximum block gas, in which case it fails and the next transactions
+	// of the block are rejected.
 	// NOTE: this must exist in a separate defer function for the
 	//       above recovery to recover from this one
 	defer func() {
 		if mode == runTxModeDeliver {
-			ctx.BlockGasMeter().ConsumeGas(
-				ctx.GasMeter().GasConsumedToLimit(),
-				"block gas meter",
-			)
+			if !consumeBlockGas(ctx) {
+				result = sdk.ErrOutOfBlockGas("tx exceeds the block gas limit").Result()
+			}
 
 			if ctx.BlockGasMeter().GasConsumed() < startingGas {
 				panic(sdk.ErrorGasOverflow{"tx gas summation"})
@@ -760,8 +1000,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		// NOTE: Alternatively, we could require that anteHandler ensures that
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
-		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
+		if mode == runTxModeDeliver && app.writeAheadTrace != nil {
+			app.writeAheadTrace.mark("ante")
+			anteCtx, msCache = app.writeAheadTrace.cacheContext(ctx)
+		} else {
+			anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
+		}
 
+		anteStart := time.Now()
 		newCtx, result, abort := app.anteHandler(anteCtx, tx, (mode == runTxModeSimulate))
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is cache-wrapped, or something else
@@ -773,6 +1019,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 			// prior to returning.
 			ctx = newCtx.WithMultiStore(ms)
 		}
+		if app.txTracer != nil {
+			app.txTracer.TraceTxSpan(TxTraceSpan{
+				Step: TxTraceStepAnte, Height: ctx.BlockHeight(), TxHash: ctx.TxID(),
+				Start: anteStart, Duration: time.Since(anteStart),
+				GasUsed: ctx.GasMeter().GasConsumed(), GasWanted: result.GasWanted, Code: uint32(result.Code),
+			})
+		}
 
 		if abort {
 			return result
@@ -789,6 +1042,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
+	if wat != nil {
+		savepoint = wat.savepoint()
+	}
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
 
@@ -796,22 +1052,101 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return
 	}
 
-	// only update state if all messages pass
-	if result.IsOK() {
+	// only update state if all messages pass and the block gas limit is not
+	// exceeded, the block gas being consumed above
+	if result.IsOK() && !exceedsBlockGas(ctx) {
 		msCache.Write()
+	} else if wat != nil {
+		wat.rollback(savepoint)
+	}
+	savepoint = -1
+
+	// The post handler is run on the persisted state with an infinite gas
+	// meter, so as not to change the gas used by the transaction. Its state
+	// changes are cache wrapped in their own branch, discarded if it aborts,
+	// and it never changes the result of the messages.
+	if app.postHandler != nil {
+		postCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		var writePost func()
+		if wat != nil {
+			wat.mark("post")
+			savepoint = wat.savepoint()
+			var postCache sdk.CacheMultiStore
+			postCtx, postCache = wat.cacheContext(postCtx)
+			writePost = postCache.Write
+		} else {
+			postCtx, writePost = postCtx.CacheContext()
+		}
+
+		postStart := time.Now()
+		abort := app.postHandler(postCtx, tx, result)
+		if app.txTracer != nil {
+			app.txTracer.TraceTxSpan(TxTraceSpan{
+				Step: TxTraceStepPost, Height: ctx.BlockHeight(), TxHash: ctx.TxID(),
+				Start: postStart, Duration: time.Since(postStart),
+				GasUsed: postCtx.GasMeter().GasConsumed(), Code: uint32(result.Code),
+			})
+		}
+
+		if !abort {
+			writePost()
+		} else if wat != nil {
+			wat.rollback(savepoint)
+		}
+		savepoint = -1
 	}
 
 	return
 }
 
+// consumeBlockGas consumes the gas used by a transaction from the block gas
+// meter. It returns false if the maximum block gas is exceeded.
+func consumeBlockGas(ctx sdk.Context) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			ok = false
+		}
+	}()
+
+	ctx.BlockGasMeter().ConsumeGas(ctx.GasMeter().GasConsumedToLimit(), "block gas meter")
+	return true
+}
+
+// exceedsBlockGas returns true if the gas used by a transaction exceeds the
+// gas remaining in the block.
+func exceedsBlockGas(ctx sdk.Context) bool {
+	maxGas := ctx.BlockGasMeter().Limit()
+	if maxGas == 0 {
+		// infinite block gas meter
+		return false
+	}
+
+	blockGas, overflow := sdk.AddUint64Overflow(ctx.BlockGasMeter().GasConsumed(), ctx.GasMeter().GasConsumedToLimit())
+	return overflow || blockGas > maxGas
+}
+
 // EndBlock implements the ABCI application interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.ResetTraceContext().(sdk.CacheMultiStore)
 	}
 
+	// the events emitted in EndBlock are returned as tags
+	ctx := app.deliverState.ctx.WithEventManager(sdk.NewEventManager())
+	var msCache sdk.CacheMultiStore
+	if app.writeAheadTrace != nil {
+		app.writeAheadTrace.mark("end_block", "height", req.Height)
+		ctx, msCache = app.writeAheadTrace.cacheContext(ctx)
+	}
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		res = app.endBlocker(ctx, req)
+	}
+	res.Tags = append(res.Tags, ctx.EventManager().Events().ToTags()...)
+	if msCache != nil {
+		msCache.Write()
 	}
 
 	return
@@ -821,9 +1156,37 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 
+	// Halt the node before committing the configured height or time. The
+	// block is replayed by Tendermint when the node restarts.
+	if app.haltReached(header) {
+		app.halt()
+		return abci.ResponseCommit{}
+	}
+
+	// Write the trace of the block ahead of committing it
+	if app.writeAheadTrace != nil {
+		app.writeAheadTrace.mark("commit", "height", header.Height)
+		app.writeAheadTrace.flush()
+	}
+
 	// Write the Deliver state and commit the MultiStore
+	commitStart := time.Now()
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
+	if app.txTracer != nil {
+		app.txTracer.TraceTxSpan(TxTraceSpan{
+			Step: TxTraceStepCommit, Height: header.Height,
+			Start: commitStart, Duration: time.Since(commitStart),
+		})
+	}
+	app.metrics.BlockProcessingTime.Observe(time.Since(app.blockStartTime).Seconds())
+	if app.gasProfiler != nil {
+		app.gasProfiler.commit(header.Height)
+	}
+	if app.writeAheadTrace != nil {
+		app.writeAheadTrace.mark("app_hash", "height", commitID.Version, "hash", fmt.Sprintf("%X", commitID.Hash))
+		app.writeAheadTrace.flush()
+	}
 	// TODO: this is missing a module identifier and dumps byte array
 	app.Logger.Debug("Commit synced",
 		"commit", fmt.Sprintf("%X", commitID),
@@ -841,3 +1204,35 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		Data: commitID.Hash,
 	}
 }
+
+// haltReached returns true if the block of the given header reaches the
+// configured halt height or time
+func (app *BaseApp) haltReached(header abci.Header) bool {
+	switch {
+	case app.haltHeight > 0 && uint64(header.Height) >= app.haltHeight:
+		return true
+	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
+		return true
+	default:
+		return false
+	}
+}
+
+// halt gracefully shuts down the node with SIGINT or SIGTERM, exiting
+// immediately if both fail.
+func (app *BaseApp) halt() {
+	app.Logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		// the signal which is not supported depends on the OS
+		sigIntErr := p.Signal(syscall.SIGINT)
+		sigTermErr := p.Signal(syscall.SIGTERM)
+		if sigIntErr == nil || sigTermErr == nil {
+			return
+		}
+	}
+
+	app.Logger.Info("failed to send SIGINT/SIGTERM; exiting...")
+	os.Exit(0)
+}
@@ -3,16 +3,20 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store"
 
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -542,6 +546,65 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// Test that the events emitted by the handlers, and in BeginBlock and
+// EndBlock, are returned as tags namespaced by their type.
+func TestDeliverTxEvents(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.EventManager().EmitEvent(sdk.NewEvent("counter",
+				sdk.NewAttribute("value", fmt.Sprintf("%d", msg.(*msgCounter).Counter)),
+			))
+			return sdk.Result{Tags: sdk.NewTags("legacy", []byte("tag"))}
+		})
+	}
+	blockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.EventManager().EmitEvent(sdk.NewEvent("block", sdk.NewAttribute("stage", "begin")))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.EventManager().EmitEvent(sdk.NewEvent("block", sdk.NewAttribute("stage", "end")))
+			return abci.ResponseEndBlock{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, blockerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	beginRes := app.BeginBlock(abci.RequestBeginBlock{})
+	require.Equal(t, sdk.NewTags("block.stage", []byte("begin")).ToKVPairs(), beginRes.Tags)
+
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 1, 2))
+	require.NoError(t, err)
+	res := app.DeliverTx(txBytes)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	expected := sdk.NewTags(
+		sdk.TagAction, []byte(msgCounter{}.Type()),
+		"legacy", []byte("tag"),
+		sdk.TagAction, []byte(msgCounter{}.Type()),
+		"legacy", []byte("tag"),
+		"message.action", []byte(msgCounter{}.Type()),
+		"message.module", []byte(routeMsgCounter),
+		"counter.value", []byte("1"),
+		"message.action", []byte(msgCounter{}.Type()),
+		"message.module", []byte(routeMsgCounter),
+		"counter.value", []byte("2"),
+	)
+	require.Equal(t, expected.ToKVPairs(), res.Tags)
+
+	endRes := app.EndBlock(abci.RequestEndBlock{})
+	require.Equal(t, sdk.NewTags("block.stage", []byte("end")).ToKVPairs(), endRes.Tags)
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -947,7 +1010,7 @@ func TestMaxBlockGasLimits(t *testing.T) {
 
 			// check for failed transactions
 			if tc.fail && (j+1) > tc.failAfterDeliver {
-				require.Equal(t, res.Code, sdk.CodeOutOfGas, fmt.Sprintf("%d: %v, %v", i, tc, res))
+				require.Equal(t, res.Code, sdk.CodeOutOfBlockGas, fmt.Sprintf("%d: %v, %v", i, tc, res))
 				require.Equal(t, res.Codespace, sdk.CodespaceRoot, fmt.Sprintf("%d: %v, %v", i, tc, res))
 				require.True(t, ctx.BlockGasMeter().IsOutOfGas())
 			} else {
@@ -1031,3 +1094,601 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.EndBlock(abci.RequestEndBlock{})
 	app.Commit()
 }
+
+// message signed by a configurable address, for testing the msg dispatcher
+type msgSigned struct {
+	Signer        sdk.AccAddress
+	FailOnHandler bool
+}
+
+// Implements Msg
+func (msg msgSigned) Route() string                { return routeMsgCounter }
+func (msg msgSigned) Type() string                 { return "signed" }
+func (msg msgSigned) GetSignBytes() []byte         { return nil }
+func (msg msgSigned) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+func (msg msgSigned) ValidateBasic() sdk.Error     { return nil }
+
+func TestMsgDispatcher(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, deliverKey, getIntFromStore(store, deliverKey)+1)
+			if msg.(msgSigned).FailOnHandler {
+				return sdk.ErrInternal("message handler failure").Result()
+			}
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt)
+	dispatcher := app.MsgDispatcher("module")
+	ctx := app.NewContext(true, abci.Header{})
+	store := ctx.KVStore(capKey1)
+
+	// messages signed by the module account are routed to their handler
+	res := dispatcher.DispatchMsg(ctx, msgSigned{Signer: sdk.ModuleAddress("module")})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+
+	// the state changes of a failed message are discarded
+	res = dispatcher.DispatchMsg(ctx, msgSigned{Signer: sdk.ModuleAddress("module"), FailOnHandler: true})
+	require.False(t, res.IsOK())
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+
+	// messages signed by another account are rejected
+	res = dispatcher.DispatchMsg(ctx, msgSigned{Signer: sdk.ModuleAddress("other")})
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+
+	// messages without signers are rejected
+	res = dispatcher.DispatchMsg(ctx, msgNoRoute{})
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+
+	// unknown routes
+	app = setupBaseApp(t)
+	res = app.MsgDispatcher("module").DispatchMsg(ctx, msgSigned{Signer: sdk.ModuleAddress("module")})
+	require.Equal(t, sdk.CodeUnknownRequest, res.Code)
+}
+
+// Test that the state changes of a transaction exceeding the block gas limit
+// are discarded
+func TestMaxBlockGasDiscardsState(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(10000))
+			return newCtx, sdk.Result{GasWanted: 10000}, false
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(uint64(msg.(msgCounter).Counter), "counter-handler")
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, deliverKey, getIntFromStore(store, deliverKey)+1)
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			BlockSize: &abci.BlockSizeParams{
+				MaxGas: 10000,
+			},
+		},
+	})
+	app.BeginBlock(abci.RequestBeginBlock{})
+
+	// the first tx fits in the block
+	res := app.Deliver(newTxCounter(0, 4000))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// the second one exceeds the block gas limit
+	res = app.Deliver(newTxCounter(1, 4000))
+	require.Equal(t, sdk.CodeOutOfBlockGas, res.Code, fmt.Sprintf("%v", res))
+
+	// and no gas is left for the next ones
+	res = app.Deliver(newTxCounter(2, 1))
+	require.Equal(t, sdk.CodeOutOfBlockGas, res.Code, fmt.Sprintf("%v", res))
+
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+}
+
+type panicUnauthorized struct{}
+
+func TestRunTxRecoveryHandlers(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			switch msg.(msgCounter).Counter {
+			case 0:
+				panic(panicUnauthorized{})
+			case 1:
+				panic(sdk.ErrorOutOfGas{Descriptor: "test"})
+			default:
+				panic("unhandled")
+			}
+		})
+	}
+	recoveryOpt := func(bapp *BaseApp) {
+		bapp.AddRunTxRecoveryHandler(func(recoveryObj interface{}) sdk.Error {
+			if _, ok := recoveryObj.(panicUnauthorized); ok {
+				return sdk.ErrUnauthorized("recovered unauthorized panic")
+			}
+			return nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, recoveryOpt)
+	app.BeginBlock(abci.RequestBeginBlock{})
+
+	testCases := []struct {
+		tx   *txTest
+		code sdk.CodeType
+	}{
+		{newTxCounter(0, 0), sdk.CodeUnauthorized},
+		{newTxCounter(1, 1), sdk.CodeOutOfGas},
+		{newTxCounter(2, 2), sdk.CodeInternal},
+	}
+
+	for i, tc := range testCases {
+		res := app.Deliver(tc.tx)
+		require.Equal(t, tc.code, res.Code, fmt.Sprintf("%d: %v", i, res))
+	}
+}
+
+// Test that the log of a transaction holds the result of each message, up to
+// the first failed one.
+func TestDeliverTxMessageLogs(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			counter := msg.(msgCounter).Counter
+			if msg.(msgCounter).FailOnHandler {
+				return sdk.ErrInternal(fmt.Sprintf("counter %d failed", counter)).Result()
+			}
+			return sdk.Result{Log: fmt.Sprintf("counter %d", counter), Data: []byte{byte(counter)}}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.BeginBlock(abci.RequestBeginBlock{})
+
+	tx := newTxCounter(0, 1, 2, 3)
+	tx.Msgs[1] = msgCounter{Counter: 2, FailOnHandler: true}
+	res := app.Deliver(tx)
+	require.Equal(t, sdk.CodeInternal, res.Code)
+
+	logs, err := sdk.ParseABCILogs(res.Log)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	require.Equal(t, sdk.ABCIMessageLog{MsgIndex: 0, Success: true, Log: "counter 1", Data: []byte{1}}, logs[0])
+	require.Equal(t, 1, logs[1].MsgIndex)
+	require.False(t, logs[1].Success)
+	require.Contains(t, logs[1].Log, "counter 2 failed")
+}
+
+func TestPostHandler(t *testing.T) {
+	postKey := []byte("post-key")
+	msgKey := []byte("msg-key")
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(10, "counter-handler")
+			if msg.(msgCounter).FailOnHandler {
+				return sdk.ErrInternal("message handler failure").Result()
+			}
+			store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(capKey1)
+			setIntOnStore(store, msgKey, getIntFromStore(store, msgKey)+1)
+			return sdk.Result{Log: "message handler"}
+		})
+	}
+	abortPost := false
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) bool {
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, postKey, getIntFromStore(store, postKey)+1)
+			return abortPost
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, postOpt)
+	app.BeginBlock(abci.RequestBeginBlock{})
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+
+	// the post handler is not run in CheckTx
+	res := app.Check(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(0), getIntFromStore(app.getState(runTxModeCheck).ctx.KVStore(capKey1), postKey))
+
+	// it is run in DeliverTx without consuming gas
+	res = app.Deliver(newTxCounter(1, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, uint64(10), res.GasUsed)
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	// and its state changes are persisted when the messages fail
+	tx := newTxCounter(2, 0)
+	tx.Msgs[0] = msgCounter{Counter: 0, FailOnHandler: true}
+	res = app.Deliver(tx)
+	require.False(t, res.IsOK())
+	require.Equal(t, int64(2), getIntFromStore(store, postKey))
+
+	// but not when it aborts, the result of the messages being kept
+	abortPost = true
+	res = app.Deliver(newTxCounter(3, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(2), getIntFromStore(store, postKey))
+	require.Equal(t, int64(2), getIntFromStore(store, msgKey))
+	require.Contains(t, res.Log, "message handler")
+}
+
+func TestHaltReached(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+	testCases := []struct {
+		haltHeight uint64
+		haltTime   uint64
+		halt       bool
+	}{
+		{0, 0, false},
+		{11, 0, false},
+		{10, 0, true},
+		{9, 0, true},
+		{0, 1001, false},
+		{0, 1000, true},
+		{11, 1000, true},
+	}
+
+	for i, tc := range testCases {
+		app := setupBaseApp(t, SetHaltHeight(tc.haltHeight), SetHaltTime(tc.haltTime))
+		header := abci.Header{Height: 10, Time: blockTime}
+		require.Equal(t, tc.halt, app.haltReached(header), "test case %d", i)
+	}
+}
+
+func TestTxResponseCodeInfo(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the description of the registered code of a failed tx is set as info
+	checkRes := app.CheckTx(nil)
+	require.Equal(t, uint32(sdk.CodeTxDecode), checkRes.Code)
+	require.Equal(t, string(sdk.CodespaceRoot), checkRes.Codespace)
+	require.Equal(t, sdk.CodeToDefaultMsg(sdk.CodeTxDecode), checkRes.Info)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	deliverRes := app.DeliverTx(nil)
+	require.Equal(t, uint32(sdk.CodeTxDecode), deliverRes.Code)
+	require.Equal(t, sdk.CodeToDefaultMsg(sdk.CodeTxDecode), deliverRes.Info)
+}
+
+func TestWriteAheadTrace(t *testing.T) {
+	var buf bytes.Buffer
+	setKey := func(ctx sdk.Context, key *sdk.KVStoreKey, k string) {
+		ctx.KVStore(key).Set([]byte(k), []byte{1})
+	}
+	opts := []func(*BaseApp){
+		func(bapp *BaseApp) { bapp.SetWriteAheadTracer(&buf) },
+		func(bapp *BaseApp) {
+			bapp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+				setKey(ctx, capKey2, "init")
+				return abci.ResponseInitChain{}
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				setKey(ctx, capKey2, "begin")
+				return abci.ResponseBeginBlock{}
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+				setKey(ctx, capKey2, "end")
+				return abci.ResponseEndBlock{}
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+				setKey(ctx, capKey1, "ante")
+				return
+			})
+		},
+		func(bapp *BaseApp) {
+			bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+				if msg.(msgCounter).FailOnHandler {
+					return sdk.ErrInternal("message handler failure").Result()
+				}
+				setKey(ctx, capKey1, "msg")
+				return sdk.Result{}
+			})
+		},
+	}
+
+	app := setupBaseApp(t, opts...)
+	app.InitChain(abci.RequestInitChain{ChainId: "test-chain"})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+
+	// the messages of the failed transaction are rolled back from the trace
+	res := app.Deliver(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	tx := newTxCounter(1, 0, 1)
+	tx.Msgs[1] = msgCounter{Counter: 1, FailOnHandler: true}
+	res = app.Deliver(tx)
+	require.False(t, res.IsOK())
+
+	// the trace is written ahead of the commit only
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	require.Zero(t, buf.Len())
+	commitRes := app.Commit()
+
+	var steps []string
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var entry struct {
+			Marker    string
+			Operation string
+			Key       []byte
+			Metadata  map[string]string
+		}
+		require.NoError(t, json.Unmarshal(line, &entry))
+		if entry.Marker != "" {
+			steps = append(steps, entry.Marker)
+			continue
+		}
+		steps = append(steps, fmt.Sprintf("%s %s/%s", entry.Operation, entry.Metadata["store"], entry.Key))
+	}
+
+	expectedSteps := []string{
+		"init_chain", "write key2/init",
+		"begin_block", "write key2/begin",
+		"tx", "ante", "write key1/ante", "msg", "write key1/msg",
+		"tx", "ante", "write key1/ante",
+		"end_block", "write key2/end",
+		"commit", "app_hash",
+	}
+	require.Equal(t, expectedSteps, steps)
+	require.Contains(t, buf.String(), fmt.Sprintf(`"hash":"%X"`, commitRes.Data))
+}
+
+func TestMetrics(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, SetMetrics(PrometheusMetrics("test_metrics")), routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(i, 2*i, 2*i+1))
+		require.NoError(t, err)
+		res := app.DeliverTx(txBytes)
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the values of the counters and the number of observations of the
+	// histograms, by name and labels
+	families, err := stdprometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			name := family.GetName()
+			for _, label := range metric.GetLabel() {
+				name += fmt.Sprintf(" %s=%s", label.GetName(), label.GetValue())
+			}
+			if counter := metric.GetCounter(); counter != nil {
+				values[name] = counter.GetValue()
+			} else if histogram := metric.GetHistogram(); histogram != nil {
+				values[name] = float64(histogram.GetSampleCount())
+			}
+		}
+	}
+
+	require.Equal(t, float64(2), values["test_metrics_app_txs"])
+	require.Equal(t, float64(4), values["test_metrics_app_msgs route=msgCounter type=counter1"])
+	require.Equal(t, float64(2), values["test_metrics_app_tx_gas_used"])
+	require.Equal(t, float64(1), values["test_metrics_app_block_processing_time"])
+	require.Equal(t, float64(4), values["test_metrics_app_store_operation_time operation=write store=key1"])
+	require.True(t, values["test_metrics_app_store_operation_time operation=read store=key1"] >= 4)
+}
+
+func TestGasProfile(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(uint64(10*(msg.(msgCounter).Counter+1)), "msg")
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, SetGasProfileBlocks(2), routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		for i := int64(0); i < height; i++ {
+			res := app.Deliver(newTxCounter(i, 0, i))
+			require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the messages of an uncommitted block are discarded
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 4}})
+	res := app.Deliver(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 4}})
+
+	queryRes := app.Query(abci.RequestQuery{Path: "/custom/metrics/gas"})
+	require.Equal(t, uint32(sdk.CodeOK), queryRes.Code, queryRes.Log)
+	var profiles []BlockGasProfile
+	require.NoError(t, codec.Cdc.UnmarshalJSON(queryRes.Value, &profiles))
+
+	// only the profiles of the last two blocks are retained
+	expected := []BlockGasProfile{
+		{Height: 2, Msgs: []MsgGasProfile{
+			{Route: routeMsgCounter, Type: "counter1", Count: 4, TotalGas: 50, MinGas: 10, MaxGas: 20},
+		}},
+		{Height: 3, Msgs: []MsgGasProfile{
+			{Route: routeMsgCounter, Type: "counter1", Count: 6, TotalGas: 90, MinGas: 10, MaxGas: 30},
+		}},
+	}
+	require.Equal(t, expected, profiles)
+
+	// gas profiling is disabled by default
+	app = setupBaseApp(t, routerOpt)
+	queryRes = app.Query(abci.RequestQuery{Path: "/custom/metrics/gas"})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), queryRes.Code)
+}
+
+type testTxTracer struct {
+	spans []TxTraceSpan
+}
+
+func (tt *testTxTracer) TraceTxSpan(span TxTraceSpan) {
+	tt.spans = append(tt.spans, span)
+}
+
+func TestTxTracer(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(1000))
+			newCtx.GasMeter().ConsumeGas(5, "ante")
+			return newCtx, sdk.Result{GasWanted: 1000}, false
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(10, "counter-handler")
+			return sdk.Result{}
+		})
+	}
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) bool {
+			ctx.GasMeter().ConsumeGas(3, "post")
+			return false
+		})
+	}
+	tracer := &testTxTracer{}
+	tracerOpt := func(bapp *BaseApp) { bapp.SetTxTracer(tracer) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, postOpt, tracerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 0, 1))
+	require.NoError(t, err)
+
+	// the messages are not run in CheckTx
+	checkRes := app.CheckTx(txBytes)
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	deliverRes := app.DeliverTx(txBytes)
+	require.True(t, deliverRes.IsOK(), fmt.Sprintf("%v", deliverRes))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	txHash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	var steps []string
+	for _, span := range tracer.spans {
+		steps = append(steps, fmt.Sprintf("%s %d %d", span.Step, span.MsgIndex, span.GasUsed))
+		require.False(t, span.Start.IsZero())
+		if span.Step != TxTraceStepCommit {
+			require.Equal(t, txHash, span.TxHash)
+		}
+	}
+	expected := []string{
+		"ante 0 5", "check_tx 0 5",
+		"ante 0 5", "msg 0 10", "msg 1 10", "post 0 3", "deliver_tx 0 25",
+		"commit 0 0",
+	}
+	require.Equal(t, expected, steps)
+	require.Equal(t, int64(1), tracer.spans[len(tracer.spans)-1].Height)
+	require.Equal(t, uint64(1000), tracer.spans[len(tracer.spans)-2].GasWanted)
+}
+
+func TestJSONTxTracer(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewJSONTxTracer(&buf)
+	tracer.TraceTxSpan(TxTraceSpan{Step: TxTraceStepMsg, Height: 2, TxHash: "AB", MsgIndex: 1, GasUsed: 10})
+	tracer.TraceTxSpan(TxTraceSpan{Step: TxTraceStepCommit, Height: 2, Duration: time.Millisecond})
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var span TxTraceSpan
+	require.NoError(t, json.Unmarshal(lines[0], &span))
+	require.Equal(t, TxTraceSpan{Step: TxTraceStepMsg, Height: 2, TxHash: "AB", MsgIndex: 1, GasUsed: 10}, span)
+	require.Contains(t, string(lines[1]), `"duration":1000000`)
+}
+
+func TestStoreStats(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	// the store statistics are collected along with the metrics
+	app := setupBaseApp(t, SetStoreStats(true), SetMetrics(NopMetrics()), routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	queryStats := func(path string) StoreAccessStats {
+		queryRes := app.Query(abci.RequestQuery{Path: path})
+		require.Equal(t, uint32(sdk.CodeOK), queryRes.Code, queryRes.Log)
+		var stats StoreAccessStats
+		require.NoError(t, codec.Cdc.UnmarshalJSON(queryRes.Value, &stats))
+		return stats
+	}
+	storeStats := func(stats StoreAccessStats, store string) StoreStats {
+		for _, s := range stats.Stores {
+			if s.Store == store {
+				return s
+			}
+		}
+		return StoreStats{Store: store}
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	res := app.Deliver(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the handler reads and writes its counter
+	stats := queryStats("/custom/metrics/store/reset")
+	require.False(t, stats.Since.IsZero())
+	key1Stats := storeStats(stats, capKey1.Name())
+	require.Equal(t, uint64(1), key1Stats.Writes)
+	require.True(t, key1Stats.Reads >= 1)
+
+	// the statistics were reset
+	stats = queryStats("/custom/metrics/store")
+	require.Equal(t, StoreStats{Store: capKey1.Name()}, storeStats(stats, capKey1.Name()))
+
+	// the store statistics are disabled by default
+	app = setupBaseApp(t, routerOpt)
+	queryRes := app.Query(abci.RequestQuery{Path: "/custom/metrics/store"})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), queryRes.Code)
+}
@@ -0,0 +1,62 @@
+package baseapp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.MsgDispatcher = msgDispatcher{}
+
+// msgDispatcher routes the messages dispatched by a module to the handlers of
+// the application router
+type msgDispatcher struct {
+	router     Router
+	moduleName string
+	moduleAddr sdk.AccAddress
+}
+
+// MsgDispatcher returns the dispatcher a module executes the messages of other
+// modules with, the module account being their only allowed signer. As the
+// routes are resolved on dispatch, it can be passed to keepers before the
+// routes are registered.
+func (app *BaseApp) MsgDispatcher(moduleName string) sdk.MsgDispatcher {
+	return msgDispatcher{
+		router:     app.router,
+		moduleName: moduleName,
+		moduleAddr: sdk.ModuleAddress(moduleName),
+	}
+}
+
+// DispatchMsg implements sdk.MsgDispatcher
+func (d msgDispatcher) DispatchMsg(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return sdk.ErrUnauthorized(fmt.Sprintf(
+			"module %s cannot dispatch a message without signers", d.moduleName)).Result()
+	}
+	for _, signer := range signers {
+		if !signer.Equals(d.moduleAddr) {
+			return sdk.ErrUnauthorized(fmt.Sprintf(
+				"module %s cannot dispatch a message signed by %s", d.moduleName, signer)).Result()
+		}
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err.Result()
+	}
+
+	handler := d.router.Route(msg.Route())
+	if handler == nil {
+		return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msg.Route()).Result()
+	}
+
+	// only persist the state changes of the message if it succeeds
+	cacheCtx, writeCache := ctx.CacheContext()
+	result := handler(cacheCtx, msg)
+	if result.IsOK() {
+		writeCache()
+	}
+
+	return result
+}
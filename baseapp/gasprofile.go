@@ -0,0 +1,104 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+)
+
+// MsgGasProfile is the gas consumed by the handlers of the messages of a type
+// in the delivered transactions of a block.
+type MsgGasProfile struct {
+	Route    string `json:"route"`
+	Type     string `json:"type"`
+	Count    uint64 `json:"count"`
+	TotalGas uint64 `json:"total_gas"`
+	MinGas   uint64 `json:"min_gas"`
+	MaxGas   uint64 `json:"max_gas"`
+}
+
+// BlockGasProfile is the gas consumed by the messages of a block, by route
+// and type of the messages.
+type BlockGasProfile struct {
+	Height int64           `json:"height"`
+	Msgs   []MsgGasProfile `json:"msgs"`
+}
+
+type msgGasKey struct {
+	route, typ string
+}
+
+// gasProfiler aggregates the gas consumed by the messages of each block, and
+// retains the profiles of the last committed blocks.
+type gasProfiler struct {
+	mtx sync.Mutex
+
+	blocks   int
+	current  map[msgGasKey]*MsgGasProfile
+	profiles []BlockGasProfile
+}
+
+func newGasProfiler(blocks int) *gasProfiler {
+	return &gasProfiler{
+		blocks:  blocks,
+		current: make(map[msgGasKey]*MsgGasProfile),
+	}
+}
+
+// beginBlock discards the gas consumed by the messages of an uncommitted block
+func (gp *gasProfiler) beginBlock() {
+	gp.mtx.Lock()
+	defer gp.mtx.Unlock()
+	gp.current = make(map[msgGasKey]*MsgGasProfile)
+}
+
+// addMsg adds the gas consumed by a message to the profile of the block
+func (gp *gasProfiler) addMsg(route, typ string, gas uint64) {
+	gp.mtx.Lock()
+	defer gp.mtx.Unlock()
+
+	key := msgGasKey{route, typ}
+	profile, ok := gp.current[key]
+	if !ok {
+		profile = &MsgGasProfile{Route: route, Type: typ, MinGas: gas}
+		gp.current[key] = profile
+	}
+	profile.Count++
+	profile.TotalGas += gas
+	if gas < profile.MinGas {
+		profile.MinGas = gas
+	}
+	if gas > profile.MaxGas {
+		profile.MaxGas = gas
+	}
+}
+
+// commit retains the profile of the committed block, discarding the one of
+// the oldest block retained if there are too many
+func (gp *gasProfiler) commit(height int64) {
+	gp.mtx.Lock()
+	defer gp.mtx.Unlock()
+
+	profile := BlockGasProfile{Height: height, Msgs: make([]MsgGasProfile, 0, len(gp.current))}
+	for _, msg := range gp.current {
+		profile.Msgs = append(profile.Msgs, *msg)
+	}
+	sort.Slice(profile.Msgs, func(i, j int) bool {
+		if profile.Msgs[i].Route != profile.Msgs[j].Route {
+			return profile.Msgs[i].Route < profile.Msgs[j].Route
+		}
+		return profile.Msgs[i].Type < profile.Msgs[j].Type
+	})
+
+	gp.profiles = append(gp.profiles, profile)
+	if len(gp.profiles) > gp.blocks {
+		gp.profiles = gp.profiles[len(gp.profiles)-gp.blocks:]
+	}
+	gp.current = make(map[msgGasKey]*MsgGasProfile)
+}
+
+// blockProfiles returns the profiles of the retained blocks, the oldest first
+func (gp *gasProfiler) blockProfiles() []BlockGasProfile {
+	gp.mtx.Lock()
+	defer gp.mtx.Unlock()
+	return append([]BlockGasProfile(nil), gp.profiles...)
+}
@@ -0,0 +1,101 @@
+package baseapp
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetricsSubsystem is the subsystem of the metrics of the application.
+const MetricsSubsystem = "app"
+
+// Metrics contains the metrics of the application. It observes the operations
+// on the stores when set on the BaseApp.
+type Metrics struct {
+	// Number of delivered transactions.
+	Txs metrics.Counter
+	// Number of messages of the delivered transactions, by route and type.
+	Msgs metrics.Counter
+	// Gas consumed by the handlers of the messages of the delivered
+	// transactions, by route and type.
+	MsgGasUsed metrics.Histogram
+	// Gas used by the delivered transactions.
+	TxGasUsed metrics.Histogram
+	// Time between BeginBlock and Commit in seconds.
+	BlockProcessingTime metrics.Histogram
+	// Duration of the operations on the stores in seconds, by store and
+	// operation.
+	StoreOperationTime metrics.Histogram
+}
+
+var _ sdk.StoreObserver = (*Metrics)(nil)
+
+// PrometheusMetrics returns the metrics of the application, registered to
+// the default Prometheus registerer under the given namespace. They are served
+// with the metrics of Tendermint when its Prometheus instrumentation is on.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		Txs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs",
+			Help:      "Number of delivered transactions.",
+		}, []string{}),
+		Msgs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "msgs",
+			Help:      "Number of messages of the delivered transactions.",
+		}, []string{"route", "type"}),
+		MsgGasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "msg_gas_used",
+			Help:      "Gas consumed by the handlers of the messages of the delivered transactions.",
+			Buckets:   stdprometheus.ExponentialBuckets(1000, 2, 12),
+		}, []string{"route", "type"}),
+		TxGasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "tx_gas_used",
+			Help:      "Gas used by the delivered transactions.",
+			Buckets:   stdprometheus.ExponentialBuckets(10000, 2, 10),
+		}, []string{}),
+		BlockProcessingTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_processing_time",
+			Help:      "Time between BeginBlock and Commit in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 2, 14),
+		}, []string{}),
+		StoreOperationTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "store_operation_time",
+			Help:      "Duration of the operations on the stores in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.000001, 4, 10),
+		}, []string{"store", "operation"}),
+	}
+}
+
+// NopMetrics returns the metrics of the application discarding their values.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Txs:                 discard.NewCounter(),
+		Msgs:                discard.NewCounter(),
+		MsgGasUsed:          discard.NewHistogram(),
+		TxGasUsed:           discard.NewHistogram(),
+		BlockProcessingTime: discard.NewHistogram(),
+		StoreOperationTime:  discard.NewHistogram(),
+	}
+}
+
+// ObserveStoreOperation implements the StoreObserver interface.
+func (m *Metrics) ObserveStoreOperation(storeName string, op sdk.StoreOperation, duration time.Duration) {
+	m.StoreOperationTime.With("store", storeName, "operation", string(op)).Observe(duration.Seconds())
+}
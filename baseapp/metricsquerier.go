@@ -0,0 +1,47 @@
+package baseapp
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetricsQueryRoute is the route of the custom queries of the metrics
+// collected by the BaseApp, e.g. "/custom/metrics/gas".
+const MetricsQueryRoute = "metrics"
+
+// queryMetrics handles the custom queries of the metrics collected by the
+// BaseApp, which are the ones of the node rather than of the queried state:
+//   - gas: the BlockGasProfiles of the retained blocks, the oldest first
+//   - store: the StoreAccessStats of the stores
+//   - store/reset: the StoreAccessStats of the stores, which are then reset
+func (app *BaseApp) queryMetrics(_ sdk.Context, path []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+	switch strings.Join(path, "/") {
+	case "gas":
+		if app.gasProfiler == nil {
+			return nil, sdk.ErrUnknownRequest("gas profiling is disabled")
+		}
+		return marshalMetrics(app.gasProfiler.blockProfiles())
+
+	case "store", "store/reset":
+		if app.storeStats == nil {
+			return nil, sdk.ErrUnknownRequest("store statistics are disabled")
+		}
+		return marshalMetrics(app.storeStats.stats(len(path) > 1))
+
+	default:
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown metrics query endpoint %s", strings.Join(path, "/")))
+	}
+}
+
+func marshalMetrics(metrics interface{}) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(codec.Cdc, metrics)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
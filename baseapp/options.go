@@ -3,6 +3,8 @@ package baseapp
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	dbm "github.com/tendermint/tendermint/libs/db"
 
@@ -27,6 +29,73 @@ func SetMinimumFees(minFees string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinimumFees(fees) }
 }
 
+// SetHaltHeight returns an option that sets the height at which the node
+// halts, before committing it.
+func SetHaltHeight(haltHeight uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltHeight(haltHeight) }
+}
+
+// SetHaltTime returns an option that sets the unix time at which the node
+// halts, before committing the first block reaching it.
+func SetHaltTime(haltTime uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
+}
+
+// SetMetrics returns an option that sets the metrics of the app, which
+// observe the operations on its stores.
+func SetMetrics(metrics *Metrics) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setMetrics(metrics) }
+}
+
+// SetGasProfileBlocks returns an option that profiles the gas consumed by the
+// messages of each block, by route and type, retaining the profiles of the
+// given number of last committed blocks. They are queried under
+// "/custom/metrics/gas". Gas profiling is disabled if the number is zero.
+func SetGasProfileBlocks(blocks int) func(*BaseApp) {
+	if blocks <= 0 {
+		return func(*BaseApp) {}
+	}
+	return func(bap *BaseApp) { bap.gasProfiler = newGasProfiler(blocks) }
+}
+
+// SetTxTraceFile returns an option that appends the spans of the lifecycle of
+// the transactions, and of the commits, to the given file, if any, as JSON
+// lines.
+func SetTxTraceFile(traceFile string) func(*BaseApp) {
+	if traceFile == "" {
+		return func(*BaseApp) {}
+	}
+	w, err := os.OpenFile(traceFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		panic(fmt.Sprintf("failed to open the tx trace file: %v", err))
+	}
+	return func(bap *BaseApp) { bap.SetTxTracer(NewJSONTxTracer(w)) }
+}
+
+// SetStoreStats returns an option that counts the operations on the stores of
+// the app, by store and operation, if enabled. They are queried under
+// "/custom/metrics/store", and reset when queried under
+// "/custom/metrics/store/reset".
+func SetStoreStats(enabled bool) func(*BaseApp) {
+	if !enabled {
+		return func(*BaseApp) {}
+	}
+	return func(bap *BaseApp) { bap.setStoreStats() }
+}
+
+// SetWriteAheadTraceFile returns an option that appends the write-ahead trace
+// of the KV mutations committed by each block to the given file, if any.
+func SetWriteAheadTraceFile(traceFile string) func(*BaseApp) {
+	if traceFile == "" {
+		return func(*BaseApp) {}
+	}
+	w, err := os.OpenFile(traceFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		panic(fmt.Sprintf("failed to open the write-ahead trace file: %v", err))
+	}
+	return func(bap *BaseApp) { bap.SetWriteAheadTracer(w) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -48,6 +117,14 @@ func (app *BaseApp) SetCMS(cms store.CommitMultiStore) {
 	app.cms = cms
 }
 
+// SetStoreLoader sets the StoreLoader used by LoadLatestVersion
+func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
+	if app.sealed {
+		panic("SetStoreLoader() on sealed BaseApp")
+	}
+	app.storeLoader = loader
+}
+
 func (app *BaseApp) SetInitChainer(initChainer sdk.InitChainer) {
 	if app.sealed {
 		panic("SetInitChainer() on sealed BaseApp")
@@ -76,6 +153,13 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
+	if app.sealed {
+		panic("SetPostHandler() on sealed BaseApp")
+	}
+	app.postHandler = ph
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -97,6 +181,25 @@ func (app *BaseApp) SetFauxMerkleMode() {
 	app.fauxMerkleMode = true
 }
 
+// SetWriteAheadTracer sets the writer to which the KV mutations committed by
+// each block are traced, before the block is committed.
+func (app *BaseApp) SetWriteAheadTracer(w io.Writer) {
+	if app.sealed {
+		panic("SetWriteAheadTracer() on sealed BaseApp")
+	}
+	app.writeAheadTrace = &writeAheadTrace{writer: w}
+}
+
+// SetTxTracer sets the tracer receiving the spans of the lifecycle of the
+// transactions, i.e. of their ante handler, messages and post handler, and of
+// the commits.
+func (app *BaseApp) SetTxTracer(tracer TxTracer) {
+	if app.sealed {
+		panic("SetTxTracer() on sealed BaseApp")
+	}
+	app.txTracer = tracer
+}
+
 //----------------------------------------
 // TODO: move these out of this file?
 
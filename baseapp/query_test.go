@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -95,3 +97,85 @@ func TestP2PQuery(t *testing.T) {
 	res = app.Query(pubkeyQuery)
 	require.Equal(t, uint32(4), res.Code)
 }
+
+// Test queries under custom top-level paths
+func TestQueryPathHandler(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	queryPathOpt := func(bapp *BaseApp) {
+		bapp.AddQueryPathHandler("kv", func(ctx sdk.Context, path []string, req abci.RequestQuery) abci.ResponseQuery {
+			require.Equal(t, []string{"get"}, path)
+			return abci.ResponseQuery{Value: ctx.KVStore(capKey1).Get(req.Data)}
+		})
+
+		// reserved and already registered paths are rejected
+		require.Panics(t, func() { bapp.AddQueryPathHandler("custom", nil) })
+		require.Panics(t, func() { bapp.AddQueryPathHandler("kv", nil) })
+	}
+
+	app := setupBaseApp(t, queryPathOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the handler reads the latest committed state
+	app.BeginBlock(abci.RequestBeginBlock{})
+	app.deliverState.ctx.KVStore(capKey1).Set(key, value)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	res := app.Query(abci.RequestQuery{Path: "/kv/get", Data: key})
+	require.Equal(t, value, res.Value)
+
+	// unregistered paths are unknown
+	res = app.Query(abci.RequestQuery{Path: "/other/get", Data: key})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+
+	// handlers can't be added once the app is sealed
+	require.Panics(t, func() { app.AddQueryPathHandler("other", nil) })
+}
+
+// Test custom queries at the latest and at previous heights
+func TestCustomQueryHeight(t *testing.T) {
+	key := []byte("hello")
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("kv", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+			return ctx.KVStore(capKey1).Get(req.Data), nil
+		})
+	}
+
+	app := setupBaseApp(t, querierOpt, SetPruning(store.PruneNothing))
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.deliverState.ctx.KVStore(capKey1).Set(key, []byte(fmt.Sprintf("value%d", height)))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the latest height is queried by default
+	res := app.Query(abci.RequestQuery{Path: "/custom/kv", Data: key})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("value3"), res.Value)
+	require.Equal(t, int64(3), res.Height)
+
+	// previous heights are queried from their stored state
+	res = app.Query(abci.RequestQuery{Path: "/custom/kv", Data: key, Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("value2"), res.Value)
+	require.Equal(t, int64(2), res.Height)
+
+	// future heights can't be queried
+	res = app.Query(abci.RequestQuery{Path: "/custom/kv", Data: key, Height: 4})
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), res.Code)
+}
+
+// Test the query of the registered error codes
+func TestErrorCodesQuery(t *testing.T) {
+	app := setupBaseApp(t)
+
+	res := app.Query(abci.RequestQuery{Path: "/app/codes"})
+	require.Equal(t, uint32(sdk.CodeOK), res.Code)
+
+	var codes []sdk.ErrorCode
+	codec.Cdc.MustUnmarshalJSON(res.Value, &codes)
+	require.Equal(t, sdk.RegisteredCodes(), codes)
+}
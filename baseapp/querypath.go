@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryPathHandler handles the ABCI queries under a custom top-level path.
+// It is passed a cache-wrapped context of the latest committed state and the
+// query path without its top-level prefix, e.g. []string{"code", "1"} for the
+// path "/wasm/code/1".
+type QueryPathHandler func(ctx sdk.Context, path []string, req abci.RequestQuery) abci.ResponseQuery
+
+// top-level query paths handled by the BaseApp itself
+var reservedQueryPaths = map[string]bool{
+	"app":    true,
+	"store":  true,
+	"p2p":    true,
+	"custom": true,
+}
+
+// AddQueryPathHandler registers a handler for the ABCI queries under a custom
+// top-level path, e.g. "wasm" for the queries under "/wasm". It panics if the
+// path is reserved by the BaseApp or has already been registered.
+func (app *BaseApp) AddQueryPathHandler(prefix string, handler QueryPathHandler) {
+	if app.sealed {
+		panic("AddQueryPathHandler() on sealed BaseApp")
+	}
+	if prefix == "" || reservedQueryPaths[prefix] {
+		panic(fmt.Sprintf("query path %q is reserved", prefix))
+	}
+	if _, ok := app.queryPathHandlers[prefix]; ok {
+		panic(fmt.Sprintf("query path %s has already been registered", prefix))
+	}
+	if app.queryPathHandlers == nil {
+		app.queryPathHandlers = make(map[string]QueryPathHandler)
+	}
+	app.queryPathHandlers[prefix] = handler
+}
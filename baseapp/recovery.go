@@ -0,0 +1,53 @@
+package baseapp
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoveryHandler maps a panic recovered during the execution of a
+// transaction to an error. It returns nil if it does not handle the recovered
+// value, which is then passed to the next handler.
+type RecoveryHandler func(recoveryObj interface{}) sdk.Error
+
+// AddRunTxRecoveryHandler registers custom recovery handlers for the panics
+// occurring in runTx. They are run in the order they are added, before the
+// default handlers mapping out of gas panics to sdk.CodeOutOfGas and any
+// other panic to sdk.CodeInternal.
+func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
+	if app.sealed {
+		panic("AddRunTxRecoveryHandler() on sealed BaseApp")
+	}
+	app.runTxRecoveryHandlers = append(app.runTxRecoveryHandlers, handlers...)
+}
+
+// runTxRecovery maps a panic recovered in runTx to the result of the first
+// handler handling it
+func (app *BaseApp) runTxRecovery(recoveryObj interface{}) sdk.Result {
+	for _, handler := range app.runTxRecoveryHandlers {
+		if err := handler(recoveryObj); err != nil {
+			return err.Result()
+		}
+	}
+
+	if err := outOfGasRecoveryHandler(recoveryObj); err != nil {
+		return err.Result()
+	}
+	return defaultRecoveryHandler(recoveryObj).Result()
+}
+
+// outOfGasRecoveryHandler handles the panics of gas meters running out of gas
+func outOfGasRecoveryHandler(recoveryObj interface{}) sdk.Error {
+	err, ok := recoveryObj.(sdk.ErrorOutOfGas)
+	if !ok {
+		return nil
+	}
+	return sdk.ErrOutOfGas(fmt.Sprintf("out of gas in location: %v", err.Descriptor))
+}
+
+// defaultRecoveryHandler handles any panic, logging its stack trace
+func defaultRecoveryHandler(recoveryObj interface{}) sdk.Error {
+	return sdk.ErrInternal(fmt.Sprintf("recovered: %v\nstack:\n%v", recoveryObj, string(debug.Stack())))
+}
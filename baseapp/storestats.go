@@ -0,0 +1,99 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreStats are the numbers of operations on a store, and their total
+// duration.
+type StoreStats struct {
+	Store       string        `json:"store"`
+	Reads       uint64        `json:"reads"`
+	Writes      uint64        `json:"writes"`
+	Iterations  uint64        `json:"iterations"`
+	ReadTime    time.Duration `json:"read_time"`
+	WriteTime   time.Duration `json:"write_time"`
+	IterateTime time.Duration `json:"iterate_time"`
+}
+
+// StoreAccessStats are the statistics of the operations on the stores since
+// they were enabled or reset.
+type StoreAccessStats struct {
+	Since  time.Time    `json:"since"`
+	Stores []StoreStats `json:"stores"`
+}
+
+// storeStatsObserver counts the operations on the stores
+type storeStatsObserver struct {
+	mtx sync.Mutex
+
+	since  time.Time
+	stores map[string]*StoreStats
+}
+
+var _ sdk.StoreObserver = (*storeStatsObserver)(nil)
+
+func newStoreStatsObserver() *storeStatsObserver {
+	return &storeStatsObserver{
+		since:  time.Now().UTC(),
+		stores: make(map[string]*StoreStats),
+	}
+}
+
+// ObserveStoreOperation implements the StoreObserver interface.
+func (so *storeStatsObserver) ObserveStoreOperation(storeName string, op sdk.StoreOperation, duration time.Duration) {
+	so.mtx.Lock()
+	defer so.mtx.Unlock()
+
+	stats, ok := so.stores[storeName]
+	if !ok {
+		stats = &StoreStats{Store: storeName}
+		so.stores[storeName] = stats
+	}
+	switch op {
+	case sdk.StoreOperationRead:
+		stats.Reads++
+		stats.ReadTime += duration
+	case sdk.StoreOperationWrite:
+		stats.Writes++
+		stats.WriteTime += duration
+	case sdk.StoreOperationIterate:
+		stats.Iterations++
+		stats.IterateTime += duration
+	}
+}
+
+// stats returns the statistics of the stores, sorted by name, resetting them
+// if reset is true
+func (so *storeStatsObserver) stats(reset bool) StoreAccessStats {
+	so.mtx.Lock()
+	defer so.mtx.Unlock()
+
+	stats := StoreAccessStats{Since: so.since, Stores: make([]StoreStats, 0, len(so.stores))}
+	for _, store := range so.stores {
+		stats.Stores = append(stats.Stores, *store)
+	}
+	sort.Slice(stats.Stores, func(i, j int) bool {
+		return stats.Stores[i].Store < stats.Stores[j].Store
+	})
+
+	if reset {
+		so.since = time.Now().UTC()
+		so.stores = make(map[string]*StoreStats)
+	}
+	return stats
+}
+
+// storeObservers notifies the operations on the stores to several observers
+type storeObservers []sdk.StoreObserver
+
+// ObserveStoreOperation implements the StoreObserver interface.
+func (observers storeObservers) ObserveStoreOperation(storeName string, op sdk.StoreOperation, duration time.Duration) {
+	for _, observer := range observers {
+		observer.ObserveStoreOperation(storeName, op, duration)
+	}
+}
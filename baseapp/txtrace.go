@@ -0,0 +1,84 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Steps of the lifecycle of the transactions traced by a TxTracer
+const (
+	TxTraceStepCheckTx    = "check_tx"
+	TxTraceStepSimulateTx = "simulate_tx"
+	TxTraceStepDeliverTx  = "deliver_tx"
+	TxTraceStepAnte       = "ante"
+	TxTraceStepMsg        = "msg"
+	TxTraceStepPost       = "post"
+	TxTraceStepCommit     = "commit"
+)
+
+// TxTraceSpan is the trace record of a step of the lifecycle of a
+// transaction, or of the commit of a block. The spans of the ante handler,
+// the messages and the post handler of a transaction are traced before the
+// span of the transaction, which contains them.
+type TxTraceSpan struct {
+	Step     string        `json:"step"`
+	Height   int64         `json:"height"`
+	TxHash   string        `json:"tx_hash,omitempty"`
+	MsgIndex int           `json:"msg_index,omitempty"`
+	Route    string        `json:"route,omitempty"`
+	Type     string        `json:"type,omitempty"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	// Gas consumed by the step, or by the transaction for the span of a
+	// transaction
+	GasUsed   uint64 `json:"gas_used"`
+	GasWanted uint64 `json:"gas_wanted,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+}
+
+// TxTracer receives the spans of the lifecycle of the transactions, e.g. to
+// write them or to export them to a distributed tracing system. It is called
+// synchronously from CheckTx and DeliverTx, concurrently for both.
+type TxTracer interface {
+	TraceTxSpan(span TxTraceSpan)
+}
+
+// jsonTxTracer writes the spans as JSON lines
+type jsonTxTracer struct {
+	mtx    sync.Mutex
+	writer io.Writer
+}
+
+// NewJSONTxTracer returns a TxTracer writing the spans to w as JSON lines.
+func NewJSONTxTracer(w io.Writer) TxTracer {
+	return &jsonTxTracer{writer: w}
+}
+
+// TraceTxSpan implements the TxTracer interface.
+func (tt *jsonTxTracer) TraceTxSpan(span TxTraceSpan) {
+	raw, err := json.Marshal(span)
+	if err != nil {
+		panic(fmt.Sprintf("failed to serialize tx trace span: %v", err))
+	}
+
+	tt.mtx.Lock()
+	defer tt.mtx.Unlock()
+	if _, err := tt.writer.Write(append(raw, '\n')); err != nil {
+		panic(fmt.Sprintf("failed to write the tx trace: %v", err))
+	}
+}
+
+// txTraceStep returns the traced step of a transaction run in the given mode
+func txTraceStep(mode runTxMode) string {
+	switch mode {
+	case runTxModeCheck:
+		return TxTraceStepCheckTx
+	case runTxModeSimulate:
+		return TxTraceStepSimulateTx
+	default:
+		return TxTraceStepDeliverTx
+	}
+}
@@ -0,0 +1,65 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// writeAheadTrace records the KV mutations committed by each block to an
+// append-only writer, as JSON lines delimited by markers of the block steps,
+// the transactions and their messages, so that the state can be reconstructed
+// and replayed. The mutations use the format of the store tracer, with the
+// name of their store as metadata.
+//
+// The trace of a block is buffered, the mutations which are not persisted, e.g.
+// those of a failed transaction, being discarded, and written before the block
+// is committed.
+type writeAheadTrace struct {
+	writer io.Writer
+	buf    bytes.Buffer
+}
+
+// mark adds a marker to the trace, with the given key/value pairs
+func (wat *writeAheadTrace) mark(marker string, keyvals ...interface{}) {
+	fields := map[string]interface{}{"marker": marker}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		fields[keyvals[i].(string)] = keyvals[i+1]
+	}
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		panic(fmt.Sprintf("failed to serialize write-ahead trace marker: %v", err))
+	}
+	wat.buf.Write(raw)
+	wat.buf.WriteString("\n")
+}
+
+// cacheContext returns a context with a cache of the multistore of ctx which
+// traces the mutations it writes, and the cache
+func (wat *writeAheadTrace) cacheContext(ctx sdk.Context) (sdk.Context, sdk.CacheMultiStore) {
+	msCache := store.NewWriteTraceMultiStore(ctx.MultiStore().(sdk.CacheMultiStore), &wat.buf)
+	return ctx.WithMultiStore(msCache), msCache
+}
+
+// savepoint returns the position to rollback the trace to
+func (wat *writeAheadTrace) savepoint() int {
+	return wat.buf.Len()
+}
+
+// rollback discards the trace recorded after the savepoint
+func (wat *writeAheadTrace) rollback(savepoint int) {
+	wat.buf.Truncate(savepoint)
+}
+
+// flush writes the buffered trace to the writer
+func (wat *writeAheadTrace) flush() {
+	if _, err := wat.writer.Write(wat.buf.Bytes()); err != nil {
+		panic(fmt.Sprintf("failed to write the write-ahead trace: %v", err))
+	}
+	wat.buf.Reset()
+}
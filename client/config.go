@@ -24,9 +24,10 @@ var configDefaults map[string]string
 
 func init() {
 	configDefaults = map[string]string{
-		"chain-id": "",
-		"output":   "text",
-		"node":     "tcp://localhost:26657",
+		"chain-id":        "",
+		"output":          "text",
+		"node":            "tcp://localhost:26657",
+		"keyring-backend": "leveldb",
 	}
 }
 
@@ -36,8 +37,12 @@ func ConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config <key> [value]",
 		Short: "Create or query a Gaia CLI configuration file",
-		RunE:  runConfigCmd,
-		Args:  cobra.RangeArgs(0, 2),
+		Long: `Create or query a Gaia CLI configuration file, holding the default values of
+the flags of the same name: chain-id, node, output, keyring-backend, async (the
+broadcast mode), trace and trust-node. The flags given on the command line
+override them.`,
+		RunE: runConfigCmd,
+		Args: cobra.RangeArgs(0, 2),
 	}
 
 	cmd.Flags().String(cli.HomeFlag, app.DefaultCLIHome,
@@ -78,7 +83,7 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 	// Get value action
 	if getAction {
 		switch key {
-		case "trace", "trust-node":
+		case "trace", "trust-node", "async":
 			fmt.Println(tree.GetDefault(key, false).(bool))
 		default:
 			if defaultValue, ok := configDefaults[key]; ok {
@@ -96,9 +101,14 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 	}
 	value := args[1]
 	switch key {
-	case "chain-id", "output", "node":
+	case "chain-id", "node", "keyring-backend":
 		tree.Set(key, value)
-	case "trace", "trust-node":
+	case "output":
+		if value != "text" && value != "json" {
+			return fmt.Errorf("invalid output format %q, must be text or json", value)
+		}
+		tree.Set(key, value)
+	case "trace", "trust-node", "async":
 		boolVal, err := strconv.ParseBool(value)
 		if err != nil {
 			return err
@@ -117,6 +127,29 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// InitConfig reads the configuration file of the client home directory of the
+// executed command, if any, into viper, and binds the flags of the command, so
+// that the values of the file are the defaults of the flags not set.
+func InitConfig(cmd *cobra.Command) error {
+	home, err := cmd.Flags().GetString(cli.HomeFlag)
+	if err != nil {
+		return err
+	}
+
+	cfgFile := path.Join(home, "config", "config.toml")
+	if _, err := os.Stat(cfgFile); err == nil {
+		viper.SetConfigFile(cfgFile)
+
+		if err := viper.ReadInConfig(); err != nil {
+			return err
+		}
+	}
+
+	// the flags are bound to the command executed, the flags of the same name
+	// being defined by many commands
+	return viper.BindPFlags(cmd.Flags())
+}
+
 func ensureConfFile(rootDir string) (string, error) {
 	cfgPath := path.Join(rootDir, "config")
 	if err := os.MkdirAll(cfgPath, os.ModePerm); err != nil {
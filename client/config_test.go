@@ -0,0 +1,41 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+func TestInitConfig(t *testing.T) {
+	home, err := ioutil.TempDir("", "gaiacli")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	defer viper.Reset()
+
+	cfgFile, err := ensureConfFile(home)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(cfgFile, []byte(`chain-id = "test-chain"
+node = "tcp://node:26657"
+async = true
+`), 0644))
+
+	// the commands define the flags of the same name, the executed one being
+	// bound
+	cmd := &cobra.Command{Use: "cmd", Run: func(*cobra.Command, []string) {}}
+	cmd.Flags().String(cli.HomeFlag, home, "")
+	cmd.Flags().String(FlagChainID, "", "")
+	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "")
+	cmd.Flags().Bool(FlagAsync, false, "")
+	GetCommands(&cobra.Command{Use: "other"})
+
+	require.NoError(t, cmd.ParseFlags([]string{"--node", "tcp://other:26657"}))
+	require.NoError(t, InitConfig(cmd))
+	require.Equal(t, "test-chain", viper.GetString(FlagChainID))
+	require.Equal(t, "tcp://other:26657", viper.GetString(FlagNode))
+	require.True(t, viper.GetBool(FlagAsync))
+}
@@ -254,3 +254,10 @@ func (ctx CLIContext) WithSimulation(simulate bool) CLIContext {
 	ctx.Simulate = simulate
 	return ctx
 }
+
+// WithHeight returns a copy of the context with an updated height of the
+// queries
+func (ctx CLIContext) WithHeight(height int64) CLIContext {
+	ctx.Height = height
+	return ctx
+}
@@ -33,24 +33,39 @@ func (ctx CLIContext) GetNode() (rpcclient.Client, error) {
 
 // Query performs a query for information about the connected node.
 func (ctx CLIContext) Query(path string, data cmn.HexBytes) (res []byte, err error) {
-	return ctx.query(path, data)
+	res, _, err = ctx.query(path, data)
+	return
 }
 
 // Query information about the connected node with a data payload
 func (ctx CLIContext) QueryWithData(path string, data []byte) (res []byte, err error) {
+	res, _, err = ctx.query(path, data)
+	return
+}
+
+// QueryWithHeight queries the connected node with a data payload, returning
+// the height at which the query was executed along with its result.
+func (ctx CLIContext) QueryWithHeight(path string, data []byte) (res []byte, height int64, err error) {
 	return ctx.query(path, data)
 }
 
 // QueryStore performs a query from a Tendermint node with the provided key and
 // store name.
 func (ctx CLIContext) QueryStore(key cmn.HexBytes, storeName string) (res []byte, err error) {
+	res, _, err = ctx.queryStore(key, storeName, "key")
+	return
+}
+
+// QueryStoreWithHeight performs a query of the key of a store, returning the
+// height at which the query was executed along with its result.
+func (ctx CLIContext) QueryStoreWithHeight(key cmn.HexBytes, storeName string) (res []byte, height int64, err error) {
 	return ctx.queryStore(key, storeName, "key")
 }
 
 // QuerySubspace performs a query from a Tendermint node with the provided
 // store name and subspace.
 func (ctx CLIContext) QuerySubspace(subspace []byte, storeName string) (res []sdk.KVPair, err error) {
-	resRaw, err := ctx.queryStore(subspace, storeName, "subspace")
+	resRaw, _, err := ctx.queryStore(subspace, storeName, "subspace")
 	if err != nil {
 		return res, err
 	}
@@ -150,11 +165,11 @@ func (ctx CLIContext) EnsureAccountExistsFromAddr(addr sdk.AccAddress) error {
 }
 
 // query performs a query from a Tendermint node with the provided store name
-// and path.
-func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err error) {
+// and path, returning the height at which it was executed.
+func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, height int64, err error) {
 	node, err := ctx.GetNode()
 	if err != nil {
-		return res, err
+		return res, height, err
 	}
 
 	opts := rpcclient.ABCIQueryOptions{
@@ -164,25 +179,25 @@ func (ctx CLIContext) query(path string, key cmn.HexBytes) (res []byte, err erro
 
 	result, err := node.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		return res, err
+		return res, height, err
 	}
 
 	resp := result.Response
 	if !resp.IsOK() {
-		return res, errors.Errorf(resp.Log)
+		return res, height, errors.Errorf(resp.Log)
 	}
 
 	// data from trusted node or subspace query doesn't need verification
 	if ctx.TrustNode || !isQueryStoreWithProof(path) {
-		return resp.Value, nil
+		return resp.Value, resp.Height, nil
 	}
 
 	err = ctx.verifyProof(path, resp)
 	if err != nil {
-		return nil, err
+		return nil, height, err
 	}
 
-	return resp.Value, nil
+	return resp.Value, resp.Height, nil
 }
 
 // Verify verifies the consensus proof at given height.
@@ -233,7 +248,7 @@ func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) err
 
 // queryStore performs a query from a Tendermint node with the provided a store
 // name and path.
-func (ctx CLIContext) queryStore(key cmn.HexBytes, storeName, endPath string) ([]byte, error) {
+func (ctx CLIContext) queryStore(key cmn.HexBytes, storeName, endPath string) ([]byte, int64, error) {
 	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
 	return ctx.query(path, key)
 }
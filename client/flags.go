@@ -38,12 +38,15 @@ const (
 	FlagIndentResponse     = "indent"
 	FlagListenAddr         = "laddr"
 	FlagCORS               = "cors"
+	FlagRateLimit          = "rate-limit"
+	FlagRateLimitBurst     = "rate-limit-burst"
 	FlagMaxOpenConnections = "max-open"
 	FlagInsecure           = "insecure"
 	FlagSSLHosts           = "ssl-hosts"
 	FlagSSLCertFile        = "ssl-certfile"
 	FlagSSLKeyFile         = "ssl-keyfile"
 	FlagOutputDocument     = "output-document" // inspired by wget -O
+	FlagKeyringBackend     = "keyring-backend"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -106,8 +109,10 @@ func RegisterRestServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().Bool(FlagInsecure, false, "Do not set up SSL/TLS layer")
 	cmd.Flags().String(FlagSSLHosts, "", "Comma-separated hostnames and IPs to generate a certificate for")
 	cmd.Flags().String(FlagSSLCertFile, "", "Path to a SSL certificate file. If not supplied, a self-signed certificate will be generated.")
-	cmd.Flags().String(FlagSSLKeyFile, "", "Path to a key file; ignored if a certificate file is not supplied.")
-	cmd.Flags().String(FlagCORS, "", "Set the domains that can make CORS requests (* for all)")
+	cmd.Flags().String(FlagSSLKeyFile, "", "Path to the key file of the SSL certificate; required along with a certificate file.")
+	cmd.Flags().String(FlagCORS, "", "Comma-separated origins that can make CORS requests (* for all)")
+	cmd.Flags().Float64(FlagRateLimit, 0, "Maximum number of requests per second of each client IP (0 for no limit)")
+	cmd.Flags().Int(FlagRateLimitBurst, 0, "Maximum number of requests of a client IP served at once before the rate limit applies (the rate limit if 0)")
 	cmd.Flags().String(FlagChainID, "", "Chain ID of Tendermint node")
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "Address of the node to connect to")
 	cmd.Flags().Int(FlagMaxOpenConnections, 1000, "The number of maximum open connections")
@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
@@ -32,10 +33,15 @@ const (
 	flagRecover     = "recover"
 	flagNoBackup    = "no-backup"
 	flagDryRun      = "dry-run"
+	flagCoinType    = "coin-type"
 	flagAccount     = "account"
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
+	flagLanguage    = "language"
+	flagWords       = "mnemonic-words"
+	flagKeyAlgo     = "algo"
+	flagRemote      = "remote"
 )
 
 func addKeyCommand() *cobra.Command {
@@ -47,12 +53,21 @@ Optionally specify a BIP39 mnemonic, a BIP39 passphrase to further secure the mn
 and a bip32 HD path to derive a specific account. The key will be stored under the given name
 and encrypted with the given password. The only input that is required is the encryption password.
 
+The key is derived with the BIP44 path 44'/<coin-type>'/<account>'/0/<index>, or with the
+full path given by --bip44-path, so that wallets created by other tools can be recovered and
+many accounts can be managed from one mnemonic.
+
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
-The flag --recover allows one to recover a key from a seed passphrase.
+The flag --recover allows one to recover a key from a seed passphrase, and prompts for
+its BIP39 passphrase as well when run with -i.
+A generated mnemonic has the number of words given by --mnemonic-words (12 or 24), and
+both generated and recovered mnemonics are in the BIP39 language given by --language.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
-multisig transactions.
+multisig transactions. Along with the --remote flag, it adds the public key of a key
+held by a remote signing service, e.g. in front of an HSM, which signs in place of
+the keystore.
 
 You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
@@ -66,14 +81,19 @@ the flag --nosort is set.
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	cmd.Flags().Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	cmd.Flags().String(FlagPublicKey, "", "Parse a public key in bech32 format and save it to disk")
+	cmd.Flags().String(flagRemote, "", "URL of the remote signing service holding the key given by --pubkey")
 	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	cmd.Flags().Bool(client.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
-	cmd.Flags().String(flagBIP44Path, "44'/118'/0'/0/0", "BIP44 path from which to derive a private key")
+	cmd.Flags().String(flagBIP44Path, "", "Full BIP44 path from which to derive a private key, overriding --coin-type, --account and --index")
 	cmd.Flags().Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
+	cmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "Coin type for HD derivation")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation")
+	cmd.Flags().String(flagLanguage, keys.English.String(), "Language of the BIP39 mnemonic")
+	cmd.Flags().Int(flagWords, 24, "Number of words of a generated BIP39 mnemonic (12 or 24)")
+	cmd.Flags().String(flagKeyAlgo, string(keys.Secp256k1), "Signing algorithm of the key (secp256k1|secp256r1)")
 	return cmd
 }
 
@@ -95,6 +115,9 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
 	interactive := viper.GetBool(flagInteractive)
+	if viper.GetString(flagRemote) != "" && viper.GetString(FlagPublicKey) == "" {
+		return errors.New("the public key of a remote key must be given by --pubkey")
+	}
 
 	if viper.GetBool(flagDryRun) {
 		// we throw this away, so don't enforce args,
@@ -164,12 +187,33 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if url := viper.GetString(flagRemote); url != "" {
+			_, err = kb.CreateRemote(name, pk, url)
+			return err
+		}
 		kb.CreateOffline(name, pk)
 		return nil
 	}
 
+	language, err := keys.LanguageFromString(viper.GetString(flagLanguage))
+	if err != nil {
+		return err
+	}
+	algo := keys.SigningAlgo(viper.GetString(flagKeyAlgo))
+	if !keys.IsSupportedAlgorithm(algo) {
+		return fmt.Errorf("unsupported signing algorithm %q", algo)
+	}
+
 	bipFlag := cmd.Flags().Lookup(flagBIP44Path)
-	bip44Params, err := getBIP44ParamsAndPath(bipFlag.Value.String(), bipFlag.Changed || !interactive)
+	bip44Path := bipFlag.Value.String()
+	if bip44Path == "" {
+		bip44Path = hd.CreateHDPath(
+			uint32(viper.GetInt(flagCoinType)),
+			uint32(viper.GetInt(flagAccount)),
+			uint32(viper.GetInt(flagIndex)),
+		).String()
+	}
+	bip44Params, err := getBIP44ParamsAndPath(bip44Path, bipFlag.Changed || !interactive)
 	if err != nil {
 		return err
 	}
@@ -177,9 +221,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	// If we're using ledger, only thing we need is the path. So generate key and
 	// we're done.
 	if viper.GetBool(client.FlagUseLedger) {
-		account := uint32(viper.GetInt(flagAccount))
-		index := uint32(viper.GetInt(flagIndex))
-		path := ccrypto.DerivationPath{44, 118, account, 0, index}
+		path := ccrypto.DerivationPath(bip44Params.DerivationPath())
 		info, err := kb.CreateLedger(name, path, keys.Secp256k1)
 		if err != nil {
 			return err
@@ -196,7 +238,19 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		info, err := kb.CreateKey(name, seed, encryptPassword)
+		if err := keys.ValidateMnemonic(seed, language); err != nil {
+			return err
+		}
+
+		var bip39Passphrase string
+		if interactive {
+			bip39Passphrase, err = getBIP39Passphrase(buf)
+			if err != nil {
+				return err
+			}
+		}
+
+		info, err := kb.DeriveWithAlgo(name, seed, bip39Passphrase, encryptPassword, *bip44Params, algo)
 		if err != nil {
 			return err
 		}
@@ -215,8 +269,16 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(mnemonic) == 0 {
+		if language != keys.English {
+			return keys.ErrUnsupportedLanguage
+		}
+		entropySize, err := keys.MnemonicEntropySize(viper.GetInt(flagWords))
+		if err != nil {
+			return err
+		}
+
 		// read entropy seed straight from crypto.Rand and convert to mnemonic
-		entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
+		entropySeed, err := bip39.NewEntropy(entropySize)
 		if err != nil {
 			return err
 		}
@@ -230,27 +292,13 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	// get bip39 passphrase
 	var bip39Passphrase string
 	if interactive {
-		bip39Passphrase, err = client.GetString(
-			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
-				"Most users should just hit enter to use the default, \"\"", buf)
+		bip39Passphrase, err = getBIP39Passphrase(buf)
 		if err != nil {
 			return err
 		}
-
-		// if they use one, make them re-enter it
-		if len(bip39Passphrase) != 0 {
-			p2, err := client.GetString("Repeat the passphrase:", buf)
-			if err != nil {
-				return err
-			}
-
-			if bip39Passphrase != p2 {
-				return errors.New("passphrases don't match")
-			}
-		}
 	}
 
-	info, err := kb.Derive(name, mnemonic, bip39Passphrase, encryptPassword, *bip44Params)
+	info, err := kb.DeriveWithAlgo(name, mnemonic, bip39Passphrase, encryptPassword, *bip44Params, algo)
 	if err != nil {
 		return err
 	}
@@ -258,6 +306,29 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func getBIP39Passphrase(buf *bufio.Reader) (string, error) {
+	bip39Passphrase, err := client.GetString(
+		"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
+			"Most users should just hit enter to use the default, \"\"", buf)
+	if err != nil {
+		return "", err
+	}
+
+	// if they use one, make them re-enter it
+	if len(bip39Passphrase) != 0 {
+		p2, err := client.GetString("Repeat the passphrase:", buf)
+		if err != nil {
+			return "", err
+		}
+
+		if bip39Passphrase != p2 {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+
+	return bip39Passphrase, nil
+}
+
 func getBIP44ParamsAndPath(path string, flagSet bool) (*hd.BIP44Params, error) {
 	buf := client.BufferStdin()
 	bip44Path := path
@@ -346,6 +417,8 @@ type NewKeyBody struct {
 	Name     string `json:"name"`
 	Password string `json:"password"`
 	Seed     string `json:"seed"`
+	Account  uint32 `json:"account"`
+	Index    uint32 `json:"index"`
 }
 
 // add new key REST handler
@@ -402,7 +475,7 @@ func AddNewKeyRequestHandler(indent bool) http.HandlerFunc {
 		if seed == "" {
 			seed = getSeed(keys.Secp256k1)
 		}
-		info, err := kb.CreateKey(m.Name, seed, m.Password)
+		info, err := kb.CreateAccount(m.Name, seed, "", m.Password, m.Account, m.Index)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
@@ -442,6 +515,8 @@ func SeedRequestHandler(w http.ResponseWriter, r *http.Request) {
 type RecoverKeyBody struct {
 	Password string `json:"password"`
 	Seed     string `json:"seed"`
+	Account  uint32 `json:"account"`
+	Index    uint32 `json:"index"`
 }
 
 // RecoverRequestHandler performs key recover request
@@ -499,7 +574,7 @@ func RecoverRequestHandler(indent bool) http.HandlerFunc {
 			}
 		}
 
-		info, err := kb.CreateKey(name, m.Seed, m.Password)
+		info, err := kb.CreateAccount(name, m.Seed, "", m.Password, m.Account, m.Index)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
@@ -60,7 +60,7 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	buf := client.BufferStdin()
-	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline {
+	if info.GetType() == keys.TypeLedger || info.GetType() == keys.TypeOffline || info.GetType() == keys.TypeRemote {
 		if !viper.GetBool(flagYes) {
 			if err := confirmDeletion(buf); err != nil {
 				return err
@@ -0,0 +1,65 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func exportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <name>",
+		Short: "Export private keys",
+		Long: `Export a private key from the local keybase in ASCII-armored format,
+encrypted with a new passphrase. With --pubkey, only the public key is exported,
+unarmored in Bech32 format.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportCmd,
+	}
+	cmd.Flags().Bool(FlagPublicKey, false, "Export the public key only, in Bech32 format")
+	return cmd
+}
+
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	kb, err := GetKeyBase()
+	if err != nil {
+		return err
+	}
+
+	if viper.GetBool(FlagPublicKey) {
+		info, err := kb.Get(args[0])
+		if err != nil {
+			return err
+		}
+		bechPubKey, err := sdk.Bech32ifyAccPub(info.GetPubKey())
+		if err != nil {
+			return err
+		}
+		fmt.Println(bechPubKey)
+		return nil
+	}
+
+	buf := client.BufferStdin()
+	decryptPassword, err := client.GetPassword(
+		"Enter passphrase to decrypt your key:", buf)
+	if err != nil {
+		return err
+	}
+	encryptPassword, err := client.GetCheckPassword(
+		"Enter passphrase to encrypt the exported key:",
+		"Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	armored, err := kb.ExportPrivKey(args[0], decryptPassword, encryptPassword)
+	if err != nil {
+		return err
+	}
+	fmt.Println(armored)
+	return nil
+}
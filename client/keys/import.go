@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func importKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <name> <keyfile>",
+		Short: "Import private keys into the local keybase",
+		Long: `Import an ASCII-armored private key, as exported by 'keys export', into
+the local keybase. It is encrypted on disk with the passphrase it was exported
+with. With --pubkey, the keyfile holds a Bech32 public key, stored as an offline key.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runImportCmd,
+	}
+	cmd.Flags().Bool(FlagPublicKey, false, "Import a public key only, in Bech32 format")
+	return cmd
+}
+
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	bz, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	kb, err := GetKeyBaseWithWritePerm()
+	if err != nil {
+		return err
+	}
+
+	if _, err := kb.Get(name); err == nil {
+		return fmt.Errorf("cannot overwrite key %s", name)
+	}
+
+	if viper.GetBool(FlagPublicKey) {
+		pubKey, err := sdk.GetAccPubKeyBech32(strings.TrimSpace(string(bz)))
+		if err != nil {
+			return err
+		}
+		_, err = kb.CreateOffline(name, pubKey)
+		return err
+	}
+
+	buf := client.BufferStdin()
+	passphrase, err := client.GetPassword(
+		"Enter passphrase to decrypt your key:", buf)
+	if err != nil {
+		return err
+	}
+
+	if err := kb.ImportPrivKey(name, string(bz), passphrase); err != nil {
+		return err
+	}
+	fmt.Printf("Key %q imported\n", name)
+	return nil
+}
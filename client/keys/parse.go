@@ -0,0 +1,135 @@
+package keys
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/bech32"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseOutput holds the formats of an address, or of a public key along with
+// the formats of its address.
+type ParseOutput struct {
+	PubKeyHex     string `json:"pub_key_hex,omitempty"`
+	Bech32AccPub  string `json:"bech32_acc_pub,omitempty"`
+	Bech32ValPub  string `json:"bech32_val_pub,omitempty"`
+	Bech32ConsPub string `json:"bech32_cons_pub,omitempty"`
+	AddressHex    string `json:"address_hex"`
+	Bech32Acc     string `json:"bech32_acc"`
+	Bech32Val     string `json:"bech32_val"`
+	Bech32Cons    string `json:"bech32_cons"`
+}
+
+func parseKeyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "parse <hex-or-bech32>",
+		Short: "Convert addresses and public keys between hex and bech32 formats",
+		Long: `Convert an address or a public key from hex or from any of its bech32 formats
+to all the others. The bech32 formats are those of accounts, validator operators and
+validator consensus nodes. A hex public key is the hex of its amino encoding, as shown
+in Tendermint logs.
+
+Example:
+
+  gaiacli keys parse cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e
+  gaiacli keys parse 000102030405060708090A0B0C0D0E0F10111213
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runParseCmd,
+	}
+}
+
+func runParseCmd(_ *cobra.Command, args []string) error {
+	out, err := parseKey(strings.TrimSpace(args[0]))
+	if err != nil {
+		return err
+	}
+
+	switch viper.Get(cli.OutputFlag) {
+	case "text":
+		printParseOutput(out)
+	case "json":
+		bz, err := MarshalJSON(out)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bz))
+	}
+	return nil
+}
+
+// parseKey returns the formats of the given hex or bech32 address or public key.
+func parseKey(str string) (ParseOutput, error) {
+	if bz, err := hex.DecodeString(str); err == nil {
+		if len(bz) == sdk.AddrLen {
+			return addressOutput(bz), nil
+		}
+		var pub crypto.PubKey
+		if err := codec.Cdc.UnmarshalBinaryBare(bz, &pub); err != nil {
+			return ParseOutput{}, fmt.Errorf("%s is neither a hex address nor a hex public key", str)
+		}
+		return pubKeyOutput(pub)
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(str)
+	if err != nil {
+		return ParseOutput{}, fmt.Errorf("%s is neither hex nor bech32: %v", str, err)
+	}
+
+	config := sdk.GetConfig()
+	switch hrp {
+	case config.GetBech32AccountAddrPrefix(), config.GetBech32ValidatorAddrPrefix(), config.GetBech32ConsensusAddrPrefix():
+		return addressOutput(bz), nil
+	case config.GetBech32AccountPubPrefix(), config.GetBech32ValidatorPubPrefix(), config.GetBech32ConsensusPubPrefix():
+		var pub crypto.PubKey
+		if err := codec.Cdc.UnmarshalBinaryBare(bz, &pub); err != nil {
+			return ParseOutput{}, err
+		}
+		return pubKeyOutput(pub)
+	default:
+		return ParseOutput{}, fmt.Errorf("unknown bech32 prefix %s", hrp)
+	}
+}
+
+func addressOutput(addr []byte) ParseOutput {
+	return ParseOutput{
+		AddressHex: fmt.Sprintf("%X", addr),
+		Bech32Acc:  sdk.AccAddress(addr).String(),
+		Bech32Val:  sdk.ValAddress(addr).String(),
+		Bech32Cons: sdk.ConsAddress(addr).String(),
+	}
+}
+
+func pubKeyOutput(pub crypto.PubKey) (out ParseOutput, err error) {
+	out = addressOutput(pub.Address())
+	out.PubKeyHex = fmt.Sprintf("%X", pub.Bytes())
+	if out.Bech32AccPub, err = sdk.Bech32ifyAccPub(pub); err != nil {
+		return
+	}
+	if out.Bech32ValPub, err = sdk.Bech32ifyValPub(pub); err != nil {
+		return
+	}
+	out.Bech32ConsPub, err = sdk.Bech32ifyConsPub(pub)
+	return
+}
+
+func printParseOutput(out ParseOutput) {
+	if out.PubKeyHex != "" {
+		fmt.Printf("PubKey (hex):\t\t%s\n", out.PubKeyHex)
+		fmt.Printf("Bech32 Acc PubKey:\t%s\n", out.Bech32AccPub)
+		fmt.Printf("Bech32 Val PubKey:\t%s\n", out.Bech32ValPub)
+		fmt.Printf("Bech32 Cons PubKey:\t%s\n", out.Bech32ConsPub)
+	}
+	fmt.Printf("Address (hex):\t\t%s\n", out.AddressHex)
+	fmt.Printf("Bech32 Acc:\t\t%s\n", out.Bech32Acc)
+	fmt.Printf("Bech32 Val:\t\t%s\n", out.Bech32Val)
+	fmt.Printf("Bech32 Cons:\t\t%s\n", out.Bech32Cons)
+}
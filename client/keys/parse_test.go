@@ -0,0 +1,45 @@
+package keys
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseKey(t *testing.T) {
+	pub := secp256k1.GenPrivKey().PubKey()
+	addr := pub.Address()
+
+	// all the formats of an address parse to the same output
+	expected := addressOutput(addr)
+	require.Equal(t, fmt.Sprintf("%X", addr), expected.AddressHex)
+	for _, str := range []string{
+		expected.AddressHex, expected.Bech32Acc, expected.Bech32Val, expected.Bech32Cons,
+	} {
+		out, err := parseKey(str)
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	}
+	require.Equal(t, sdk.AccAddress(addr).String(), expected.Bech32Acc)
+
+	// all the formats of a public key parse to it and its address
+	expected, err := pubKeyOutput(pub)
+	require.NoError(t, err)
+	require.Equal(t, addressOutput(addr).Bech32Acc, expected.Bech32Acc)
+	for _, str := range []string{
+		expected.PubKeyHex, expected.Bech32AccPub, expected.Bech32ValPub, expected.Bech32ConsPub,
+	} {
+		out, err := parseKey(str)
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	}
+
+	for _, str := range []string{"", "0A0B", "notbech32", "other1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn8a9jte"} {
+		_, err := parseKey(str)
+		require.Error(t, err, str)
+	}
+}
@@ -24,6 +24,10 @@ func Commands() *cobra.Command {
 		addKeyCommand(),
 		listKeysCmd,
 		showKeysCmd(),
+		parseKeyCommand(),
+		client.LineBreak,
+		exportKeyCommand(),
+		importKeyCommand(),
 		client.LineBreak,
 		deleteKeyCommand(),
 		updateKeyCommand(),
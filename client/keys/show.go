@@ -28,6 +28,7 @@ const (
 	FlagBechPrefix = "bech"
 
 	flagMultiSigThreshold  = "multisig-threshold"
+	flagDevice             = "device"
 	defaultMultiSigKeyName = "multi"
 )
 
@@ -56,6 +57,7 @@ func showKeysCmd() *cobra.Command {
 	cmd.Flags().BoolP(FlagAddress, "a", false, "output the address only (overrides --output)")
 	cmd.Flags().BoolP(FlagPublicKey, "p", false, "output the public key only (overrides --output)")
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures")
+	cmd.Flags().BoolP(flagDevice, "d", false, "display the address on the Ledger device screen for verification (Ledger keys only)")
 
 	return cmd
 }
@@ -92,6 +94,7 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 
 	isShowAddr := viper.GetBool(FlagAddress)
 	isShowPubKey := viper.GetBool(FlagPublicKey)
+	isShowDevice := viper.GetBool(flagDevice)
 	isOutputSet := cmd.Flag(cli.OutputFlag).Changed
 
 	if isShowAddr && isShowPubKey {
@@ -102,7 +105,20 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("cannot use --output with --address or --pubkey")
 	}
 
-	bechKeyOut, err := getBechKeyOut(viper.GetString(FlagBechPrefix))
+	if isShowDevice {
+		if len(args) > 1 {
+			return errors.New("the device flag (-d) can only be used with a single key")
+		}
+		if info.GetType() != keys.TypeLedger {
+			return errors.New("the device flag (-d) is supported for Ledger keys only")
+		}
+		if isShowPubKey {
+			return errors.New("the device flag (-d) can only be used for addresses not pubkeys")
+		}
+	}
+
+	bechPrefix := viper.GetString(FlagBechPrefix)
+	bechKeyOut, err := getBechKeyOut(bechPrefix)
 	if err != nil {
 		return err
 	}
@@ -116,6 +132,10 @@ func runShowCmd(cmd *cobra.Command, args []string) (err error) {
 		printKeyInfo(info, bechKeyOut)
 	}
 
+	if isShowDevice {
+		return keys.ShowLedgerAddress(info, getBechAddrPrefix(bechPrefix))
+	}
+
 	return nil
 }
 
@@ -143,6 +163,19 @@ func getBechKeyOut(bechPrefix string) (bechKeyOutFn, error) {
 	return nil, fmt.Errorf("invalid Bech32 prefix encoding provided: %s", bechPrefix)
 }
 
+// getBechAddrPrefix returns the bech32 address prefix of the given, already
+// validated, Bech32 prefix encoding.
+func getBechAddrPrefix(bechPrefix string) string {
+	config := sdk.GetConfig()
+	switch bechPrefix {
+	case "val":
+		return config.GetBech32ValidatorAddrPrefix()
+	case "cons":
+		return config.GetBech32ConsensusAddrPrefix()
+	}
+	return config.GetBech32AccountAddrPrefix()
+}
+
 ///////////////////////////
 // REST
 
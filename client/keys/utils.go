@@ -3,12 +3,9 @@ package keys
 import (
 	"fmt"
 	"net/http"
-	"path/filepath"
 
 	"github.com/spf13/viper"
-	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/tendermint/tendermint/libs/cli"
-	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,8 +13,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// KeyDBName is the directory under root where we store the keys
-const KeyDBName = "keys"
+// KeyringServiceName is the name under which the keys are stored in the os
+// and pass keyring backends
+var KeyringServiceName = "cosmos"
 
 // keybase is used to make GetKeyBase a singleton
 var keybase keys.Keybase
@@ -89,30 +87,44 @@ func GetKeyBaseWithWritePerm() (keys.Keybase, error) {
 
 // GetKeyBaseFromDirWithWritePerm initializes a keybase at a particular dir with write permissions.
 func GetKeyBaseFromDirWithWritePerm(rootDir string) (keys.Keybase, error) {
-	return getKeyBaseFromDirWithOpts(rootDir, nil)
+	return getKeyBaseFromDir(rootDir)
 }
 
 // GetKeyBaseFromDir initializes a read-only keybase at a particular dir.
 func GetKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
-	// Disabled because of the inability to create a new keys database directory
-	// in the instance of when ReadOnly is set to true.
-	//
-	// ref: syndtr/goleveldb#240
-	// return getKeyBaseFromDirWithOpts(rootDir, &opt.Options{ReadOnly: true})
-	return getKeyBaseFromDirWithOpts(rootDir, nil)
+	return getKeyBaseFromDir(rootDir)
 }
 
-func getKeyBaseFromDirWithOpts(rootDir string, o *opt.Options) (keys.Keybase, error) {
+// getKeyBaseFromDir initializes a keybase in the keyring backend set by the
+// --keyring-backend flag, the leveldb backend by default. The keys of the
+// leveldb, file and test backends are stored in the given dir.
+func getKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	if keybase == nil {
-		db, err := dbm.NewGoLevelDBWithOpts(KeyDBName, filepath.Join(rootDir, "keys"), o)
+		backend := viper.GetString(client.FlagKeyringBackend)
+		if backend == "" {
+			backend = keys.BackendLevelDB
+		}
+
+		kb, err := keys.NewKeyring(KeyringServiceName, backend, rootDir, readKeyringPassphrase)
 		if err != nil {
 			return nil, err
 		}
-		keybase = client.GetKeyBase(db)
+		keybase = kb
 	}
 	return keybase, nil
 }
 
+// readKeyringPassphrase reads the passphrase of a file keyring from STDIN,
+// twice when the keyring is created.
+func readKeyringPassphrase(create bool) (string, error) {
+	buf := client.BufferStdin()
+	if create {
+		return client.GetCheckPassword(
+			"Enter a passphrase for the keyring:", "Repeat the passphrase:", buf)
+	}
+	return client.GetPassword("Enter the passphrase of the keyring:", buf)
+}
+
 // used to set the keybase manually in test
 func SetKeyBase(kb keys.Keybase) {
 	keybase = kb
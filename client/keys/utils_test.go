@@ -3,39 +3,50 @@ package keys
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 )
 
-func TestGetKeyBaseLocks(t *testing.T) {
+func TestGetKeyBaseBackend(t *testing.T) {
 	dir, err := ioutil.TempDir("", "cosmos-sdk-keys")
 	require.Nil(t, err)
 	defer os.RemoveAll(dir)
+	defer func() { keybase = nil }()
 
-	// Acquire db
+	// the keys are stored in the leveldb keybase under the keys dir by default
 	kb, err := GetKeyBaseFromDirWithWritePerm(dir)
 	require.Nil(t, err)
 	_, _, err = kb.CreateMnemonic("foo", keys.English, "12345678", keys.Secp256k1)
 	require.Nil(t, err)
-	// Reset global variable
+	kb.CloseDB()
 	keybase = nil
-	// Try to acquire another keybase from the same storage
-	_, err = GetKeyBaseFromDirWithWritePerm(dir)
-	require.NotNil(t, err)
+	_, err = os.Stat(filepath.Join(dir, "keys", "keys.db"))
+	require.Nil(t, err)
+
+	// unknown backend
+	viper.Set(client.FlagKeyringBackend, "unknown")
 	_, err = GetKeyBaseFromDirWithWritePerm(dir)
 	require.NotNil(t, err)
 
-	// Close the db and try to acquire the lock
-	kb.CloseDB()
+	// the keys are persisted in the selected backend
+	viper.Set(client.FlagKeyringBackend, keys.BackendTest)
+	defer viper.Set(client.FlagKeyringBackend, "")
 	kb, err = GetKeyBaseFromDirWithWritePerm(dir)
 	require.Nil(t, err)
-
-	// Try to acquire another read-only keybase from the same storage
-	_, err = GetKeyBaseFromDir(dir)
+	_, _, err = kb.CreateMnemonic("foo", keys.English, "12345678", keys.Secp256k1)
 	require.Nil(t, err)
 
-	kb.CloseDB()
+	// Reset global variable
+	keybase = nil
+	kb, err = GetKeyBaseFromDir(dir)
+	require.Nil(t, err)
+	info, err := kb.Get("foo")
+	require.Nil(t, err)
+	require.Equal(t, "foo", info.GetName())
 }
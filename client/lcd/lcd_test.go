@@ -10,12 +10,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	client "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
@@ -26,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -137,6 +142,33 @@ func TestBlock(t *testing.T) {
 	getBlock(t, port, 100000000, true)
 }
 
+func TestSubscribe(t *testing.T) {
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{})
+	defer cleanup()
+
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://localhost:%s/subscribe", port), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// invalid tags are reported
+	require.NoError(t, conn.WriteJSON(rpc.SubscriptionRequest{Action: rpc.SubscribeAction, Event: "Tx", Tags: []string{"action="}}))
+	var msg rpc.SubscriptionMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	require.Equal(t, rpc.MsgTypeError, msg.Type)
+
+	require.NoError(t, conn.WriteJSON(rpc.SubscriptionRequest{Action: rpc.SubscribeAction, Event: "NewBlock"}))
+	var subscribed rpc.SubscriptionMessage
+	require.NoError(t, conn.ReadJSON(&subscribed))
+	require.Equal(t, rpc.SubscriptionMessage{Type: rpc.MsgTypeSubscribed, Query: "tm.event='NewBlock'"}, subscribed)
+
+	// the new blocks are pushed
+	var event rpc.SubscriptionMessage
+	require.NoError(t, conn.ReadJSON(&event))
+	require.Equal(t, rpc.MsgTypeEvent, event.Type)
+	require.Equal(t, "tm.event='NewBlock'", event.Query)
+	require.Contains(t, string(event.Data), `"block"`)
+}
+
 func TestValidators(t *testing.T) {
 	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{})
 	defer cleanup()
@@ -293,6 +325,204 @@ func TestCoinSendGenerateSignAndBroadcast(t *testing.T) {
 	require.Equal(t, gasEstimate, resultTx.DeliverTx.GasWanted)
 }
 
+func TestMultisigGenerateSignAndBroadcast(t *testing.T) {
+	kb := GetKeyBase(t)
+	addr, _ := CreateAddr(t, name1, pw, kb)
+	CreateAddr(t, name2, pw, kb)
+	CreateAddr(t, name3, pw, kb)
+	var pubKeys []crypto.PubKey
+	for _, name := range []string{name1, name2, name3} {
+		info, err := kb.Get(name)
+		require.Nil(t, err)
+		pubKeys = append(pubKeys, info.GetPubKey())
+	}
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr, multisigAddr})
+	defer cleanup()
+	chainID := viper.GetString(client.FlagChainID)
+	acc := getAccount(t, port, addr)
+	coins := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 1)}
+
+	// generate a tx of the multisig account
+	generate := func(from sdk.AccAddress) (*http.Response, string) {
+		msg := bank.NewMsgSend(
+			[]bank.Input{bank.NewInput(from, coins)},
+			[]bank.Output{bank.NewOutput(addr, coins)},
+		)
+		json, err := cdc.MarshalJSON(authrest.MultisigGenerateBody{
+			Msgs:           []sdk.Msg{msg},
+			MultisigPubKey: multisigPub,
+			ChainID:        chainID,
+			Memo:           memo,
+			Fees:           fees,
+			Gas:            200000,
+		})
+		require.Nil(t, err)
+		return Request(t, port, "POST", "/tx/multisig/generate", json)
+	}
+	res, body := generate(addr)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+	res, body = generate(multisigAddr)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var msg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &msg))
+	require.Equal(t, []sdk.AccAddress{multisigAddr}, msg.GetSigners())
+	require.Equal(t, 0, len(msg.Signatures))
+
+	// sign with two of the keys and combine the signatures
+	sigs := []auth.StdSignature{
+		doMultisigSign(t, port, name1, pw, chainID, multisigAddr, msg),
+		doMultisigSign(t, port, name3, pw, chainID, multisigAddr, msg),
+	}
+	combine := func(sigs []auth.StdSignature) (*http.Response, string) {
+		json, err := cdc.MarshalJSON(authrest.MultisigCombineBody{
+			Tx:             msg,
+			MultisigPubKey: multisigPub,
+			Signatures:     sigs,
+			ChainID:        chainID,
+		})
+		require.Nil(t, err)
+		return Request(t, port, "POST", "/tx/multisig/combine", json)
+	}
+	signedWithOtherAccount := doSign(t, port, name2, pw, chainID, acc.GetAccountNumber(), acc.GetSequence(), msg)
+	res, body = combine(append(sigs, signedWithOtherAccount.Signatures[0]))
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+	res, body = combine(sigs)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var signedMsg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &signedMsg))
+	require.Equal(t, 1, len(signedMsg.Signatures))
+
+	// broadcast the tx
+	resultTx := doBroadcast(t, port, signedMsg)
+	require.Equal(t, uint32(0), resultTx.CheckTx.Code)
+	require.Equal(t, uint32(0), resultTx.DeliverTx.Code)
+	require.Equal(t, uint64(1), getAccount(t, port, multisigAddr).GetSequence())
+}
+
+func TestBroadcastModes(t *testing.T) {
+	addr, seed := CreateAddr(t, name1, pw, GetKeyBase(t))
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+	acc := getAccount(t, port, addr)
+	chainID := viper.GetString(client.FlagChainID)
+
+	res, body, _ := doTransferWithGas(t, port, seed, name1, memo, "", addr, "", 1, false, true, fees)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var msg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &msg))
+
+	broadcast := func(sequence uint64, path string, mode string) (*http.Response, string) {
+		signedMsg := doSign(t, port, name1, pw, chainID, acc.GetAccountNumber(), sequence, msg)
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(signedMsg)
+		require.Nil(t, err)
+		req, err := cdc.MarshalJSON(tx.BroadcastBody{TxBytes: txBytes, Mode: mode})
+		require.Nil(t, err)
+		return Request(t, port, "POST", path, req)
+	}
+
+	// invalid mode
+	res, body = broadcast(acc.GetSequence(), "/txs", "commit")
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+
+	// block mode returns the DeliverTx result
+	res, body = broadcast(acc.GetSequence(), "/txs", tx.BroadcastBlock)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var commitRes ctypes.ResultBroadcastTxCommit
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &commitRes))
+	require.Equal(t, uint32(0), commitRes.CheckTx.Code)
+	require.Equal(t, uint32(0), commitRes.DeliverTx.Code)
+	require.True(t, commitRes.Height > 0)
+
+	// sync mode returns the CheckTx result
+	res, body = broadcast(acc.GetSequence()+1, "/txs", tx.BroadcastSync)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var syncRes ctypes.ResultBroadcastTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &syncRes))
+	require.Equal(t, uint32(0), syncRes.Code)
+	require.NotEmpty(t, syncRes.Hash)
+
+	// async mode, given as a query parameter, returns the hash only
+	res, body = broadcast(acc.GetSequence()+2, "/txs?mode=async", "")
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var asyncRes tx.BroadcastAsyncResult
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &asyncRes))
+	require.NotEmpty(t, asyncRes.Hash)
+	require.NotContains(t, body, "code")
+}
+
+func TestDecodeTx(t *testing.T) {
+	addr, seed := CreateAddr(t, name1, pw, GetKeyBase(t))
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+	acc := getAccount(t, port, addr)
+
+	res, body, _ := doTransferWithGas(t, port, seed, name1, memo, "", addr, "", 1, false, true, fees)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var msg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &msg))
+	signedMsg := doSign(t, port, name1, pw, viper.GetString(client.FlagChainID), acc.GetAccountNumber(), acc.GetSequence(), msg)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(signedMsg)
+	require.Nil(t, err)
+
+	// decode the tx bytes
+	req, err := cdc.MarshalJSON(tx.DecodeBody{TxBytes: txBytes})
+	require.Nil(t, err)
+	res, body = Request(t, port, "POST", "/txs/decode", req)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var decodedMsg auth.StdTx
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &decodedMsg))
+	require.Equal(t, signedMsg, decodedMsg)
+
+	// invalid tx bytes
+	req, err = cdc.MarshalJSON(tx.DecodeBody{TxBytes: txBytes[:len(txBytes)/2]})
+	require.Nil(t, err)
+	res, body = Request(t, port, "POST", "/txs/decode", req)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+}
+
+func TestQueryHeight(t *testing.T) {
+	addr, seed := CreateAddr(t, name1, pw, GetKeyBase(t))
+	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+
+	getAccountAt := func(height int64) (auth.Account, int64) {
+		res, body := Request(t, port, "GET", fmt.Sprintf("/auth/accounts/%s?height=%d", addr, height), nil)
+		require.Equal(t, http.StatusOK, res.StatusCode, body)
+		var resp utils.ResponseWithHeight
+		require.Nil(t, json.Unmarshal([]byte(body), &resp))
+		var acc auth.Account
+		require.Nil(t, cdc.UnmarshalJSON(resp.Result, &acc))
+		return acc, resp.Height
+	}
+
+	// the latest query reports its height
+	initialAcc, height := getAccountAt(0)
+	require.True(t, height > 0)
+
+	_, resultTx := doTransfer(t, port, seed, name1, memo, pw, addr, fees)
+	tests.WaitForHeight(resultTx.Height+1, port)
+	require.NotEqual(t, initialAcc.GetCoins(), getAccount(t, port, addr).GetCoins())
+
+	// the state of a previous height is queried
+	acc, accHeight := getAccountAt(height)
+	require.Equal(t, height, accHeight)
+	require.Equal(t, initialAcc.GetCoins(), acc.GetCoins())
+
+	// custom queries are executed at the height too
+	res, body := Request(t, port, "GET", fmt.Sprintf("/staking/pool?height=%d", height), nil)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	var resp utils.ResponseWithHeight
+	require.Nil(t, json.Unmarshal([]byte(body), &resp))
+	require.Equal(t, height, resp.Height)
+
+	// invalid heights are rejected
+	res, body = Request(t, port, "GET", fmt.Sprintf("/auth/accounts/%s?height=latest", addr), nil)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
+}
+
 func TestTxs(t *testing.T) {
 	addr, seed := CreateAddr(t, name1, pw, GetKeyBase(t))
 	cleanup, _, _, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
@@ -331,6 +561,16 @@ func TestTxs(t *testing.T) {
 	txs = getTransactions(t, port, fmt.Sprintf("recipient=%s", receiveAddr.String()))
 	require.Len(t, txs, 1)
 	require.Equal(t, resultTx.Height, txs[0].Height)
+	require.NotEmpty(t, txs[0].Timestamp)
+
+	// query with tag filters, which are ANDed
+	txs = getTransactions(t, port, fmt.Sprintf("tag=sender:%s&tag=recipient:%s", addr, receiveAddr))
+	require.Len(t, txs, 1)
+	require.Equal(t, resultTx.Hash, txs[0].Hash)
+	txs = getTransactions(t, port, fmt.Sprintf("tag=sender:%s&tag=recipient:%s", addr, addr))
+	require.Equal(t, emptyTxs, txs)
+	res, body := Request(t, port, "GET", "/txs?tag=sender", nil)
+	require.Equal(t, http.StatusBadRequest, res.StatusCode, body)
 }
 
 func TestPoolParamsQuery(t *testing.T) {
@@ -855,6 +1095,6 @@ func TestSlashingGetParams(t *testing.T) {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var params slashing.Params
-	err := cdc.UnmarshalJSON([]byte(body), &params)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &params)
 	require.NoError(t, err)
 }
@@ -0,0 +1,106 @@
+package lcd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORSHandler(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	request := func(h http.Handler, method, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/node_info", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if method == http.MethodOptions {
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	h := corsHandler(ok, []string{"https://wallet.example.com"})
+
+	// allowed origin
+	rec := request(h, http.MethodGet, "https://wallet.example.com")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "https://wallet.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// preflight of an allowed origin
+	rec = request(h, http.MethodOptions, "https://wallet.example.com")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, corsAllowedMethods, rec.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, corsAllowedHeaders, rec.Header().Get("Access-Control-Allow-Headers"))
+
+	// other origins and same-origin requests get no CORS headers
+	rec = request(h, http.MethodGet, "https://evil.example.com")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	rec = request(h, http.MethodGet, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// any origin
+	h = corsHandler(ok, []string{"*"})
+	rec = request(h, http.MethodGet, "https://evil.example.com")
+	require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter(2, 3)
+	rl.now = func() time.Time { return now }
+
+	// a burst of 3 requests, then 2 per second
+	for i := 0; i < 3; i++ {
+		ok, _ := rl.allow("1.2.3.4")
+		require.True(t, ok, i)
+	}
+	ok, wait := rl.allow("1.2.3.4")
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, wait)
+
+	// the clients are limited independently
+	ok, _ = rl.allow("5.6.7.8")
+	require.True(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = rl.allow("1.2.3.4")
+	require.True(t, ok)
+	ok, _ = rl.allow("1.2.3.4")
+	require.False(t, ok)
+
+	// the idle clients are dropped
+	now = now.Add(time.Minute)
+	rl.dropIdle(now)
+	require.Empty(t, rl.buckets)
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	h := rateLimitHandler(ok, newRateLimiter(1, 1))
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/node_info", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusOK, request("1.2.3.4:1000").Code)
+	// the port of the client doesn't matter
+	rec := request("1.2.3.4:2000")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Equal(t, http.StatusOK, request("5.6.7.8:1000").Code)
+}
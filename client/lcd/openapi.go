@@ -0,0 +1,185 @@
+package lcd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// OpenAPIRoute is the route of the OpenAPI document of the registered routes.
+const OpenAPIRoute = "/openapi.json"
+
+// path parameters of route templates, e.g. {height} or {height:[0-9]+}
+var pathParamRegexp = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// OpenAPIRequestHandler returns a handler serving the OpenAPI (Swagger 2.0)
+// document of the routes registered on the router at the time of the request,
+// so that it stays in sync with the route registration. The documentation of
+// the routes, e.g. their request and response schemas, is taken from the
+// swagger.yaml of the given file system, and undocumented routes are listed
+// with their path parameters only.
+func OpenAPIRequestHandler(router *mux.Router, docsFS http.FileSystem) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		base, err := readSwaggerSpec(docsFS)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		spec, err := openAPISpec(router, base)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		output, err := json.MarshalIndent(spec, "", "  ")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(output)
+	}
+}
+
+// readSwaggerSpec reads the swagger.yaml of the file system, if any.
+func readSwaggerSpec(docsFS http.FileSystem) (map[string]interface{}, error) {
+	if docsFS == nil {
+		return nil, nil
+	}
+	file, err := docsFS.Open("/swagger.yaml")
+	if err != nil {
+		return nil, nil
+	}
+	defer file.Close()
+
+	bz, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	var spec interface{}
+	if err := yaml.Unmarshal(bz, &spec); err != nil {
+		return nil, fmt.Errorf("invalid swagger.yaml: %v", err)
+	}
+	specMap, ok := stringKeys(spec).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid swagger.yaml: not an object")
+	}
+	return specMap, nil
+}
+
+// openAPISpec returns the OpenAPI document of the routes of the router which
+// have methods, along with the documentation of the base document for those
+// routes. The paths of the base document which aren't routes are left out.
+func openAPISpec(router *mux.Router, base map[string]interface{}) (map[string]interface{}, error) {
+	spec := map[string]interface{}{
+		"swagger": "2.0",
+		"info": map[string]interface{}{
+			"title":   "Gaia-Lite for Cosmos",
+			"version": "1.0",
+		},
+	}
+	for key, value := range base {
+		spec[key] = value
+	}
+	basePaths, _ := base["paths"].(map[string]interface{})
+
+	paths := make(map[string]interface{})
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		path := pathParamRegexp.ReplaceAllString(template, "{$1}")
+		if path == OpenAPIRoute {
+			return nil
+		}
+		baseItem, _ := basePaths[path].(map[string]interface{})
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			if params, ok := baseItem["parameters"]; ok {
+				item["parameters"] = params
+			}
+			paths[path] = item
+		}
+
+		for _, method := range methods {
+			method = strings.ToLower(method)
+			if op, ok := baseItem[method]; ok {
+				item[method] = op
+				continue
+			}
+			item[method] = undocumentedOperation(path, baseItem["parameters"] == nil)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spec["paths"] = paths
+	return spec, nil
+}
+
+// undocumentedOperation returns the operation of an undocumented route, with
+// its path parameters unless they are documented for the path.
+func undocumentedOperation(path string, withParams bool) map[string]interface{} {
+	var params []interface{}
+	for _, match := range pathParamRegexp.FindAllStringSubmatch(path, -1) {
+		if !withParams {
+			break
+		}
+		params = append(params, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"type":     "string",
+		})
+	}
+
+	op := map[string]interface{}{
+		"summary": "Undocumented route",
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{"description": "OK"},
+		},
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	return op
+}
+
+// stringKeys converts the maps decoded from YAML, keyed by interface{}, into
+// maps keyed by string so that they can be encoded in JSON.
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprintf("%v", key)] = stringKeys(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = stringKeys(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,48 @@
+package lcd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIRequestHandler(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+	router := mux.NewRouter()
+	router.PathPrefix("/swagger-ui/").HandlerFunc(noop)
+	router.HandleFunc(OpenAPIRoute, OpenAPIRequestHandler(router, http.Dir("swagger-ui"))).Methods("GET")
+	router.HandleFunc("/node_info", noop).Methods("GET")
+	router.HandleFunc("/keys/{name}", noop).Methods("GET", "PUT")
+	router.HandleFunc("/blocks/{height:[0-9]+}/extra", noop).Methods("GET")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", OpenAPIRoute, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var spec struct {
+		Swagger string                            `json:"swagger"`
+		Paths   map[string]map[string]interface{} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+	require.Equal(t, "2.0", spec.Swagger)
+
+	// only the registered routes with methods are documented
+	require.Len(t, spec.Paths, 3)
+	require.Contains(t, spec.Paths["/node_info"], "get")
+	require.Contains(t, spec.Paths["/node_info"]["get"], "responses")
+	require.NotEqual(t, "Undocumented route", spec.Paths["/node_info"]["get"].(map[string]interface{})["summary"])
+	require.Contains(t, spec.Paths["/keys/{name}"], "get")
+	require.Contains(t, spec.Paths["/keys/{name}"], "put")
+	require.Contains(t, spec.Paths["/keys/{name}"], "parameters")
+
+	// undocumented routes are listed with their path parameters
+	extra := spec.Paths["/blocks/{height}/extra"]["get"].(map[string]interface{})
+	require.Equal(t, "Undocumented route", extra["summary"])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"name": "height", "in": "path", "required": true, "type": "string",
+	}}, extra["parameters"])
+}
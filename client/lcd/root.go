@@ -1,11 +1,14 @@
 package lcd
 
 import (
+	gocontext "context"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
@@ -25,6 +28,16 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/client/lcd/statik"
 )
 
+// ShutdownTimeout is how long the rest server waits for the pending requests
+// to complete when it is stopped.
+const ShutdownTimeout = 10 * time.Second
+
+// limits of the requests, as those of the Tendermint RPC server
+const (
+	maxBodyBytes   = int64(1000000) // 1MB
+	maxHeaderBytes = 1 << 20
+)
+
 // RestServer represents the Light Client Rest server
 type RestServer struct {
 	Mux     *mux.Router
@@ -34,7 +47,16 @@ type RestServer struct {
 
 	log         log.Logger
 	listener    net.Listener
+	server      *http.Server
 	fingerprint string
+
+	// files of the generated self-signed certificate, removed on shutdown
+	generatedFiles []string
+
+	// origins allowed to make CORS requests, none if empty
+	corsOrigins []string
+	// limiter of the rate of the requests of each client IP, if any
+	rateLimiter *rateLimiter
 }
 
 // NewRestServer creates a new rest server instance
@@ -68,61 +90,127 @@ func (rs *RestServer) setKeybase(kb keybase.Keybase) {
 	rs.KeyBase = kb
 }
 
-// Start starts the rest server
+// Start starts the rest server, serving over HTTPS unless insecure. The
+// certificate is read from the given cert/key files, or else a self-signed
+// certificate is generated for the given hosts. It blocks until the server is
+// stopped, which it is gracefully on SIGINT or SIGTERM.
 func (rs *RestServer) Start(listenAddr string, sslHosts string,
 	certFile string, keyFile string, maxOpen int, insecure bool) (err error) {
 
-	server.TrapSignal(func() {
-		err := rs.listener.Close()
-		rs.log.Error("error closing listener", "err", err)
-	})
+	if !insecure {
+		certFile, keyFile, err = rs.setupCertificate(sslHosts, certFile, keyFile)
+		if err != nil {
+			return err
+		}
+	}
 
 	rs.listener, err = rpcserver.Listen(
 		listenAddr,
 		rpcserver.Config{MaxOpenConnections: maxOpen},
 	)
 	if err != nil {
+		rs.removeGeneratedFiles()
 		return
 	}
+	rs.server = &http.Server{
+		Handler:        rpcserver.RecoverAndLogHandler(rs.handler(), rs.log),
+		ReadTimeout:    rpcserver.ReadTimeout,
+		WriteTimeout:   rpcserver.WriteTimeout,
+		MaxHeaderBytes: maxHeaderBytes,
+	}
+
+	server.TrapSignal(func() {
+		if err := rs.Stop(); err != nil {
+			rs.log.Error("error stopping the rest server", "err", err)
+		}
+	})
 	rs.log.Info("Starting Gaia Lite REST service...")
 
 	// launch rest-server in insecure mode
 	if insecure {
-		return rpcserver.StartHTTPServer(rs.listener, rs.Mux, rs.log)
+		rs.log.Info(fmt.Sprintf("Serving HTTP on %s", rs.listener.Addr()))
+		err = rs.server.Serve(rs.listener)
+	} else {
+		rs.log.Info(fmt.Sprintf("Serving HTTPS on %s", rs.listener.Addr()), "fingerprint", rs.fingerprint)
+		err = rs.server.ServeTLS(rs.listener, certFile, keyFile)
 	}
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
 
-	// handle certificates
-	if certFile != "" {
-		// validateCertKeyFiles() is needed to work around tendermint/tendermint#2460
-		if err := validateCertKeyFiles(certFile, keyFile); err != nil {
-			return err
-		}
+// Stop gracefully stops the rest server, waiting up to ShutdownTimeout for the
+// pending requests to complete, and removes the generated certificate, if any.
+func (rs *RestServer) Stop() error {
+	defer rs.removeGeneratedFiles()
+	if rs.server == nil {
+		return nil
+	}
 
-		//  cert/key pair is provided, read the fingerprint
-		rs.fingerprint, err = fingerprintFromFile(certFile)
-		if err != nil {
-			return err
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), ShutdownTimeout)
+	defer cancel()
+	return rs.server.Shutdown(ctx)
+}
+
+// setupCertificate returns the given cert/key files once validated, or else
+// the files of a self-signed certificate generated for the given hosts, and
+// sets the fingerprint of the certificate.
+func (rs *RestServer) setupCertificate(sslHosts, certFile, keyFile string) (string, string, error) {
+	var err error
+	if certFile == "" {
+		if keyFile != "" {
+			return "", "", errors.New("a certificate file is required along with the key file")
 		}
-	} else {
+
 		// if certificate is not supplied, generate a self-signed one
 		certFile, keyFile, rs.fingerprint, err = genCertKeyFilesAndReturnFingerprint(sslHosts)
 		if err != nil {
-			return err
+			return "", "", err
 		}
+		rs.generatedFiles = []string{certFile, keyFile}
+		return certFile, keyFile, nil
+	}
 
-		defer func() {
-			os.Remove(certFile)
-			os.Remove(keyFile)
-		}()
+	// validateCertKeyFiles() is needed to work around tendermint/tendermint#2460
+	if err := validateCertKeyFiles(certFile, keyFile); err != nil {
+		return "", "", err
 	}
 
-	rs.log.Info(rs.fingerprint)
-	return rpcserver.StartHTTPAndTLSServer(
-		rs.listener,
-		rs.Mux,
-		certFile, keyFile,
-		rs.log,
-	)
+	//  cert/key pair is provided, read the fingerprint
+	rs.fingerprint, err = fingerprintFromFile(certFile)
+	if err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
+}
+
+// handler returns the handler of the routes, wrapped in the CORS and rate
+// limiting middlewares which are configured. The CORS headers are set on the
+// rate limited responses too, so that browsers can read them.
+func (rs *RestServer) handler() http.Handler {
+	h := limitBodySize(rs.Mux)
+	if rs.rateLimiter != nil {
+		h = rateLimitHandler(h, rs.rateLimiter)
+	}
+	if len(rs.corsOrigins) > 0 {
+		h = corsHandler(h, rs.corsOrigins)
+	}
+	return h
+}
+
+func limitBodySize(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (rs *RestServer) removeGeneratedFiles() {
+	for _, file := range rs.generatedFiles {
+		os.Remove(file)
+	}
+	rs.generatedFiles = nil
 }
 
 // ServeCommand will start a Gaia Lite REST service as a blocking process. It
@@ -138,6 +226,17 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 			rs.setKeybase(nil)
 			registerRoutesFn(rs)
 
+			for _, origin := range strings.Split(viper.GetString(client.FlagCORS), ",") {
+				if origin = strings.TrimSpace(origin); origin != "" {
+					rs.corsOrigins = append(rs.corsOrigins, origin)
+				}
+			}
+			if rate := viper.GetFloat64(client.FlagRateLimit); rate > 0 {
+				rs.rateLimiter = newRateLimiter(rate, viper.GetInt(client.FlagRateLimitBurst))
+			} else if rate < 0 {
+				return fmt.Errorf("invalid --%s %v: must be positive, or 0 for no limit", client.FlagRateLimit, rate)
+			}
+
 			// Start the rest server and return error if one exists
 			err = rs.Start(
 				viper.GetString(client.FlagListenAddr),
@@ -156,13 +255,16 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 	return cmd
 }
 
-func (rs *RestServer) registerSwaggerUI() {
+// RegisterSwaggerUI registers the Swagger UI under /swagger-ui/ and the
+// OpenAPI document of the routes of the server under OpenAPIRoute.
+func (rs *RestServer) RegisterSwaggerUI() {
 	statikFS, err := fs.New()
 	if err != nil {
 		panic(err)
 	}
 	staticServer := http.FileServer(statikFS)
 	rs.Mux.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", staticServer))
+	rs.Mux.HandleFunc(OpenAPIRoute, OpenAPIRequestHandler(rs.Mux, statikFS)).Methods("GET")
 }
 
 func validateCertKeyFiles(certFile, keyFile string) error {
@@ -0,0 +1,75 @@
+package lcd
+
+import (
+	"crypto/tls"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestRestServerTLS(t *testing.T) {
+	listenAddr, port, err := server.FreeTCPAddr()
+	require.NoError(t, err)
+
+	rs := NewRestServer(cdc)
+	rs.Mux.HandleFunc("/ping", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("pong"))
+	}).Methods("GET")
+
+	done := make(chan error)
+	go func() {
+		done <- rs.Start(listenAddr, "localhost", "", "", 10, false)
+	}()
+
+	// the self-signed certificate is served over HTTPS
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+	var res *http.Response
+	for i := 0; i < 50; i++ {
+		res, err = client.Get("https://localhost:" + port + "/ping")
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NotEmpty(t, res.TLS.PeerCertificates)
+	require.True(t, strings.HasPrefix(rs.fingerprint, "SHA256 Fingerprint="), rs.fingerprint)
+
+	// plain HTTP isn't served
+	res, err = http.Get("http://localhost:" + port + "/ping")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	// the server stops gracefully, removing the generated certificate
+	files := rs.generatedFiles
+	require.Len(t, files, 2)
+	require.NoError(t, rs.Stop())
+	require.NoError(t, <-done)
+	for _, file := range files {
+		_, err := os.Stat(file)
+		require.True(t, os.IsNotExist(err), file)
+	}
+}
+
+func TestRestServerCertificateFiles(t *testing.T) {
+	rs := NewRestServer(cdc)
+
+	// a key file requires a certificate file
+	_, _, err := rs.setupCertificate("localhost", "", "key.pem")
+	require.Error(t, err)
+
+	// a certificate file requires a key file
+	_, _, err = rs.setupCertificate("localhost", "cert.pem", "")
+	require.Error(t, err)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -60,7 +62,9 @@ import (
 
 	authRest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	bankRest "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	evidenceRest "github.com/cosmos/cosmos-sdk/x/evidence/client/rest"
 	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	mintRest "github.com/cosmos/cosmos-sdk/x/mint/client/rest"
 	slashingRest "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	stakingRest "github.com/cosmos/cosmos-sdk/x/staking/client/rest"
 )
@@ -114,6 +118,7 @@ func GetKeyBase(t *testing.T) crkeys.Keybase {
 	require.NoError(t, err)
 
 	viper.Set(cli.HomeFlag, dir)
+	viper.Set(client.FlagKeyringBackend, crkeys.BackendTest)
 
 	keybase, err := keys.GetKeyBaseWithWritePerm()
 	require.NoError(t, err)
@@ -232,7 +237,9 @@ func InitializeTestLCD(
 	privVal.Reset()
 
 	db := dbm.NewMemDB()
-	app := gapp.NewGaiaApp(logger, db, nil, true)
+	// keep the recent states, as gaiad does by default, for the queries at
+	// previous heights
+	app := gapp.NewGaiaApp(logger, db, nil, true, baseapp.SetPruning(store.PruneSyncable))
 	cdc = gapp.MakeCodec()
 
 	genesisFile := config.GenesisFile()
@@ -393,6 +400,8 @@ func registerRoutes(rs *RestServer) {
 	stakingRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashingRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	govRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	mintRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	evidenceRest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 }
 
 // Request makes a test LCD test request. It returns a response object and a
@@ -418,6 +427,15 @@ func Request(t *testing.T, port, method, path string, payload []byte) (*http.Res
 	return res, string(output)
 }
 
+// extractResultFromResponse returns the result of the response of a query,
+// checking that it holds the height of the query.
+func extractResultFromResponse(t *testing.T, body []byte) []byte {
+	var resp utils.ResponseWithHeight
+	require.Nil(t, json.Unmarshal(body, &resp), string(body))
+	require.True(t, resp.Height > 0, string(body))
+	return resp.Result
+}
+
 // ----------------------------------------------------------------------
 // ICS 0 - Tendermint
 // ----------------------------------------------------------------------
@@ -632,7 +650,7 @@ func getAccount(t *testing.T, port string, addr sdk.AccAddress) auth.Account {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/auth/accounts/%s", addr.String()), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var acc auth.Account
-	err := cdc.UnmarshalJSON([]byte(body), &acc)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &acc)
 	require.Nil(t, err)
 	return acc
 }
@@ -658,6 +676,24 @@ func doSign(t *testing.T, port, name, password, chainID string, accnum, sequence
 	return signedMsg
 }
 
+// POST /tx/multisig/sign Sign a Tx with a key of a multisig key
+func doMultisigSign(t *testing.T, port, name, password, chainID string, multisigAddr sdk.AccAddress, msg auth.StdTx) auth.StdSignature {
+	var sig auth.StdSignature
+	payload := authrest.MultisigSignBody{
+		Tx:              msg,
+		MultisigAddress: multisigAddr.String(),
+		BaseReq: utils.NewBaseReq(
+			name, password, "", chainID, "", "", 0, 0, nil, false, false,
+		),
+	}
+	json, err := cdc.MarshalJSON(payload)
+	require.Nil(t, err)
+	res, body := Request(t, port, "POST", "/tx/multisig/sign", json)
+	require.Equal(t, http.StatusOK, res.StatusCode, body)
+	require.Nil(t, cdc.UnmarshalJSON([]byte(body), &sig))
+	return sig
+}
+
 // POST /tx/broadcast Send a signed Tx
 func doBroadcast(t *testing.T, port string, msg auth.StdTx) ctypes.ResultBroadcastTxCommit {
 	tx := broadcastReq{Tx: msg, Return: "block"}
@@ -843,7 +879,7 @@ func getDelegatorDelegations(t *testing.T, port string, delegatorAddr sdk.AccAdd
 
 	var dels []staking.Delegation
 
-	err := cdc.UnmarshalJSON([]byte(body), &dels)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &dels)
 	require.Nil(t, err)
 
 	return dels
@@ -856,7 +892,7 @@ func getDelegatorUnbondingDelegations(t *testing.T, port string, delegatorAddr s
 
 	var ubds []staking.UnbondingDelegation
 
-	err := cdc.UnmarshalJSON([]byte(body), &ubds)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &ubds)
 	require.Nil(t, err)
 
 	return ubds
@@ -879,7 +915,7 @@ func getRedelegations(t *testing.T, port string, delegatorAddr sdk.AccAddress, s
 	res, body = Request(t, port, "GET", endpoint, nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var redels []staking.Redelegation
-	err := cdc.UnmarshalJSON([]byte(body), &redels)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &redels)
 	require.Nil(t, err)
 	return redels
 }
@@ -891,7 +927,7 @@ func getDelegatorValidators(t *testing.T, port string, delegatorAddr sdk.AccAddr
 
 	var bondedValidators []staking.Validator
 
-	err := cdc.UnmarshalJSON([]byte(body), &bondedValidators)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &bondedValidators)
 	require.Nil(t, err)
 
 	return bondedValidators
@@ -903,7 +939,7 @@ func getDelegatorValidator(t *testing.T, port string, delegatorAddr sdk.AccAddre
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var bondedValidator staking.Validator
-	err := cdc.UnmarshalJSON([]byte(body), &bondedValidator)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &bondedValidator)
 	require.Nil(t, err)
 
 	return bondedValidator
@@ -935,7 +971,7 @@ func getDelegation(t *testing.T, port string, delegatorAddr sdk.AccAddress, vali
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var bond staking.Delegation
-	err := cdc.UnmarshalJSON([]byte(body), &bond)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &bond)
 	require.Nil(t, err)
 
 	return bond
@@ -952,7 +988,7 @@ func getUnbondingDelegation(t *testing.T, port string, delegatorAddr sdk.AccAddr
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var unbond staking.UnbondingDelegation
-	err := cdc.UnmarshalJSON([]byte(body), &unbond)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &unbond)
 	require.Nil(t, err)
 
 	return unbond
@@ -964,7 +1000,7 @@ func getValidators(t *testing.T, port string) []staking.Validator {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var validators []staking.Validator
-	err := cdc.UnmarshalJSON([]byte(body), &validators)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &validators)
 	require.Nil(t, err)
 
 	return validators
@@ -976,7 +1012,7 @@ func getValidator(t *testing.T, port string, validatorAddr sdk.ValAddress) staki
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var validator staking.Validator
-	err := cdc.UnmarshalJSON([]byte(body), &validator)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &validator)
 	require.Nil(t, err)
 
 	return validator
@@ -988,7 +1024,7 @@ func getValidatorDelegations(t *testing.T, port string, validatorAddr sdk.ValAdd
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var delegations []staking.Delegation
-	err := cdc.UnmarshalJSON([]byte(body), &delegations)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &delegations)
 	require.Nil(t, err)
 
 	return delegations
@@ -1000,7 +1036,7 @@ func getValidatorUnbondingDelegations(t *testing.T, port string, validatorAddr s
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var ubds []staking.UnbondingDelegation
-	err := cdc.UnmarshalJSON([]byte(body), &ubds)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &ubds)
 	require.Nil(t, err)
 
 	return ubds
@@ -1012,7 +1048,7 @@ func getStakingPool(t *testing.T, port string) staking.Pool {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	require.NotNil(t, body)
 	var pool staking.Pool
-	err := cdc.UnmarshalJSON([]byte(body), &pool)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &pool)
 	require.Nil(t, err)
 	return pool
 }
@@ -1023,7 +1059,7 @@ func getStakingParams(t *testing.T, port string) staking.Params {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var params staking.Params
-	err := cdc.UnmarshalJSON([]byte(body), &params)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &params)
 	require.Nil(t, err)
 	return params
 }
@@ -1077,7 +1113,7 @@ func getProposalsAll(t *testing.T, port string) []gov.Proposal {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var proposals []gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposals)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposals)
 	require.Nil(t, err)
 	return proposals
 }
@@ -1088,7 +1124,7 @@ func getProposalsFilterDepositor(t *testing.T, port string, depositorAddr sdk.Ac
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var proposals []gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposals)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposals)
 	require.Nil(t, err)
 	return proposals
 }
@@ -1099,7 +1135,7 @@ func getProposalsFilterVoter(t *testing.T, port string, voterAddr sdk.AccAddress
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var proposals []gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposals)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposals)
 	require.Nil(t, err)
 	return proposals
 }
@@ -1110,7 +1146,7 @@ func getProposalsFilterVoterDepositor(t *testing.T, port string, voterAddr, depo
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var proposals []gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposals)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposals)
 	require.Nil(t, err)
 	return proposals
 }
@@ -1121,7 +1157,7 @@ func getProposalsFilterStatus(t *testing.T, port string, status gov.ProposalStat
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var proposals []gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposals)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposals)
 	require.Nil(t, err)
 	return proposals
 }
@@ -1165,7 +1201,7 @@ func getDeposits(t *testing.T, port string, proposalID uint64) []gov.Deposit {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d/deposits", proposalID), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var deposits []gov.Deposit
-	err := cdc.UnmarshalJSON([]byte(body), &deposits)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &deposits)
 	require.Nil(t, err)
 	return deposits
 }
@@ -1175,7 +1211,7 @@ func getTally(t *testing.T, port string, proposalID uint64) gov.TallyResult {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d/tally", proposalID), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var tally gov.TallyResult
-	err := cdc.UnmarshalJSON([]byte(body), &tally)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &tally)
 	require.Nil(t, err)
 	return tally
 }
@@ -1219,7 +1255,7 @@ func getVotes(t *testing.T, port string, proposalID uint64) []gov.Vote {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d/votes", proposalID), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var votes []gov.Vote
-	err := cdc.UnmarshalJSON([]byte(body), &votes)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &votes)
 	require.Nil(t, err)
 	return votes
 }
@@ -1229,7 +1265,7 @@ func getProposal(t *testing.T, port string, proposalID uint64) gov.Proposal {
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d", proposalID), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var proposal gov.Proposal
-	err := cdc.UnmarshalJSON([]byte(body), &proposal)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &proposal)
 	require.Nil(t, err)
 	return proposal
 }
@@ -1239,7 +1275,7 @@ func getDeposit(t *testing.T, port string, proposalID uint64, depositorAddr sdk.
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d/deposits/%s", proposalID, depositorAddr), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var deposit gov.Deposit
-	err := cdc.UnmarshalJSON([]byte(body), &deposit)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &deposit)
 	require.Nil(t, err)
 	return deposit
 }
@@ -1249,7 +1285,7 @@ func getVote(t *testing.T, port string, proposalID uint64, voterAddr sdk.AccAddr
 	res, body := Request(t, port, "GET", fmt.Sprintf("/gov/proposals/%d/votes/%s", proposalID, voterAddr), nil)
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 	var vote gov.Vote
-	err := cdc.UnmarshalJSON([]byte(body), &vote)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &vote)
 	require.Nil(t, err)
 	return vote
 }
@@ -1272,7 +1308,7 @@ func getDepositParam(t *testing.T, port string) gov.DepositParams {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var depositParams gov.DepositParams
-	err := cdc.UnmarshalJSON([]byte(body), &depositParams)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &depositParams)
 	require.Nil(t, err)
 	return depositParams
 }
@@ -1283,7 +1319,7 @@ func getTallyingParam(t *testing.T, port string) gov.TallyParams {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var tallyParams gov.TallyParams
-	err := cdc.UnmarshalJSON([]byte(body), &tallyParams)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &tallyParams)
 	require.Nil(t, err)
 	return tallyParams
 }
@@ -1294,7 +1330,7 @@ func getVotingParam(t *testing.T, port string) gov.VotingParams {
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var votingParams gov.VotingParams
-	err := cdc.UnmarshalJSON([]byte(body), &votingParams)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &votingParams)
 	require.Nil(t, err)
 	return votingParams
 }
@@ -1308,7 +1344,7 @@ func getSigningInfo(t *testing.T, port string, validatorPubKey string) slashing.
 	require.Equal(t, http.StatusOK, res.StatusCode, body)
 
 	var signingInfo slashing.ValidatorSigningInfo
-	err := cdc.UnmarshalJSON([]byte(body), &signingInfo)
+	err := cdc.UnmarshalJSON(extractResultFromResponse(t, []byte(body)), &signingInfo)
 	require.Nil(t, err)
 
 	return signingInfo
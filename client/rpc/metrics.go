@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+const flagReset = "reset"
+
+// StoreStatsCommand returns the statistics of the operations on the stores
+// of the node
+func StoreStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-stats",
+		Short: "Query the numbers and durations of the reads, writes and iterations on the stores of the node",
+		Long: `Query the numbers and durations of the reads, writes and iterations on the
+stores of the node, since they were enabled with gaiad start --store-stats or
+last reset. They are reset after being returned with --reset.`,
+		Args: cobra.NoArgs,
+		RunE: printStoreStats,
+	}
+	cmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
+	cmd.Flags().Bool(flagReset, false, "Reset the statistics after returning them")
+	return cmd
+}
+
+func printStoreStats(cmd *cobra.Command, args []string) error {
+	// the statistics are the ones of the node, there is no proof
+	viper.Set(client.FlagTrustNode, true)
+	cliCtx := context.NewCLIContext()
+
+	reset, err := cmd.Flags().GetBool(flagReset)
+	if err != nil {
+		return err
+	}
+	route := fmt.Sprintf("custom/%s/store", baseapp.MetricsQueryRoute)
+	if reset {
+		route += "/reset"
+	}
+	res, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
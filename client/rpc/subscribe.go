@@ -0,0 +1,287 @@
+package rpc
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Actions of the requests and types of the messages of the subscription endpoint
+const (
+	SubscribeAction   = "subscribe"
+	UnsubscribeAction = "unsubscribe"
+
+	MsgTypeSubscribed   = "subscribed"
+	MsgTypeUnsubscribed = "unsubscribed"
+	MsgTypeEvent        = "event"
+	MsgTypeReconnected  = "reconnected"
+	MsgTypeError        = "error"
+)
+
+// SubscriptionRequest is a request sent by a client of the subscription
+// endpoint to subscribe to, or unsubscribe from, the Tendermint events of the
+// given type, e.g. NewBlock or Tx, which match all the given tags.
+type SubscriptionRequest struct {
+	Action string   `json:"action"`
+	Event  string   `json:"event"`
+	Tags   []string `json:"tags"`
+}
+
+// Query returns the Tendermint query of the events of the request.
+func (req SubscriptionRequest) Query() (string, error) {
+	if len(req.Event) == 0 {
+		return "", errors.New("the event to subscribe to is required")
+	}
+	conditions := append([]string{fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, req.Event)}, req.Tags...)
+	query := strings.Join(conditions, " AND ")
+	if _, err := tmquery.New(query); err != nil {
+		return "", fmt.Errorf("invalid tags: %v", err)
+	}
+	return query, nil
+}
+
+// SubscriptionMessage is a message sent to a client of the subscription
+// endpoint, holding an event of a subscription, the outcome of a request or
+// an error.
+type SubscriptionMessage struct {
+	Type  string          `json:"type"`
+	Query string          `json:"query,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: checkOrigin,
+}
+
+// checkOrigin allows the websocket connections of the origins allowed to make
+// CORS requests with the --cors flag, of any origin if the flag is empty or
+// one of its origins is "*". The connections without Origin header, which are
+// not made by browsers, and those of the origin of the LCD are always allowed.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	allowAll := true
+	for _, allowed := range strings.Split(viper.GetString(client.FlagCORS), ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "" {
+			continue
+		}
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		allowAll = false
+	}
+	return allowAll
+}
+
+// SubscribeRequestHandlerFn upgrades the request to a websocket on which the
+// client subscribes to Tendermint events with SubscriptionRequests, and
+// receives them as SubscriptionMessages. The transactions of the events are
+// decoded with the codec of the context. The events are proxied from a
+// websocket connection to the node, which is reconnected and resubscribed to if
+// it is lost, the client being notified with a reconnected message.
+func SubscribeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader replies with the error
+			return
+		}
+		defer conn.Close()
+
+		sub := newSubscription(cliCtx, conn)
+		if err := sub.node.Start(); err != nil {
+			sub.write(SubscriptionMessage{Type: MsgTypeError, Error: err.Error()})
+			return
+		}
+		defer sub.node.Stop()
+
+		go sub.forwardEvents()
+		sub.readRequests()
+	}
+}
+
+// subscription proxies the subscriptions of a websocket client to the node.
+type subscription struct {
+	cdc  *codec.Codec
+	conn *websocket.Conn
+	node *rpcclient.WSClient
+
+	// serializes the writes to the client
+	writeMtx sync.Mutex
+
+	mtx     sync.Mutex
+	queries map[string]bool
+}
+
+func newSubscription(cliCtx context.CLIContext, conn *websocket.Conn) *subscription {
+	sub := &subscription{
+		cdc:     cliCtx.Codec,
+		conn:    conn,
+		queries: make(map[string]bool),
+	}
+	sub.node = rpcclient.NewWSClient(cliCtx.NodeURI, "/websocket", rpcclient.OnReconnect(sub.resubscribe))
+	sub.node.SetCodec(cdc)
+	return sub
+}
+
+// readRequests handles the requests of the client until it disconnects.
+func (sub *subscription) readRequests() {
+	for {
+		var req SubscriptionRequest
+		if err := sub.conn.ReadJSON(&req); err != nil {
+			switch err.(type) {
+			case *json.SyntaxError, *json.UnmarshalTypeError:
+				sub.write(SubscriptionMessage{Type: MsgTypeError, Error: err.Error()})
+				continue
+			default:
+				// the client disconnected
+				return
+			}
+		}
+
+		query, err := req.Query()
+		if err != nil {
+			sub.write(SubscriptionMessage{Type: MsgTypeError, Error: err.Error()})
+			continue
+		}
+
+		switch req.Action {
+		case SubscribeAction:
+			err = sub.node.Subscribe(gocontext.Background(), query)
+			if err == nil {
+				sub.setQuery(query, true)
+				sub.write(SubscriptionMessage{Type: MsgTypeSubscribed, Query: query})
+			}
+		case UnsubscribeAction:
+			err = sub.node.Unsubscribe(gocontext.Background(), query)
+			if err == nil {
+				sub.setQuery(query, false)
+				sub.write(SubscriptionMessage{Type: MsgTypeUnsubscribed, Query: query})
+			}
+		default:
+			err = fmt.Errorf("unknown action %q", req.Action)
+		}
+		if err != nil {
+			sub.write(SubscriptionMessage{Type: MsgTypeError, Query: query, Error: err.Error()})
+		}
+	}
+}
+
+// forwardEvents writes the events of the node to the client until the
+// connection to the node is stopped, e.g. once it can't be reconnected, which
+// closes the connection to the client.
+func (sub *subscription) forwardEvents() {
+	defer sub.conn.Close()
+
+	for {
+		select {
+		case resp, ok := <-sub.node.ResponsesCh:
+			if !ok {
+				sub.write(SubscriptionMessage{Type: MsgTypeError, Error: "lost the connection to the node"})
+				return
+			}
+			if resp.Error != nil {
+				sub.write(SubscriptionMessage{Type: MsgTypeError, Error: resp.Error.Error()})
+				continue
+			}
+
+			var event ctypes.ResultEvent
+			if err := cdc.UnmarshalJSON(resp.Result, &event); err != nil || event.Data == nil {
+				// the responses to the (un)subscription requests hold no event
+				continue
+			}
+			data, err := encodeEventData(sub.cdc, event.Data)
+			if err != nil {
+				sub.write(SubscriptionMessage{Type: MsgTypeError, Query: event.Query, Error: err.Error()})
+				continue
+			}
+			sub.write(SubscriptionMessage{Type: MsgTypeEvent, Query: event.Query, Data: data})
+
+		case <-sub.node.Quit():
+			sub.write(SubscriptionMessage{Type: MsgTypeError, Error: "lost the connection to the node"})
+			return
+		}
+	}
+}
+
+// resubscribe subscribes again to the queries of the client once the
+// connection to the node is reestablished.
+func (sub *subscription) resubscribe() {
+	sub.mtx.Lock()
+	defer sub.mtx.Unlock()
+
+	for query := range sub.queries {
+		if err := sub.node.Subscribe(gocontext.Background(), query); err != nil {
+			sub.write(SubscriptionMessage{Type: MsgTypeError, Query: query, Error: err.Error()})
+		}
+	}
+	sub.write(SubscriptionMessage{Type: MsgTypeReconnected})
+}
+
+func (sub *subscription) setQuery(query string, subscribed bool) {
+	sub.mtx.Lock()
+	defer sub.mtx.Unlock()
+
+	if subscribed {
+		sub.queries[query] = true
+	} else {
+		delete(sub.queries, query)
+	}
+}
+
+func (sub *subscription) write(msg SubscriptionMessage) {
+	sub.writeMtx.Lock()
+	defer sub.writeMtx.Unlock()
+
+	// a failed write closes the connection, which ends the reads
+	if err := sub.conn.WriteJSON(msg); err != nil {
+		sub.conn.Close()
+	}
+}
+
+// encodeEventData encodes the data of an event in JSON, decoding the
+// transactions with the given codec.
+func encodeEventData(appCdc *codec.Codec, data tmtypes.TMEventData) ([]byte, error) {
+	txEvent, ok := data.(tmtypes.EventDataTx)
+	if !ok {
+		return cdc.MarshalJSON(data)
+	}
+
+	txBytes := tmtypes.Tx(txEvent.Tx)
+	infos, err := tx.FormatTxResults(appCdc, []*ctypes.ResultTx{{
+		Hash:     txBytes.Hash(),
+		Height:   txEvent.Height,
+		Index:    txEvent.Index,
+		TxResult: txEvent.Result,
+		Tx:       txBytes,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return appCdc.MarshalJSON(infos[0])
+}
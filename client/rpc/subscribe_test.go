@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestSubscriptionRequestQuery(t *testing.T) {
+	query, err := SubscriptionRequest{Event: "NewBlock"}.Query()
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='NewBlock'", query)
+
+	query, err = SubscriptionRequest{Event: "Tx", Tags: []string{"action='send'", "tx.height>5"}}.Query()
+	require.NoError(t, err)
+	require.Equal(t, "tm.event='Tx' AND action='send' AND tx.height>5", query)
+
+	_, err = SubscriptionRequest{}.Query()
+	require.Error(t, err)
+	_, err = SubscriptionRequest{Event: "Tx", Tags: []string{"action="}}.Query()
+	require.Error(t, err)
+}
+
+func TestEncodeEventData(t *testing.T) {
+	appCdc := codec.New()
+	sdk.RegisterCodec(appCdc)
+	auth.RegisterCodec(appCdc)
+	codec.RegisterCrypto(appCdc)
+
+	stdTx := auth.NewStdTx(nil, auth.NewStdFee(10000, nil), nil, "memo")
+	txBytes, err := appCdc.MarshalBinaryLengthPrefixed(stdTx)
+	require.NoError(t, err)
+
+	// the transactions are decoded
+	data, err := encodeEventData(appCdc, tmtypes.EventDataTx{TxResult: tmtypes.TxResult{Height: 3, Tx: txBytes}})
+	require.NoError(t, err)
+	var info tx.Info
+	require.NoError(t, appCdc.UnmarshalJSON(data, &info))
+	require.Equal(t, int64(3), info.Height)
+	require.Equal(t, "memo", info.Tx.(auth.StdTx).Memo)
+
+	_, err = encodeEventData(appCdc, tmtypes.EventDataTx{TxResult: tmtypes.TxResult{Tx: []byte("invalid")}})
+	require.Error(t, err)
+
+	// the other events are encoded as is
+	data, err = encodeEventData(appCdc, tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: 5}})
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"height":"5"`)
+}
+
+func TestCheckOrigin(t *testing.T) {
+	defer viper.Set(client.FlagCORS, "")
+	request := func(origin string) *http.Request {
+		r := httptest.NewRequest("GET", "http://localhost:1317/subscribe", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	// any origin is allowed without --cors
+	viper.Set(client.FlagCORS, "")
+	require.True(t, checkOrigin(request("https://example.com")))
+
+	viper.Set(client.FlagCORS, "https://wallet.example.com, https://explorer.example.com")
+	require.True(t, checkOrigin(request("https://explorer.example.com")))
+	require.True(t, checkOrigin(request("HTTPS://Wallet.Example.com")))
+	require.False(t, checkOrigin(request("https://example.com")))
+	// the connections without origin and those of the LCD itself are allowed
+	require.True(t, checkOrigin(request("")))
+	require.True(t, checkOrigin(request("http://localhost:1317")))
+
+	viper.Set(client.FlagCORS, "https://wallet.example.com,*")
+	require.True(t, checkOrigin(request("https://example.com")))
+}
@@ -1,32 +1,47 @@
 package tx
 
 import (
+	"fmt"
+	"io/ioutil"
 	"net/http"
 
-	"io/ioutil"
+	"github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
+// Broadcast modes of the REST endpoints broadcasting txs
 const (
-	// Returns with the response from CheckTx.
-	flagSync = "sync"
-	// Returns right away, with no response
-	flagAsync = "async"
-	// Only returns error if mempool.BroadcastTx errs (ie. problem with the app) or if we timeout waiting for tx to commit.
-	flagBlock = "block"
+	// Returns once the tx is committed, with its CheckTx and DeliverTx results.
+	// Only returns an error if mempool.BroadcastTx errs (ie. problem with the
+	// app) or if we timeout waiting for tx to commit.
+	BroadcastBlock = "block"
+	// Returns with the CheckTx result of the tx.
+	BroadcastSync = "sync"
+	// Returns right away, with the hash of the tx only.
+	BroadcastAsync = "async"
+
+	// the query parameter of the broadcast mode
+	modeParam = "mode"
 )
 
 // BroadcastBody Tx Broadcast Body
 type BroadcastBody struct {
 	TxBytes []byte `json:"tx"`
-	Return  string `json:"return"`
+	Mode    string `json:"mode"`
+	// Deprecated: use Mode
+	Return string `json:"return"`
+}
+
+// BroadcastAsyncResult is the result of a tx broadcast in async mode
+type BroadcastAsyncResult struct {
+	Hash common.HexBytes `json:"hash"`
 }
 
-// BroadcastTxRequest REST Handler
-// nolint: gocyclo
+// BroadcastTxRequest REST Handler. The broadcast mode is read from the mode
+// query parameter, or else from the body.
 func BroadcastTxRequest(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var m BroadcastBody
@@ -40,18 +55,20 @@ func BroadcastTxRequest(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		var res interface{}
-		switch m.Return {
-		case flagBlock:
-			res, err = cliCtx.BroadcastTx(m.TxBytes)
-		case flagSync:
-			res, err = cliCtx.BroadcastTxSync(m.TxBytes)
-		case flagAsync:
-			res, err = cliCtx.BroadcastTxAsync(m.TxBytes)
-		default:
-			utils.WriteErrorResponse(w, http.StatusInternalServerError, "unsupported return type. supported types: block, sync, async")
+
+		mode := r.URL.Query().Get(modeParam)
+		if mode == "" {
+			mode = m.Mode
+		}
+		if mode == "" {
+			mode = m.Return
+		}
+		if err := ValidateBroadcastMode(mode); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
+
+		res, err := BroadcastTxWithMode(cliCtx, m.TxBytes, mode)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -59,3 +76,35 @@ func BroadcastTxRequest(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// ValidateBroadcastMode returns an error if the broadcast mode isn't one of
+// block, sync or async.
+func ValidateBroadcastMode(mode string) error {
+	switch mode {
+	case BroadcastBlock, BroadcastSync, BroadcastAsync:
+		return nil
+	default:
+		return fmt.Errorf("unsupported broadcast mode %q. supported modes: %s, %s, %s",
+			mode, BroadcastBlock, BroadcastSync, BroadcastAsync)
+	}
+}
+
+// BroadcastTxWithMode broadcasts the tx bytes in the given mode, returning the
+// result of the mode: the DeliverTx result in block mode, the CheckTx result
+// in sync mode and the tx hash only in async mode.
+func BroadcastTxWithMode(cliCtx context.CLIContext, txBytes []byte, mode string) (interface{}, error) {
+	switch mode {
+	case BroadcastBlock:
+		return cliCtx.BroadcastTxAndAwaitCommit(txBytes)
+	case BroadcastSync:
+		return cliCtx.BroadcastTxSync(txBytes)
+	case BroadcastAsync:
+		res, err := cliCtx.BroadcastTxAsync(txBytes)
+		if err != nil {
+			return nil, err
+		}
+		return BroadcastAsyncResult{Hash: res.Hash}, nil
+	default:
+		return nil, ValidateBroadcastMode(mode)
+	}
+}
@@ -0,0 +1,41 @@
+package tx
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// DecodeBody is the body of a tx decode request, holding the amino encoded
+// bytes of a tx, in base64 as in a BroadcastBody.
+type DecodeBody struct {
+	TxBytes []byte `json:"tx"`
+}
+
+// DecodeTxRequestHandlerFn returns the handler decoding the amino encoded
+// bytes of a tx, e.g. of the mempool, into its StdTx.
+func DecodeTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m DecodeBody
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = cdc.UnmarshalJSON(body, &m)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx, err := parseTx(cdc, m.TxBytes)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, tx, cliCtx.Indent)
+	}
+}
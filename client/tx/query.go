@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/common"
 
@@ -79,6 +80,12 @@ func queryTx(cdc *codec.Codec, cliCtx context.CLIContext, hashHexStr string) ([]
 		return nil, err
 	}
 
+	infos := []Info{info}
+	if err := setTimestamps(cliCtx, infos); err != nil {
+		return nil, err
+	}
+	info = infos[0]
+
 	if cliCtx.Indent {
 		return cdc.MarshalJSONIndent(info, "", "  ")
 	}
@@ -115,10 +122,50 @@ func formatTxResult(cdc *codec.Codec, res *ctypes.ResultTx) (Info, error) {
 
 // Info is used to prepare info to display
 type Info struct {
-	Hash   common.HexBytes        `json:"hash"`
-	Height int64                  `json:"height"`
-	Tx     sdk.Tx                 `json:"tx"`
-	Result abci.ResponseDeliverTx `json:"result"`
+	Hash      common.HexBytes        `json:"hash"`
+	Height    int64                  `json:"height"`
+	Tx        sdk.Tx                 `json:"tx"`
+	Result    abci.ResponseDeliverTx `json:"result"`
+	Timestamp string                 `json:"timestamp,omitempty"`
+}
+
+// setTimestamps sets the timestamps of the txs to the times of their blocks,
+// which are verified unless the node is trusted.
+func setTimestamps(cliCtx context.CLIContext, infos []Info) error {
+	times := make(map[int64]string)
+	for i, info := range infos {
+		timestamp, ok := times[info.Height]
+		if !ok {
+			blockTime, err := getBlockTime(cliCtx, info.Height)
+			if err != nil {
+				return err
+			}
+			timestamp = blockTime.Format(time.RFC3339)
+			times[info.Height] = timestamp
+		}
+		infos[i].Timestamp = timestamp
+	}
+	return nil
+}
+
+func getBlockTime(cliCtx context.CLIContext, height int64) (time.Time, error) {
+	if !cliCtx.TrustNode {
+		check, err := cliCtx.Verify(height)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return check.Header.Time, nil
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+	res, err := node.Block(&height)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return res.Block.Time, nil
 }
 
 func parseTx(cdc *codec.Codec, txBytes []byte) (sdk.Tx, error) {
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -28,6 +27,9 @@ const (
 	flagLimit    = "limit"
 	defaultPage  = 1
 	defaultLimit = 30 // should be consistent with tendermint/tendermint/rpc/core/pipe.go:19
+
+	// the query parameter of the tags of a search request
+	tagParam = "tag"
 )
 
 // default client command to search through tagged transactions
@@ -52,19 +54,11 @@ $ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
 
 			var tmTags []string
 			for _, tag := range tags {
-				if !strings.Contains(tag, ":") {
-					return fmt.Errorf("%s should be of the format <key>:<value>", tagsStr)
-				} else if strings.Count(tag, ":") > 1 {
-					return fmt.Errorf("%s should only contain one <key>:<value> pair", tagsStr)
-				}
-
-				keyValue := strings.Split(tag, ":")
-				if keyValue[0] == types.TxHeightKey {
-					tag = fmt.Sprintf("%s=%s", keyValue[0], keyValue[1])
-				} else {
-					tag = fmt.Sprintf("%s='%s'", keyValue[0], keyValue[1])
+				tmTag, err := parseTag(tag)
+				if err != nil {
+					return err
 				}
-				tmTags = append(tmTags, tag)
+				tmTags = append(tmTags, tmTag)
 			}
 			page := viper.GetInt(flagPage)
 			limit := viper.GetInt(flagLimit)
@@ -101,20 +95,47 @@ $ gaiacli query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
 	return cmd
 }
 
+// parseTag converts a <key>:<value> tag into a condition of a Tendermint query.
+func parseTag(tag string) (string, error) {
+	if !strings.Contains(tag, ":") {
+		return "", fmt.Errorf("%s should be of the format <key>:<value>", tag)
+	} else if strings.Count(tag, ":") > 1 {
+		return "", fmt.Errorf("%s should only contain one <key>:<value> pair", tag)
+	}
+
+	keyValue := strings.Split(tag, ":")
+	return tagCondition(keyValue[0], keyValue[1]), nil
+}
+
+// tagCondition returns the condition of a Tendermint query matching a tag.
+func tagCondition(key, value string) string {
+	if key == types.TxHeightKey {
+		return fmt.Sprintf("%s=%s", key, value)
+	}
+	return fmt.Sprintf("%s='%s'", key, value)
+}
+
 // SearchTxs performs a search for transactions for a given set of tags via
 // Tendermint RPC. It returns a slice of Info object containing txs and metadata.
 // An error is returned if the query fails.
 func SearchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page, limit int) ([]Info, error) {
+	txs, _, err := searchTxs(cliCtx, cdc, tags, page, limit)
+	return txs, err
+}
+
+// searchTxs performs a search for transactions, also returning the total
+// number of transactions matching the tags.
+func searchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page, limit int) ([]Info, int, error) {
 	if len(tags) == 0 {
-		return nil, errors.New("must declare at least one tag to search")
+		return nil, 0, errors.New("must declare at least one tag to search")
 	}
 
 	if page <= 0 {
-		return nil, errors.New("page must greater than 0")
+		return nil, 0, errors.New("page must greater than 0")
 	}
 
 	if limit <= 0 {
-		return nil, errors.New("limit must greater than 0")
+		return nil, 0, errors.New("limit must greater than 0")
 	}
 
 	// XXX: implement ANY
@@ -123,31 +144,35 @@ func SearchTxs(cliCtx context.CLIContext, cdc *codec.Codec, tags []string, page,
 	// get the node
 	node, err := cliCtx.GetNode()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	prove := !cliCtx.TrustNode
 
 	res, err := node.TxSearch(query, prove, page, limit)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if prove {
 		for _, tx := range res.Txs {
 			err := ValidateTxResult(cliCtx, tx)
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		}
 	}
 
 	info, err := FormatTxResults(cdc, res.Txs)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	if err := setTimestamps(cliCtx, info); err != nil {
+		return nil, 0, err
 	}
 
-	return info, nil
+	return info, res.TotalCount, nil
 }
 
 // parse the indexed txs into an array of Info
@@ -170,7 +195,6 @@ func FormatTxResults(cdc *codec.Codec, res []*ctypes.ResultTx) ([]Info, error) {
 func SearchTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var tags []string
-		var page, limit int
 		var txs []Info
 		err := r.ParseForm()
 		if err != nil {
@@ -182,67 +206,61 @@ func SearchTxRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.
 			return
 		}
 
-		tags, page, limit, err = parseHTTPArgs(r)
-
+		tags, err = parseHTTPArgs(r)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		txs, err = SearchTxs(cliCtx, cdc, tags, page, limit)
+		pagination, ok := utils.ParsePaginationOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+		// the search returns whole pages, so a cursor must start one
+		if pagination.Offset%pagination.Limit != 0 {
+			err := fmt.Errorf("cursor %d is not a multiple of the limit %d", pagination.Offset, pagination.Limit)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page := pagination.Offset/pagination.Limit + 1
+		txs, total, err := searchTxs(cliCtx, cdc, tags, page, pagination.Limit)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
+		utils.WritePaginationHeaders(w, pagination, total)
 		utils.PostProcessResponse(w, cdc, txs, cliCtx.Indent)
 	}
 }
 
-func parseHTTPArgs(r *http.Request) (tags []string, page, limit int, err error) {
+// parseHTTPArgs returns the conditions of the tags of a search request, given
+// either as tag=<key>:<value> query parameters or as <key>=<value> ones.
+func parseHTTPArgs(r *http.Request) (tags []string, err error) {
 	tags = make([]string, 0, len(r.Form))
 	for key, values := range r.Form {
-		if key == "page" || key == "limit" {
+		switch key {
+		case utils.PageParam, utils.LimitParam, utils.CursorParam:
+			continue
+		case tagParam:
+			for _, value := range values {
+				tag, err := parseTag(value)
+				if err != nil {
+					return tags, err
+				}
+				tags = append(tags, tag)
+			}
 			continue
 		}
+
 		var value string
 		value, err = url.QueryUnescape(values[0])
 		if err != nil {
-			return tags, page, limit, err
-		}
-
-		var tag string
-		if key == types.TxHeightKey {
-			tag = fmt.Sprintf("%s=%s", key, value)
-		} else {
-			tag = fmt.Sprintf("%s='%s'", key, value)
-		}
-		tags = append(tags, tag)
-	}
-
-	pageStr := r.FormValue("page")
-	if pageStr == "" {
-		page = defaultPage
-	} else {
-		page, err = strconv.Atoi(pageStr)
-		if err != nil {
-			return tags, page, limit, err
-		} else if page <= 0 {
-			return tags, page, limit, errors.New("page must greater than 0")
-		}
-	}
-
-	limitStr := r.FormValue("limit")
-	if limitStr == "" {
-		limit = defaultLimit
-	} else {
-		limit, err = strconv.Atoi(limitStr)
-		if err != nil {
-			return tags, page, limit, err
-		} else if limit <= 0 {
-			return tags, page, limit, errors.New("limit must greater than 0")
+			return tags, err
 		}
+		tags = append(tags, tagCondition(key, value))
 	}
 
-	return tags, page, limit, nil
+	return tags, nil
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 )
@@ -39,7 +41,7 @@ func ParseInt64OrReturnBadRequest(w http.ResponseWriter, s string) (n int64, ok
 
 	n, err = strconv.ParseInt(s, 10, 64)
 	if err != nil {
-		err := fmt.Errorf("'%s' is not a valid int64", s)
+		err := sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid int64", s)
 		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		return n, false
 	}
@@ -53,7 +55,7 @@ func ParseUint64OrReturnBadRequest(w http.ResponseWriter, s string) (n uint64, o
 
 	n, err = strconv.ParseUint(s, 10, 64)
 	if err != nil {
-		err := fmt.Errorf("'%s' is not a valid uint64", s)
+		err := sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid uint64", s)
 		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		return n, false
 	}
@@ -77,6 +79,269 @@ func ParseFloat64OrReturnBadRequest(w http.ResponseWriter, s string, defaultIfEm
 	return n, true
 }
 
+//----------------------------------------
+// Pagination
+
+// Query parameters and response headers of the REST endpoints returning lists.
+const (
+	PageParam   = "page"
+	LimitParam  = "limit"
+	CursorParam = "cursor"
+
+	// TotalCountHeader holds the total number of results of a list.
+	TotalCountHeader = "X-Total-Count"
+	// NextCursorHeader holds the cursor of the next page of a list, if any.
+	NextCursorHeader = "X-Next-Cursor"
+
+	// DefaultLimit is the number of results of a page when no limit is given,
+	// consistent with the transaction search of Tendermint.
+	DefaultLimit = 30
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+// Pagination is a page of the results of a list, read from the page, limit and
+// cursor query parameters of a request. Pages are 1-indexed. The cursor is the
+// offset of the first result of the page, as returned in the NextCursorHeader
+// of the previous page, and takes precedence over the page.
+type Pagination struct {
+	Page   int
+	Limit  int
+	Offset int
+}
+
+// ParsePagination parses the optional page, limit and cursor query parameters
+// of a request, defaulting to the first page of DefaultLimit results.
+func ParsePagination(r *http.Request) (p Pagination, err error) {
+	p.Page, p.Limit = 1, DefaultLimit
+	query := r.URL.Query()
+
+	if pageStr := query.Get(PageParam); len(pageStr) != 0 {
+		p.Page, err = strconv.Atoi(pageStr)
+		if err != nil || p.Page <= 0 {
+			return p, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid page", pageStr)
+		}
+	}
+
+	if limitStr := query.Get(LimitParam); len(limitStr) != 0 {
+		p.Limit, err = strconv.Atoi(limitStr)
+		if err != nil || p.Limit <= 0 {
+			return p, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid limit", limitStr)
+		}
+	}
+
+	if p.Page-1 > maxInt/p.Limit {
+		return p, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "page %d is out of range", p.Page)
+	}
+	p.Offset = (p.Page - 1) * p.Limit
+	if cursorStr := query.Get(CursorParam); len(cursorStr) != 0 {
+		p.Offset, err = strconv.Atoi(cursorStr)
+		if err != nil || p.Offset < 0 {
+			return p, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid cursor", cursorStr)
+		}
+	}
+
+	return p, nil
+}
+
+// ParsePaginationOrReturnBadRequest parses the pagination query parameters of
+// a request, writing a bad request error if they are invalid.
+func ParsePaginationOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (p Pagination, ok bool) {
+	p, err := ParsePagination(r)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return p, false
+	}
+	return p, true
+}
+
+// ParseQuerierPaginationOrReturnBadRequest parses the pagination query
+// parameters of a request listing the results of a querier paginating them by
+// page and limit, returning the page to query. It writes a bad request error
+// if they are invalid.
+func ParseQuerierPaginationOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (p Pagination, page int, ok bool) {
+	p, ok = ParsePaginationOrReturnBadRequest(w, r)
+	if !ok {
+		return p, 0, false
+	}
+	page, err := p.QuerierPage()
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return p, 0, false
+	}
+	return p, page, true
+}
+
+// QuerierPage returns the 1-indexed page of Limit results starting at the
+// offset of the pagination, for the queriers paginating their results by page
+// and limit. The offset must be a multiple of the limit.
+func (p Pagination) QuerierPage() (int, error) {
+	if p.Offset%p.Limit != 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cursor %d is not a multiple of the limit %d", p.Offset, p.Limit)
+	}
+	if p.Offset/p.Limit == maxInt {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cursor %d is out of range", p.Offset)
+	}
+	return p.Offset/p.Limit + 1, nil
+}
+
+// Bounds returns the start and end indices of the page within a list of the
+// given number of results.
+func (p Pagination) Bounds(total int) (start, end int) {
+	if p.Offset >= total {
+		return total, total
+	}
+	if p.Limit >= total-p.Offset {
+		return p.Offset, total
+	}
+	return p.Offset, p.Offset + p.Limit
+}
+
+// WritePaginationHeaders writes the total number of results of a list and, if
+// the page isn't the last one, the cursor of the next page.
+func WritePaginationHeaders(w http.ResponseWriter, p Pagination, total int) {
+	w.Header().Set(TotalCountHeader, strconv.Itoa(total))
+	if _, end := p.Bounds(total); end < total {
+		w.Header().Set(NextCursorHeader, strconv.Itoa(end))
+	}
+}
+
+// PostProcessPaginatedResponse writes the page of a JSON list of results, as
+// returned by a query, along with the pagination headers.
+func PostProcessPaginatedResponse(w http.ResponseWriter, p Pagination, res []byte, indent bool) {
+	page, total, err := paginate(p, res)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WritePaginationHeaders(w, p, total)
+	writeJSON(w, page, indent)
+}
+
+// PostProcessPaginatedQueryResponse writes the page of a JSON list of results
+// of a query, in a ResponseWithHeight, along with the pagination headers.
+func PostProcessPaginatedQueryResponse(w http.ResponseWriter, cliCtx context.CLIContext, p Pagination, res []byte) {
+	page, total, err := paginate(p, res)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	result, err := json.Marshal(page)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WritePaginationHeaders(w, p, total)
+	writeJSON(w, ResponseWithHeight{Height: cliCtx.Height, Result: result}, cliCtx.Indent)
+}
+
+// PostProcessQuerierPageResponse writes a page of a JSON list of results, as
+// returned by a querier paginating them, in a ResponseWithHeight. The total
+// number of results is unknown, so only the cursor of the next page is written,
+// if the page is full.
+func PostProcessQuerierPageResponse(w http.ResponseWriter, cliCtx context.CLIContext, p Pagination, res []byte) {
+	var page []json.RawMessage
+	if err := json.Unmarshal(res, &page); err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if page == nil {
+		page = []json.RawMessage{}
+	}
+	result, err := json.Marshal(page)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(page) >= p.Limit && p.Offset <= maxInt-len(page) {
+		w.Header().Set(NextCursorHeader, strconv.Itoa(p.Offset+len(page)))
+	}
+	writeJSON(w, ResponseWithHeight{Height: cliCtx.Height, Result: result}, cliCtx.Indent)
+}
+
+// paginate returns the page of a JSON list of results, along with the number
+// of results.
+func paginate(p Pagination, res []byte) (page []json.RawMessage, total int, err error) {
+	var results []json.RawMessage
+	if err := json.Unmarshal(res, &results); err != nil {
+		return nil, 0, err
+	}
+	start, end := p.Bounds(len(results))
+	return results[start:end], len(results), nil
+}
+
+//----------------------------------------
+// Query height
+
+// HeightParam is the query parameter of the height at which the queries of a
+// REST request are executed.
+const HeightParam = "height"
+
+// ResponseWithHeight is the envelope of the responses of the REST queries,
+// holding the height at which the queries were executed along with their
+// result, so that clients can detect stale reads.
+type ResponseWithHeight struct {
+	Height int64           `json:"height"`
+	Result json.RawMessage `json:"result"`
+}
+
+// ParseQueryHeightOrReturnBadRequest sets the height of the queries of the
+// context to the height query parameter of the request, if any, writing a bad
+// request error if it is invalid.
+func ParseQueryHeightOrReturnBadRequest(w http.ResponseWriter, cliCtx context.CLIContext, r *http.Request) (context.CLIContext, bool) {
+	heightStr := r.URL.Query().Get(HeightParam)
+	if len(heightStr) == 0 {
+		return cliCtx, true
+	}
+
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height < 0 {
+		err := sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "'%s' is not a valid height", heightStr)
+		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return cliCtx, false
+	}
+	return cliCtx.WithHeight(height), true
+}
+
+// PostProcessQueryResponse writes the result of the queries of a REST request
+// in a ResponseWithHeight, along with the height of the context at which the
+// queries were executed. The result is either JSON, as returned by a querier,
+// or a value encoded with the codec of the context.
+func PostProcessQueryResponse(w http.ResponseWriter, cliCtx context.CLIContext, response interface{}) {
+	var result []byte
+	switch res := response.(type) {
+	case []byte:
+		result = res
+	default:
+		var err error
+		result, err = cliCtx.Codec.MarshalJSON(response)
+		if err != nil {
+			WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	writeJSON(w, ResponseWithHeight{Height: cliCtx.Height, Result: result}, cliCtx.Indent)
+}
+
+// writeJSON writes a JSON response, encoded with encoding/json.
+func writeJSON(w http.ResponseWriter, response interface{}, indent bool) {
+	var (
+		output []byte
+		err    error
+	)
+	if indent {
+		output, err = json.MarshalIndent(response, "", "  ")
+	} else {
+		output, err = json.Marshal(response)
+	}
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(output)
+}
+
 // WriteGenerateStdTxResponse writes response for the generate_only mode.
 func WriteGenerateStdTxResponse(w http.ResponseWriter, cdc *codec.Codec, txBldr authtxb.TxBuilder, msgs []sdk.Msg) {
 	stdMsg, err := txBldr.Build(msgs)
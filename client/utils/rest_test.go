@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+func TestParsePagination(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    Pagination
+		wantErr bool
+	}{
+		{"", Pagination{Page: 1, Limit: DefaultLimit, Offset: 0}, false},
+		{"page=3&limit=10", Pagination{Page: 3, Limit: 10, Offset: 20}, false},
+		{"page=3&limit=10&cursor=5", Pagination{Page: 3, Limit: 10, Offset: 5}, false},
+		{"page=0", Pagination{}, true},
+		{"limit=-1", Pagination{}, true},
+		{"page=9223372036854775807&limit=2", Pagination{}, true},
+		{"cursor=x", Pagination{}, true},
+	}
+	for _, tc := range tests {
+		p, err := ParsePagination(httptest.NewRequest("GET", "/list?"+tc.query, nil))
+		if tc.wantErr {
+			require.Error(t, err, tc.query)
+			continue
+		}
+		require.NoError(t, err, tc.query)
+		require.Equal(t, tc.want, p, tc.query)
+	}
+}
+
+func TestPostProcessPaginatedResponse(t *testing.T) {
+	res := []byte(`[{"a":1},{"a":2},{"a":3}]`)
+
+	rec := httptest.NewRecorder()
+	PostProcessPaginatedResponse(rec, Pagination{Page: 1, Limit: 2}, res, false)
+	require.Equal(t, `[{"a":1},{"a":2}]`, rec.Body.String())
+	require.Equal(t, "3", rec.Header().Get(TotalCountHeader))
+	require.Equal(t, "2", rec.Header().Get(NextCursorHeader))
+
+	// the last page has no next cursor
+	rec = httptest.NewRecorder()
+	PostProcessPaginatedResponse(rec, Pagination{Page: 2, Limit: 2, Offset: 2}, res, false)
+	require.Equal(t, `[{"a":3}]`, rec.Body.String())
+	require.Equal(t, "3", rec.Header().Get(TotalCountHeader))
+	require.Empty(t, rec.Header().Get(NextCursorHeader))
+
+	// a page past the end is empty
+	rec = httptest.NewRecorder()
+	PostProcessPaginatedResponse(rec, Pagination{Page: 5, Limit: 2, Offset: 8}, res, false)
+	require.Equal(t, `[]`, rec.Body.String())
+}
+
+func TestPaginationBounds(t *testing.T) {
+	start, end := Pagination{Limit: 2, Offset: 1}.Bounds(5)
+	require.Equal(t, []int{1, 3}, []int{start, end})
+
+	// the end of a large page does not overflow
+	start, end = Pagination{Limit: maxInt, Offset: 1}.Bounds(5)
+	require.Equal(t, []int{1, 5}, []int{start, end})
+	start, end = Pagination{Limit: 1, Offset: maxInt}.Bounds(5)
+	require.Equal(t, []int{5, 5}, []int{start, end})
+}
+
+func TestQuerierPage(t *testing.T) {
+	page, err := Pagination{Limit: 10, Offset: 20}.QuerierPage()
+	require.NoError(t, err)
+	require.Equal(t, 3, page)
+
+	_, err = Pagination{Limit: 10, Offset: 5}.QuerierPage()
+	require.Error(t, err)
+	_, err = Pagination{Limit: 1, Offset: maxInt}.QuerierPage()
+	require.Error(t, err)
+}
+
+func TestPostProcessQuerierPageResponse(t *testing.T) {
+	cliCtx := context.NewCLIContext().WithHeight(7)
+
+	// a full page has a next cursor but no total
+	rec := httptest.NewRecorder()
+	PostProcessQuerierPageResponse(rec, cliCtx, Pagination{Page: 2, Limit: 2, Offset: 2}, []byte(`[{"a":3},{"a":4}]`))
+	require.Equal(t, `{"height":7,"result":[{"a":3},{"a":4}]}`, rec.Body.String())
+	require.Equal(t, "4", rec.Header().Get(NextCursorHeader))
+	require.Empty(t, rec.Header().Get(TotalCountHeader))
+
+	// the last page has no next cursor
+	rec = httptest.NewRecorder()
+	PostProcessQuerierPageResponse(rec, cliCtx, Pagination{Page: 3, Limit: 2, Offset: 4}, []byte(`null`))
+	require.Equal(t, `{"height":7,"result":[]}`, rec.Body.String())
+	require.Empty(t, rec.Header().Get(NextCursorHeader))
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -16,12 +17,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 const (
@@ -51,9 +55,12 @@ type GaiaApp struct {
 	keyDistr         *sdk.KVStoreKey
 	tkeyDistr        *sdk.TransientStoreKey
 	keyGov           *sdk.KVStoreKey
+	keyUpgrade       *sdk.KVStoreKey
+	keyEvidence      *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
+	tkeyCrisis       *sdk.TransientStoreKey
 
 	// Manage getting and setting accounts
 	accountKeeper       auth.AccountKeeper
@@ -64,7 +71,13 @@ type GaiaApp struct {
 	mintKeeper          mint.Keeper
 	distrKeeper         distr.Keeper
 	govKeeper           gov.Keeper
+	upgradeKeeper       upgrade.Keeper
+	crisisKeeper        crisis.Keeper
+	evidenceKeeper      evidence.Keeper
 	paramsKeeper        params.Keeper
+
+	// the module manager
+	mm *sdk.ModuleManager
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
@@ -86,9 +99,12 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		tkeyDistr:        sdk.NewTransientStoreKey(distr.TStoreKey),
 		keySlashing:      sdk.NewKVStoreKey(slashing.StoreKey),
 		keyGov:           sdk.NewKVStoreKey(gov.StoreKey),
+		keyUpgrade:       sdk.NewKVStoreKey(upgrade.StoreKey),
+		keyEvidence:      sdk.NewKVStoreKey(evidence.StoreKey),
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
+		tkeyCrisis:       sdk.NewTransientStoreKey(crisis.TStoreKey),
 	}
 
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams, app.tkeyParams)
@@ -130,12 +146,33 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		&stakingKeeper, app.paramsKeeper.Subspace(slashing.DefaultParamspace),
 		slashing.DefaultCodespace,
 	)
+	app.upgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		app.keyUpgrade,
+		upgrade.DefaultCodespace,
+	)
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
 		app.keyGov,
 		app.paramsKeeper, app.paramsKeeper.Subspace(gov.DefaultParamspace), app.bankKeeper, &stakingKeeper,
+		app.upgradeKeeper,
 		gov.DefaultCodespace,
 	)
+	app.crisisKeeper = crisis.NewKeeper(
+		app.cdc,
+		app.tkeyCrisis,
+		app.paramsKeeper.Subspace(crisis.DefaultParamspace),
+		app.bankKeeper, app.feeCollectionKeeper,
+		crisis.DefaultCodespace,
+	)
+	app.evidenceKeeper = evidence.NewKeeper(
+		app.cdc,
+		app.keyEvidence,
+		evidence.DefaultCodespace,
+	)
+
+	// register the evidence handlers
+	app.evidenceKeeper.SetHandler(evidence.RouteEquivocation, slashing.NewEquivocationHandler(app.slashingKeeper))
 
 	// register the staking hooks
 	// NOTE: The stakingKeeper above is passed by reference, so that it can be
@@ -144,26 +181,63 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		NewStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
 	)
 
+	app.mm = sdk.NewModuleManager(
+		auth.NewAppModule(app.cdc, app.accountKeeper, app.feeCollectionKeeper),
+		bank.NewAppModule(app.accountKeeper),
+		staking.NewAppModule(app.cdc, app.stakingKeeper),
+		mint.NewAppModule(app.cdc, app.mintKeeper),
+		distr.NewAppModule(app.cdc, app.distrKeeper),
+		gov.NewAppModule(app.cdc, app.govKeeper),
+		slashing.NewAppModule(app.cdc, app.slashingKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
+		crisis.NewAppModule(app.cdc, app.crisisKeeper),
+		evidence.NewAppModule(app.cdc, app.evidenceKeeper),
+	)
+
+	// NOTE: The upgrade module must begin the block first, so that no state
+	// is modified by the old binary at an upgrade height. The evidence module
+	// must slash double signers after the distribution module, so that there
+	// is nothing left over in the validator fee pool, so as to keep the
+	// CanWithdrawInvariant invariant.
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName,
+		slashing.ModuleName, evidence.ModuleName)
+	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName, crisis.ModuleName)
+
+	// NOTE: The distribution module must be initialized before the staking
+	// module, whose validators are read by the slashing module.
+	app.mm.SetOrderInitGenesis(distr.ModuleName, staking.ModuleName, auth.ModuleName,
+		slashing.ModuleName, gov.ModuleName, mint.ModuleName, crisis.ModuleName, evidence.ModuleName)
+	app.mm.SetOrderExportGenesis(auth.ModuleName, staking.ModuleName, mint.ModuleName,
+		distr.ModuleName, gov.ModuleName, slashing.ModuleName, crisis.ModuleName, evidence.ModuleName)
+
+	// register the invariants asserted at the end of every block
+	app.registerInvariants()
+
 	// register message routes
 	app.Router().
 		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper)).
 		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
 		AddRoute(distr.RouterKey, distr.NewHandler(app.distrKeeper)).
 		AddRoute(slashing.RouterKey, slashing.NewHandler(app.slashingKeeper)).
-		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper))
+		AddRoute(gov.RouterKey, gov.NewHandler(app.govKeeper)).
+		AddRoute(crisis.RouterKey, crisis.NewHandler(app.crisisKeeper)).
+		AddRoute(evidence.RouterKey, evidence.NewHandler(app.evidenceKeeper))
 
 	app.QueryRouter().
+		AddRoute(evidence.QuerierRoute, evidence.NewQuerier(app.evidenceKeeper)).
 		AddRoute(gov.QuerierRoute, gov.NewQuerier(app.govKeeper)).
+		AddRoute(mint.QuerierRoute, mint.NewQuerier(app.mintKeeper)).
+		AddRoute(params.QuerierRoute, params.NewQuerier(app.paramsKeeper)).
 		AddRoute(slashing.QuerierRoute, slashing.NewQuerier(app.slashingKeeper, app.cdc)).
 		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc))
 
 	// initialize BaseApp
 	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keyMint, app.keyDistr,
-		app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams)
+		app.keySlashing, app.keyGov, app.keyUpgrade, app.keyEvidence, app.keyFeeCollection, app.keyParams)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
-	app.MountStoresTransient(app.tkeyParams, app.tkeyStaking, app.tkeyDistr)
+	app.MountStoresTransient(app.tkeyParams, app.tkeyStaking, app.tkeyDistr, app.tkeyCrisis)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -184,6 +258,8 @@ func MakeCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	slashing.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
+	crisis.RegisterCodec(cdc)
+	evidence.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -192,37 +268,17 @@ func MakeCodec() *codec.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	// mint new tokens for the previous block
-	mint.BeginBlocker(ctx, app.mintKeeper)
-
-	// distribute rewards for the previous block
-	distr.BeginBlocker(ctx, req, app.distrKeeper)
-
-	// slash anyone who double signed.
-	// NOTE: This should happen after distr.BeginBlocker so that
-	// there is nothing left over in the validator fee pool,
-	// so as to keep the CanWithdrawInvariant invariant.
-	// TODO: This should really happen at EndBlocker.
-	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
-
-	return abci.ResponseBeginBlock{
-		Tags: tags.ToKVPairs(),
-	}
+	return app.mm.BeginBlock(ctx, req)
 }
 
 // application updates every end block
 // nolint: unparam
 func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	tags := gov.EndBlocker(ctx, app.govKeeper)
-	validatorUpdates, endBlockerTags := staking.EndBlocker(ctx, app.stakingKeeper)
-	tags = append(tags, endBlockerTags...)
+	res := app.mm.EndBlock(ctx, req)
 
 	app.assertRuntimeInvariants()
 
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: validatorUpdates,
-		Tags:             tags,
-	}
+	return res
 }
 
 // initialize store from a genesis state
@@ -239,20 +295,14 @@ func (app *GaiaApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisSt
 		app.accountKeeper.SetAccount(ctx, acc)
 	}
 
-	// initialize distribution (must happen before staking)
-	distr.InitGenesis(ctx, app.distrKeeper, genesisState.DistrData)
-
-	// load the initial staking information
-	validators, err := staking.InitGenesis(ctx, app.stakingKeeper, genesisState.StakingData)
+	// initialize the module-specific stores, keyed by module name in the
+	// genesis state
+	genesisData := make(map[string]json.RawMessage)
+	err := json.Unmarshal(app.cdc.MustMarshalJSON(genesisState), &genesisData)
 	if err != nil {
-		panic(err) // TODO find a way to do this w/o panics
+		panic(err)
 	}
-
-	// initialize module-specific stores
-	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
-	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData, genesisState.StakingData)
-	gov.InitGenesis(ctx, app.govKeeper, genesisState.GovData)
-	mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	validators := app.mm.InitGenesis(ctx, genesisData).Validators
 
 	// validate genesis state
 	err = GaiaValidateGenesisState(genesisState)
@@ -357,6 +407,10 @@ func (h StakingHooks) AfterValidatorPowerDidChange(ctx sdk.Context, consAddr sdk
 	h.dh.AfterValidatorPowerDidChange(ctx, consAddr, valAddr)
 	h.sh.AfterValidatorPowerDidChange(ctx, consAddr, valAddr)
 }
+func (h StakingHooks) AfterValidatorBondedDelta(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, delta int64) {
+	h.dh.AfterValidatorBondedDelta(ctx, consAddr, valAddr, delta)
+	h.sh.AfterValidatorBondedDelta(ctx, consAddr, valAddr, delta)
+}
 func (h StakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	h.dh.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
 	h.sh.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
@@ -377,6 +431,10 @@ func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAd
 	h.dh.AfterDelegationModified(ctx, delAddr, valAddr)
 	h.sh.AfterDelegationModified(ctx, delAddr, valAddr)
 }
+func (h StakingHooks) AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, balance sdk.Coin) {
+	h.dh.AfterUnbondingInitiated(ctx, delAddr, valAddr, balance)
+	h.sh.AfterUnbondingInitiated(ctx, delAddr, valAddr, balance)
+}
 func (h StakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
 	h.dh.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	h.sh.BeforeValidatorSlashed(ctx, valAddr, fraction)
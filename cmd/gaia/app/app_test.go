@@ -5,12 +5,20 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -20,19 +28,25 @@ import (
 )
 
 func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
+	// the staking tokens of the accounts are loose tokens of the pool
+	stakingGenesis := staking.DefaultGenesisState()
 	genaccs := make([]GenesisAccount, len(accs))
 	for i, acc := range accs {
 		genaccs[i] = NewGenesisAccount(acc)
+		stakingGenesis.Pool.LooseTokens = stakingGenesis.Pool.LooseTokens.Add(
+			acc.Coins.AmountOf(stakingGenesis.Params.BondDenom))
 	}
 
 	genesisState := NewGenesisState(
 		genaccs,
 		auth.DefaultGenesisState(),
-		staking.DefaultGenesisState(),
+		stakingGenesis,
 		mint.DefaultGenesisState(),
 		distr.DefaultGenesisState(),
 		gov.DefaultGenesisState(),
 		slashing.DefaultGenesisState(),
+		crisis.DefaultGenesisState(),
+		evidence.DefaultGenesisState(),
 	)
 
 	stateBytes, err := codec.MarshalJSONIndent(gapp.cdc, genesisState)
@@ -55,6 +69,130 @@ func TestGaiadExport(t *testing.T) {
 
 	// Making a new app object with the db, so that initchain hasn't been called
 	newGapp := NewGaiaApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true)
-	_, _, err := newGapp.ExportAppStateAndValidators(false)
+	_, _, err := newGapp.ExportAppStateAndValidators(false, -1)
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestGaiadExportAtHeight(t *testing.T) {
+	memDB := db.NewMemDB()
+	gapp := NewGaiaApp(log.NewNopLogger(), memDB, nil, true, baseapp.SetPruning(store.PruneNothing))
+	addr := sdk.AccAddress([]byte("addr"))
+	require.NoError(t, setGenesis(gapp, &auth.BaseAccount{Address: addr, Coins: sdk.Coins{sdk.NewInt64Coin("footoken", 1)}}))
+
+	// the balance of the account is the height of the block
+	for height := int64(2); height <= 4; height++ {
+		gapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		ctx := gapp.NewContext(false, abci.Header{Height: height})
+		acc := gapp.accountKeeper.GetAccount(ctx, addr)
+		require.NoError(t, acc.SetCoins(sdk.Coins{sdk.NewInt64Coin("footoken", height)}))
+		gapp.accountKeeper.SetAccount(ctx, acc)
+		gapp.EndBlock(abci.RequestEndBlock{})
+		gapp.Commit()
+	}
+
+	newGapp := NewGaiaApp(log.NewNopLogger(), memDB, nil, true, baseapp.SetPruning(store.PruneNothing))
+	for _, height := range []int64{1, 3, 4, -1} {
+		appState, _, err := newGapp.ExportAppStateAndValidators(false, height)
+		require.NoError(t, err, "height %d", height)
+
+		var genesisState GenesisState
+		require.NoError(t, newGapp.cdc.UnmarshalJSON(appState, &genesisState))
+		require.Len(t, genesisState.Accounts, 1)
+		expected := height
+		if height == -1 {
+			expected = 4
+		}
+		require.Equal(t, sdk.Coins{sdk.NewInt64Coin("footoken", expected)}, genesisState.Accounts[0].Coins, "height %d", height)
+	}
+
+	for _, height := range []int64{0, 5} {
+		_, _, err := newGapp.ExportAppStateAndValidators(false, height)
+		require.Error(t, err, "height %d", height)
+	}
+
+	// the previous heights are no longer stored
+	prunedGapp := NewGaiaApp(log.NewNopLogger(), db.NewMemDB(), nil, true, baseapp.SetPruning(store.PruneEverything))
+	require.NoError(t, setGenesis(prunedGapp))
+	for height := int64(2); height <= 3; height++ {
+		prunedGapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		prunedGapp.EndBlock(abci.RequestEndBlock{})
+		prunedGapp.Commit()
+	}
+	_, _, err := prunedGapp.ExportAppStateAndValidators(false, 2)
+	require.Error(t, err)
+	_, _, err = prunedGapp.ExportAppStateAndValidators(false, 3)
+	require.NoError(t, err)
+}
+
+func TestGaiadExportForZeroHeight(t *testing.T) {
+	privs := make([]crypto.PrivKey, 3)
+	addrs := make([]sdk.AccAddress, len(privs))
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+	}
+	val1, val2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	consKey1, consKey2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(staking.DefaultBondDenom, amount) }
+	commission := staking.NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+
+	c := newGasChain(t, consKey1, privs...)
+	records := c.deliverBlock([]gasCase{
+		{"create_validator", privs[0], staking.NewMsgCreateValidator(val1, consKey1, stake(10000000),
+			staking.NewDescription("validator-0", "", "", ""), commission)},
+		{"create_validator_2", privs[1], staking.NewMsgCreateValidator(val2, consKey2, stake(10000000),
+			staking.NewDescription("validator-1", "", "", ""), commission)},
+	})
+
+	// the second validator misses the blocks
+	c.votes = []abci.VoteInfo{
+		{Validator: abci.Validator{Address: consKey1.Address(), Power: 10000000}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: consKey2.Address(), Power: 10000000}, SignedLastBlock: false},
+	}
+	records = append(records, c.deliverBlock([]gasCase{
+		{"delegate", privs[2], staking.NewMsgDelegate(addrs[2], val1, stake(1000000))},
+		{"begin_redelegate", privs[2], staking.NewMsgBeginRedelegate(addrs[2], val1, val2, sdk.NewDec(100000))},
+		{"undelegate_amount", privs[2], staking.NewMsgUndelegateAmount(addrs[2], val1, stake(100000))},
+	})...)
+	records = append(records, c.deliverBlock(nil)...)
+	for _, record := range records {
+		require.Zero(t, record.Code, record.Name)
+	}
+
+	ctx := c.app.NewContext(true, abci.Header{})
+	balance := c.app.accountKeeper.GetAccount(ctx, addrs[2]).GetCoins().AmountOf(staking.DefaultBondDenom)
+
+	appState, _, err := c.app.ExportAppStateAndValidators(true, -1)
+	require.NoError(t, err)
+	var genesisState GenesisState
+	require.NoError(t, c.app.cdc.UnmarshalJSON(appState, &genesisState))
+
+	// the unbonding delegation is paid and the redelegation completed
+	stakingData := genesisState.StakingData
+	require.Empty(t, stakingData.UnbondingDelegations)
+	require.Empty(t, stakingData.Redelegations)
+	for _, acc := range genesisState.Accounts {
+		if acc.Address.Equals(addrs[2]) {
+			require.False(t, acc.Coins.AmountOf(staking.DefaultBondDenom).LT(balance.AddRaw(100000)))
+		}
+	}
+	for _, validator := range stakingData.Validators {
+		require.Zero(t, validator.BondHeight)
+		require.Zero(t, validator.UnbondingHeight)
+	}
+
+	// the missed blocks are forgotten
+	require.Len(t, genesisState.SlashingData.SigningInfos, 2)
+	for addr, info := range genesisState.SlashingData.SigningInfos {
+		require.Zero(t, info.StartHeight, addr)
+		require.Zero(t, info.IndexOffset, addr)
+		require.Zero(t, info.MissedBlocksCounter, addr)
+		require.Empty(t, genesisState.SlashingData.MissedBlocks[addr], addr)
+	}
+
+	// a new chain starts from the exported state
+	newGapp := NewGaiaApp(log.NewNopLogger(), db.NewMemDB(), nil, true)
+	require.NotPanics(t, func() {
+		newGapp.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	})
+}
@@ -2,26 +2,35 @@ package app
 
 import (
 	"encoding/json"
+	"time"
 
-	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	distr "github.com/cosmos/cosmos-sdk/x/distribution"
-	"github.com/cosmos/cosmos-sdk/x/gov"
-	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
-// export the state of gaia for a genesis file
-func (app *GaiaApp) ExportAppStateAndValidators(forZeroHeight bool) (
+// time after which all the unbondings and redelegations are mature, when
+// exporting for zero height
+var zeroHeightMaturityTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// export the state of gaia for a genesis file, at the given height or at the
+// latest height if -1. The state of a previous height must still be stored.
+func (app *GaiaApp) ExportAppStateAndValidators(forZeroHeight bool, height int64) (
 	appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 
+	if height == -1 {
+		height = app.LastBlockHeight()
+	}
+
 	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	ctx, err := app.NewContextAtHeight(height)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if forZeroHeight {
 		app.prepForZeroHeightGenesis(ctx)
@@ -36,15 +45,20 @@ func (app *GaiaApp) ExportAppStateAndValidators(forZeroHeight bool) (
 	}
 	app.accountKeeper.IterateAccounts(ctx, appendAccount)
 
-	genState := NewGenesisState(
-		accounts,
-		auth.ExportGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper),
-		staking.ExportGenesis(ctx, app.stakingKeeper),
-		mint.ExportGenesis(ctx, app.mintKeeper),
-		distr.ExportGenesis(ctx, app.distrKeeper),
-		gov.ExportGenesis(ctx, app.govKeeper),
-		slashing.ExportGenesis(ctx, app.slashingKeeper),
-	)
+	// export the module-specific stores, keyed by module name in the genesis
+	// state
+	genesisData := app.mm.ExportGenesis(ctx)
+	genesisData["accounts"] = app.cdc.MustMarshalJSON(accounts)
+	bz, err := json.Marshal(genesisData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var genState GenesisState
+	err = app.cdc.UnmarshalJSON(bz, &genState)
+	if err != nil {
+		return nil, nil, err
+	}
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, err
@@ -59,6 +73,26 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context) {
 	/* Just to be safe, assert the invariants on current state. */
 	app.assertRuntimeInvariantsOnContext(ctx)
 
+	/* Complete the unbondings and redelegations, as if they were mature. */
+
+	// the unbonding validators become unbonded, and are removed without
+	// delegations, before their rewards are withdrawn
+	matureCtx := ctx.WithBlockTime(zeroHeightMaturityTime)
+	app.stakingKeeper.UnbondAllMatureValidatorQueue(matureCtx)
+
+	// pay the unbonding delegations to the delegators, a delegation being
+	// queued once per completion time of its entries
+	for _, dvPair := range app.stakingKeeper.DequeueAllMatureUBDQueue(matureCtx, zeroHeightMaturityTime) {
+		_ = app.stakingKeeper.CompleteUnbonding(matureCtx, dvPair.DelegatorAddr, dvPair.ValidatorAddr)
+	}
+
+	// forget the redelegations, their tokens being delegated to the
+	// destination validators
+	for _, dvvTriplet := range app.stakingKeeper.DequeueAllMatureRedelegationQueue(matureCtx, zeroHeightMaturityTime) {
+		_ = app.stakingKeeper.CompleteRedelegation(matureCtx, dvvTriplet.DelegatorAddr,
+			dvvTriplet.ValidatorSrcAddr, dvvTriplet.ValidatorDstAddr)
+	}
+
 	/* Handle fee distribution state. */
 
 	// withdraw all validator commission
@@ -99,24 +133,6 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context) {
 
 	/* Handle staking state. */
 
-	// iterate through redelegations, reset creation height
-	app.stakingKeeper.IterateRedelegations(ctx, func(_ int64, red staking.Redelegation) (stop bool) {
-		for i := range red.Entries {
-			red.Entries[i].CreationHeight = 0
-		}
-		app.stakingKeeper.SetRedelegation(ctx, red)
-		return false
-	})
-
-	// iterate through unbonding delegations, reset creation height
-	app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) (stop bool) {
-		for i := range ubd.Entries {
-			ubd.Entries[i].CreationHeight = 0
-		}
-		app.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
-		return false
-	})
-
 	// Iterate through validators by power descending, reset bond heights, and
 	// update bond intra-tx counters.
 	store := ctx.KVStore(app.keyStaking)
@@ -143,13 +159,16 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context) {
 
 	/* Handle slashing state. */
 
-	// reset start height on signing infos
+	// reset start height and missed blocks on signing infos
+	var signingAddrs []sdk.ConsAddress
 	app.slashingKeeper.IterateValidatorSigningInfos(
 		ctx,
-		func(addr sdk.ConsAddress, info slashing.ValidatorSigningInfo) (stop bool) {
-			info.StartHeight = 0
-			app.slashingKeeper.SetValidatorSigningInfo(ctx, addr, info)
+		func(addr sdk.ConsAddress, _ slashing.ValidatorSigningInfo) (stop bool) {
+			signingAddrs = append(signingAddrs, addr)
 			return false
 		},
 	)
+	for _, addr := range signingAddrs {
+		app.slashingKeeper.ResetValidatorMissedBlocks(ctx, addr)
+	}
 }
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var updateGas = flag.Bool("update-gas", false, "update the gas consumption golden file of testdata")
+
+// gasRecord is the gas consumed by the delivery of a transaction of a single
+// canonical message
+type gasRecord struct {
+	Name    string `json:"name"`
+	Route   string `json:"route"`
+	Type    string `json:"type"`
+	Code    uint32 `json:"code"`
+	GasUsed int64  `json:"gas_used"`
+}
+
+// gasCase is a canonical message delivered in a transaction signed by signer
+type gasCase struct {
+	name   string
+	signer crypto.PrivKey
+	msg    sdk.Msg
+}
+
+// gasChain delivers the transactions of the gas cases on a gaia app with a
+// fixed genesis state, keys and block times, so that the gas they consume only
+// changes with the gas costs of the handlers and stores.
+type gasChain struct {
+	t      *testing.T
+	app    *GaiaApp
+	header abci.Header
+
+	// votes of the last commit, allocating the rewards to the validators
+	votes []abci.VoteInfo
+}
+
+func newGasChain(t *testing.T, proposer crypto.PubKey, privs ...crypto.PrivKey) *gasChain {
+	gapp := NewGaiaApp(log.NewNopLogger(), db.NewMemDB(), nil, true)
+
+	accs := make([]*auth.BaseAccount, len(privs))
+	for i, priv := range privs {
+		accs[i] = &auth.BaseAccount{
+			Address: sdk.AccAddress(priv.PubKey().Address()),
+			Coins:   sdk.Coins{sdk.NewInt64Coin(staking.DefaultBondDenom, 1000000000)},
+		}
+	}
+	require.NoError(t, setGenesis(gapp, accs...))
+
+	return &gasChain{
+		t:   t,
+		app: gapp,
+		header: abci.Header{
+			Height:          gapp.LastBlockHeight() + 1,
+			Time:            time.Unix(1546300800, 0).UTC(),
+			ProposerAddress: proposer.Address(),
+		},
+	}
+}
+
+// deliverBlock delivers the gas cases in a block, one transaction each, and
+// commits it
+func (c *gasChain) deliverBlock(cases []gasCase) (records []gasRecord) {
+	c.app.BeginBlock(abci.RequestBeginBlock{
+		Header:         c.header,
+		LastCommitInfo: abci.LastCommitInfo{Votes: c.votes},
+	})
+	for _, tc := range cases {
+		ctx := c.app.NewContext(false, c.header)
+		acc := c.app.accountKeeper.GetAccount(ctx, sdk.AccAddress(tc.signer.PubKey().Address()))
+		require.NotNil(c.t, acc, tc.name)
+
+		msgs := []sdk.Msg{tc.msg}
+		fee := auth.NewStdFee(1000000, sdk.Coins{sdk.NewInt64Coin(staking.DefaultBondDenom, 1000)})
+		sig, err := tc.signer.Sign(auth.StdSignBytes(c.header.ChainID,
+			acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, ""))
+		require.NoError(c.t, err, tc.name)
+		tx := auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: tc.signer.PubKey(), Signature: sig}}, "")
+
+		res := c.app.Deliver(tx)
+		records = append(records, gasRecord{
+			Name:    tc.name,
+			Route:   tc.msg.Route(),
+			Type:    tc.msg.Type(),
+			Code:    uint32(res.Code),
+			GasUsed: int64(res.GasUsed),
+		})
+	}
+	c.app.EndBlock(abci.RequestEndBlock{})
+	c.app.Commit()
+
+	c.header.Height++
+	c.header.Time = c.header.Time.Add(5 * time.Second)
+	return records
+}
+
+// TestGasConsumption delivers a canonical message of each type of the modules
+// of gaia and compares the gas they consume to the golden file of testdata,
+// so that a change of gas costs, which breaks consensus, is caught in review.
+// The expected failures, e.g. unjailing a validator which is not jailed or
+// submitting an equivocation, only reported by Tendermint, are recorded with
+// their code.
+func TestGasConsumption(t *testing.T) {
+	privs := make([]crypto.PrivKey, 3)
+	addrs := make([]sdk.AccAddress, len(privs))
+	for i, seed := range []string{"gas-0", "gas-1", "gas-2"} {
+		privs[i] = secp256k1.GenPrivKeySecp256k1([]byte(seed))
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+	}
+	val1, val2 := sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])
+	consKey1 := ed25519.GenPrivKeyFromSecret([]byte("gas-cons-0")).PubKey()
+	consKey2 := ed25519.GenPrivKeyFromSecret([]byte("gas-cons-1")).PubKey()
+
+	c := newGasChain(t, consKey1, privs...)
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(staking.DefaultBondDenom, amount) }
+	commission := staking.NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+
+	// the validators are bonded at the end of the first block
+	evidenceHeight, evidenceTime := c.header.Height, c.header.Time
+	records := c.deliverBlock([]gasCase{
+		{"send", privs[2], bank.NewMsgSend(
+			[]bank.Input{bank.NewInput(addrs[2], sdk.Coins{stake(10)})},
+			[]bank.Output{bank.NewOutput(addrs[0], sdk.Coins{stake(10)})})},
+		{"create_validator", privs[0], staking.NewMsgCreateValidator(val1, consKey1, stake(10000000),
+			staking.NewDescription("validator-0", "", "", ""), commission)},
+		{"create_validator_2", privs[1], staking.NewMsgCreateValidator(val2, consKey2, stake(10000000),
+			staking.NewDescription("validator-1", "", "", ""), commission)},
+	})
+	for _, consKey := range []crypto.PubKey{consKey1, consKey2} {
+		c.votes = append(c.votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: consKey.Address(), Power: 10000000},
+			SignedLastBlock: true,
+		})
+	}
+
+	minDeposit := gov.DefaultGenesisState().DepositParams.MinDeposit
+	records = append(records, c.deliverBlock([]gasCase{
+		{"edit_validator", privs[0], staking.NewMsgEditValidator(val1,
+			staking.NewDescription("validator-0", "identity", "website", "details"), nil)},
+		{"delegate", privs[2], staking.NewMsgDelegate(addrs[2], val1, stake(1000000))},
+		{"begin_redelegate", privs[2], staking.NewMsgBeginRedelegate(addrs[2], val1, val2, sdk.NewDec(100000))},
+		{"undelegate_amount", privs[2], staking.NewMsgUndelegateAmount(addrs[2], val1, stake(100000))},
+		{"cancel_unbonding_delegation", privs[2], staking.NewMsgCancelUnbondingDelegation(
+			addrs[2], val1, stake(50000), c.header.Height)},
+		{"undelegate_max", privs[2], staking.NewMsgUndelegateMax(addrs[2], val2)},
+		{"submit_proposal", privs[0], gov.NewMsgSubmitProposal("title", "description",
+			gov.ProposalTypeText, addrs[0], minDeposit)},
+		{"deposit", privs[1], gov.NewMsgDeposit(addrs[1], 1, minDeposit)},
+		{"vote", privs[0], gov.NewMsgVote(addrs[0], 1, gov.OptionYes)},
+		{"vote_weighted", privs[1], gov.NewMsgVoteWeighted(addrs[1], 1, gov.WeightedVoteOptions{
+			gov.NewWeightedVoteOption(gov.OptionYes, sdk.NewDecWithPrec(6, 1)),
+			gov.NewWeightedVoteOption(gov.OptionNo, sdk.NewDecWithPrec(4, 1)),
+		})},
+		{"cancel_proposal", privs[0], gov.NewMsgCancelProposal(addrs[0], 1)},
+		{"unjail_not_jailed", privs[0], slashing.NewMsgUnjail(val1)},
+		{"verify_invariant", privs[2], crisis.NewMsgVerifyInvariant(addrs[2], bank.RouterKey, "nonnegative-balance")},
+		{"submit_equivocation_rejected", privs[2], evidence.NewMsgSubmitEvidence(addrs[2], evidence.NewEquivocation(
+			evidenceHeight, evidenceTime, 10, sdk.ConsAddress(consKey2.Address())))},
+	})...)
+
+	// the fees of the previous block are allocated to the validators
+	records = append(records, c.deliverBlock([]gasCase{
+		{"set_withdraw_address", privs[2], distr.NewMsgSetWithdrawAddress(addrs[2], addrs[0])},
+		{"withdraw_delegation_reward", privs[0], distr.NewMsgWithdrawDelegatorReward(addrs[0], val1)},
+		{"withdraw_validator_rewards_all", privs[0], distr.NewMsgWithdrawValidatorCommission(val1)},
+	})...)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	require.NoError(t, enc.Encode(records))
+	path := filepath.Join("testdata", "gas_golden.json")
+	if *updateGas {
+		require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+	}
+	expected, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), buf.String(),
+		"the gas consumption changed, review the change and run go test ./cmd/gaia/app -run TestGasConsumption -update-gas")
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,7 +16,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -39,13 +42,16 @@ type GenesisState struct {
 	DistrData    distr.GenesisState    `json:"distr"`
 	GovData      gov.GenesisState      `json:"gov"`
 	SlashingData slashing.GenesisState `json:"slashing"`
+	CrisisData   crisis.GenesisState   `json:"crisis"`
+	EvidenceData evidence.GenesisState `json:"evidence"`
 	GenTxs       []json.RawMessage     `json:"gentxs"`
 }
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 	stakingData staking.GenesisState, mintData mint.GenesisState,
 	distrData distr.GenesisState, govData gov.GenesisState,
-	slashingData slashing.GenesisState) GenesisState {
+	slashingData slashing.GenesisState, crisisData crisis.GenesisState,
+	evidenceData evidence.GenesisState) GenesisState {
 
 	return GenesisState{
 		Accounts:     accounts,
@@ -55,6 +61,8 @@ func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 		DistrData:    distrData,
 		GovData:      govData,
 		SlashingData: slashingData,
+		CrisisData:   crisisData,
+		EvidenceData: evidenceData,
 	}
 }
 
@@ -67,6 +75,12 @@ type GenesisAccount struct {
 	Vesting       bool           `json:"vesting"`
 	StartTime     int64          `json:"start_time"`
 	EndTime       int64          `json:"end_time"`
+
+	// vesting accounting of an exported vesting account, the original vesting
+	// coins defaulting to the coins of the account
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -93,6 +107,19 @@ func NewGenesisAccountI(acc auth.Account) GenesisAccount {
 		gacc.EndTime = vacc.GetEndTime()
 	}
 
+	var bvacc *auth.BaseVestingAccount
+	switch vacc := acc.(type) {
+	case *auth.ContinuousVestingAccount:
+		bvacc = vacc.BaseVestingAccount
+	case *auth.DelayedVestingAccount:
+		bvacc = vacc.BaseVestingAccount
+	}
+	if bvacc != nil {
+		gacc.OriginalVesting = bvacc.OriginalVesting
+		gacc.DelegatedFree = bvacc.DelegatedFree
+		gacc.DelegatedVesting = bvacc.DelegatedVesting
+	}
+
 	return gacc
 }
 
@@ -107,9 +134,13 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 
 	if ga.Vesting {
 		if ga.StartTime != 0 && ga.EndTime != 0 {
-			return auth.NewContinuousVestingAccount(bacc, ga.StartTime, ga.EndTime)
+			cvacc := auth.NewContinuousVestingAccount(bacc, ga.StartTime, ga.EndTime)
+			ga.setVestingAccounting(cvacc.BaseVestingAccount)
+			return cvacc
 		} else if ga.EndTime != 0 {
-			return auth.NewDelayedVestingAccount(bacc, ga.EndTime)
+			dvacc := auth.NewDelayedVestingAccount(bacc, ga.EndTime)
+			ga.setVestingAccounting(dvacc.BaseVestingAccount)
+			return dvacc
 		} else {
 			panic(fmt.Sprintf("invalid genesis vesting account: %+v", ga))
 		}
@@ -118,6 +149,16 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 	return bacc
 }
 
+// set the exported vesting accounting of the genesis account on a vesting
+// account
+func (ga *GenesisAccount) setVestingAccounting(bvacc *auth.BaseVestingAccount) {
+	if !ga.OriginalVesting.IsZero() {
+		bvacc.OriginalVesting = ga.OriginalVesting.Sort()
+	}
+	bvacc.DelegatedFree = ga.DelegatedFree
+	bvacc.DelegatedVesting = ga.DelegatedVesting
+}
+
 // Create the core parameters for genesis initialization for gaia
 // note that the pubkey input is this machines pubkey
 func GaiaAppGenState(cdc *codec.Codec, genDoc tmtypes.GenesisDoc, appGenTxs []json.RawMessage) (
@@ -176,55 +217,67 @@ func NewDefaultGenesisState() GenesisState {
 		DistrData:    distr.DefaultGenesisState(),
 		GovData:      gov.DefaultGenesisState(),
 		SlashingData: slashing.DefaultGenesisState(),
+		CrisisData:   crisis.DefaultGenesisState(),
+		EvidenceData: evidence.DefaultGenesisState(),
 		GenTxs:       nil,
 	}
 }
 
-// GaiaValidateGenesisState ensures that the genesis state obeys the expected invariants
-// TODO: No validators are both bonded and jailed (#2088)
-// TODO: Error if there is a duplicate validator (#1708)
+// GaiaValidateGenesisState ensures that the genesis state obeys the expected
+// invariants: the ones of the genesis state of each module, and the staking
+// tokens and gov deposits being accounted for across modules. It returns the
+// GenesisErrors of all the modules failing their validation.
 // TODO: Ensure all state machine parameters are in genesis (#1704)
 func GaiaValidateGenesisState(genesisState GenesisState) error {
-	if err := validateGenesisStateAccounts(genesisState.Accounts); err != nil {
-		return err
-	}
+	errs := validateGenesisStateAccounts(genesisState.Accounts)
 
 	// skip stakingData validation as genesis is created from txs
 	if len(genesisState.GenTxs) > 0 {
-		return nil
+		return errs.err()
 	}
 
-	if err := auth.ValidateGenesis(genesisState.AuthData); err != nil {
-		return err
+	modules := []struct {
+		name     string
+		validate func() error
+	}{
+		{auth.ModuleName, func() error { return auth.ValidateGenesis(genesisState.AuthData) }},
+		{staking.ModuleName, func() error { return staking.ValidateGenesis(genesisState.StakingData) }},
+		{mint.ModuleName, func() error { return mint.ValidateGenesis(genesisState.MintData) }},
+		{distr.ModuleName, func() error { return distr.ValidateGenesis(genesisState.DistrData) }},
+		{gov.ModuleName, func() error { return gov.ValidateGenesis(genesisState.GovData) }},
+		{crisis.ModuleName, func() error { return crisis.ValidateGenesis(genesisState.CrisisData) }},
+		{evidence.ModuleName, func() error { return evidence.ValidateGenesis(genesisState.EvidenceData) }},
+		{slashing.ModuleName, func() error { return slashing.ValidateGenesis(genesisState.SlashingData) }},
 	}
-	if err := staking.ValidateGenesis(genesisState.StakingData); err != nil {
-		return err
-	}
-	if err := mint.ValidateGenesis(genesisState.MintData); err != nil {
-		return err
-	}
-	if err := distr.ValidateGenesis(genesisState.DistrData); err != nil {
-		return err
-	}
-	if err := gov.ValidateGenesis(genesisState.GovData); err != nil {
-		return err
+	for _, module := range modules {
+		if err := module.validate(); err != nil {
+			errs = append(errs, GenesisError{Module: module.name, Err: err})
+		}
 	}
 
-	return slashing.ValidateGenesis(genesisState.SlashingData)
+	errs = append(errs, validateGenesisStateSupply(genesisState)...)
+	errs = append(errs, validateGenesisStateDeposits(genesisState)...)
+	return errs.err()
 }
 
 // Ensures that there are no duplicate accounts in the genesis state,
-func validateGenesisStateAccounts(accs []GenesisAccount) error {
+func validateGenesisStateAccounts(accs []GenesisAccount) (errs GenesisErrors) {
 	addrMap := make(map[string]bool, len(accs))
 	for i := 0; i < len(accs); i++ {
 		acc := accs[i]
+		path := fmt.Sprintf("[%d]", i)
 		strAddr := string(acc.Address)
 		if _, ok := addrMap[strAddr]; ok {
-			return fmt.Errorf("Duplicate account in genesis state: Address %v", acc.Address)
+			errs = append(errs, GenesisError{Module: accountsGenesisName, Path: path,
+				Err: fmt.Errorf("Duplicate account in genesis state: Address %v", acc.Address)})
 		}
 		addrMap[strAddr] = true
+		if err := acc.Coins.Validate(); err != nil {
+			errs = append(errs, GenesisError{Module: accountsGenesisName, Path: path + ".coins",
+				Err: fmt.Errorf("Invalid coins of account %v in genesis state: %v", acc.Address, err)})
+		}
 	}
-	return nil
+	return errs
 }
 
 // GaiaAppGenState but with JSON
@@ -240,6 +293,9 @@ func GaiaAppGenStateJSON(cdc *codec.Codec, genDoc tmtypes.GenesisDoc, appGenTxs
 
 // CollectStdTxs processes and validates application's genesis StdTxs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
+// Each genesis transaction must be signed for the chain of the genesis
+// document by the delegator of its MsgCreateValidator, and the validators,
+// their consensus public keys and delegators must be distinct.
 func CollectStdTxs(cdc *codec.Codec, moniker string, genTxsDir string, genDoc tmtypes.GenesisDoc) (
 	appGenTxs []auth.StdTx, persistentPeers string, err error) {
 
@@ -265,9 +321,13 @@ func CollectStdTxs(cdc *codec.Codec, moniker string, genTxsDir string, genDoc tm
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	// the validators, consensus public keys and delegators of the collected
+	// genesis transactions, each file creating a single validator
+	seen := make(map[string]string)
+
 	for _, fo := range fos {
 		filename := filepath.Join(genTxsDir, fo.Name())
-		if !fo.IsDir() && (filepath.Ext(filename) != ".json") {
+		if fo.IsDir() || (filepath.Ext(filename) != ".json") {
 			continue
 		}
 
@@ -291,15 +351,25 @@ func CollectStdTxs(cdc *codec.Codec, moniker string, genTxsDir string, genDoc tm
 				"couldn't find node's address and IP in %s", fo.Name())
 		}
 
-		// genesis transactions must be single-message
-		msgs := genStdTx.GetMsgs()
-		if len(msgs) != 1 {
-
-			return appGenTxs, persistentPeers, errors.New(
-				"each genesis transaction must provide a single genesis message")
+		msg, err := validateGenTx(genStdTx, genDoc.ChainID)
+		if err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction %s: %v", fo.Name(), err)
+		}
+		consPubKey, err := sdk.Bech32ifyConsPub(msg.PubKey)
+		if err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction %s: %v", fo.Name(), err)
+		}
+		for _, key := range []string{
+			"validator " + msg.ValidatorAddr.String(),
+			"consensus public key " + consPubKey,
+			"delegator " + msg.DelegatorAddr.String(),
+		} {
+			if other, ok := seen[key]; ok {
+				return appGenTxs, persistentPeers, fmt.Errorf(
+					"genesis transactions %s and %s have the same %s", other, fo.Name(), key)
+			}
+			seen[key] = fo.Name()
 		}
-
-		msg := msgs[0].(staking.MsgCreateValidator)
 		// validate delegator and validator addresses and funds against the accounts in the state
 		delAddr := msg.DelegatorAddr.String()
 		valAddr := sdk.AccAddress(msg.ValidatorAddr).String()
@@ -338,6 +408,42 @@ func CollectStdTxs(cdc *codec.Codec, moniker string, genTxsDir string, genDoc tm
 	return appGenTxs, persistentPeers, nil
 }
 
+// validateGenTx checks that the genesis transaction carries a single valid
+// MsgCreateValidator, signed for the chain by its delegator only, and returns
+// the message.
+func validateGenTx(tx auth.StdTx, chainID string) (msg staking.MsgCreateValidator, err error) {
+	// genesis transactions must be single-message
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return msg, errors.New("each genesis transaction must provide a single genesis message")
+	}
+	msg, ok := msgs[0].(staking.MsgCreateValidator)
+	if !ok {
+		return msg, fmt.Errorf("the genesis message is a %T, not a MsgCreateValidator", msgs[0])
+	}
+	if err := tx.ValidateBasic(); err != nil {
+		return msg, errors.New(err.Error())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return msg, errors.New(err.Error())
+	}
+	if msg.PubKey == nil {
+		return msg, errors.New("the genesis message has no consensus public key")
+	}
+
+	// the delegator account has the number and sequence 0 at genesis
+	sigs := tx.GetSignatures()
+	if len(sigs) != 1 || sigs[0].PubKey == nil || !bytes.Equal(sigs[0].PubKey.Address(), msg.DelegatorAddr) {
+		return msg, fmt.Errorf("the genesis transaction must be signed by the delegator %s only", msg.DelegatorAddr)
+	}
+	signBytes := auth.StdSignBytes(chainID, 0, 0, tx.Fee, tx.Msgs, tx.Memo)
+	if !sigs[0].PubKey.VerifyBytes(signBytes, sigs[0].Signature) {
+		return msg, fmt.Errorf("invalid signature of the delegator %s, the genesis transaction must be "+
+			"signed for chain %s", msg.DelegatorAddr, chainID)
+	}
+	return msg, nil
+}
+
 func NewDefaultGenesisAccount(addr sdk.AccAddress) GenesisAccount {
 	accAuth := auth.NewBaseAccountWithAddress(addr)
 	coins := sdk.Coins{
@@ -2,6 +2,10 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -133,6 +137,15 @@ func TestGaiaGenesisValidation(t *testing.T) {
 	genesisState.StakingData.Validators = append(genesisState.StakingData.Validators, val2)
 	err = GaiaValidateGenesisState(genesisState)
 	require.NotNil(t, err)
+	// Test account with unsorted coins fails
+	genesisState = makeGenesisState(t, nil)
+	genesisState.Accounts = append(genesisState.Accounts, GenesisAccount{
+		Address: sdk.AccAddress(pk1.Address()),
+		Coins:   sdk.Coins{sdk.NewInt64Coin("tree", 1), sdk.NewInt64Coin("gas", 1)},
+	})
+	err = GaiaValidateGenesisState(genesisState)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not sorted")
 }
 
 func TestNewDefaultGenesisAccount(t *testing.T) {
@@ -141,3 +154,93 @@ func TestNewDefaultGenesisAccount(t *testing.T) {
 	require.Equal(t, sdk.NewInt(1000), acc.Coins.AmountOf("footoken"))
 	require.Equal(t, sdk.NewInt(150), acc.Coins.AmountOf(bondDenom))
 }
+
+// makeSignedGenTx returns the genesis transaction creating the validator of
+// the private key, signed for the chain
+func makeSignedGenTx(t *testing.T, chainID string, priv crypto.PrivKey, consPubKey crypto.PubKey) auth.StdTx {
+	msg := staking.NewMsgCreateValidator(sdk.ValAddress(priv.PubKey().Address()), consPubKey,
+		sdk.NewInt64Coin(bondDenom, 50), staking.NewDescription("validator", "", "", ""), stakingTypes.CommissionMsg{})
+	fee := auth.NewStdFee(200000, nil)
+	memo := "node@127.0.0.1:26656"
+	sig, err := priv.Sign(auth.StdSignBytes(chainID, 0, 0, fee, []sdk.Msg{msg}, memo))
+	require.NoError(t, err)
+	return auth.NewStdTx([]sdk.Msg{msg}, fee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, memo)
+}
+
+func TestCollectStdTxs(t *testing.T) {
+	cdc := MakeCodec()
+	privs := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+
+	appState := NewDefaultGenesisState()
+	for _, priv := range privs {
+		appState.Accounts = append(appState.Accounts, GenesisAccount{
+			Address: sdk.AccAddress(priv.PubKey().Address()),
+			Coins:   sdk.Coins{sdk.NewInt64Coin(bondDenom, 100)},
+		})
+	}
+	genDoc := tmtypes.GenesisDoc{ChainID: "test-chain"}
+	var err error
+	genDoc.AppState, err = cdc.MarshalJSON(appState)
+	require.NoError(t, err)
+
+	// collects the gentxs of the directory, ignoring the other files
+	collect := func(genTxs ...auth.StdTx) error {
+		dir, err := ioutil.TempDir("", "gentxs")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir.json"), 0700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), []byte("gentxs"), 0600))
+		for i, genTx := range genTxs {
+			bz, err := cdc.MarshalJSON(genTx)
+			require.NoError(t, err)
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("gentx-%d.json", i)), bz, 0600))
+		}
+
+		collected, _, err := CollectStdTxs(cdc, "", dir, genDoc)
+		if err == nil {
+			require.Len(t, collected, len(genTxs))
+		}
+		return err
+	}
+
+	consPubKeys := []crypto.PubKey{ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()}
+	require.NoError(t, collect(
+		makeSignedGenTx(t, genDoc.ChainID, privs[0], consPubKeys[0]),
+		makeSignedGenTx(t, genDoc.ChainID, privs[1], consPubKeys[1]),
+	))
+
+	// signed for another chain
+	err = collect(makeSignedGenTx(t, "other-chain", privs[0], consPubKeys[0]))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "signed for chain test-chain")
+
+	// signed by another key than the delegator's
+	genTx := makeSignedGenTx(t, genDoc.ChainID, privs[0], consPubKeys[0])
+	genTx.Signatures[0].PubKey = privs[1].PubKey()
+	require.Error(t, collect(genTx))
+
+	// two validators with the same consensus public key
+	err = collect(
+		makeSignedGenTx(t, genDoc.ChainID, privs[0], consPubKeys[0]),
+		makeSignedGenTx(t, genDoc.ChainID, privs[1], consPubKeys[0]),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "same consensus public key")
+
+	// without consensus public key
+	genTx = makeSignedGenTx(t, genDoc.ChainID, privs[0], consPubKeys[0])
+	msg := genTx.Msgs[0].(staking.MsgCreateValidator)
+	msg.PubKey = nil
+	genTx.Msgs = []sdk.Msg{msg}
+	err = collect(genTx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no consensus public key")
+
+	// another message than MsgCreateValidator
+	genTx = makeSignedGenTx(t, genDoc.ChainID, privs[0], consPubKeys[0])
+	genTx.Msgs = []sdk.Msg{staking.NewMsgDelegate(sdk.AccAddress(privs[0].PubKey().Address()),
+		sdk.ValAddress(privs[0].PubKey().Address()), sdk.NewInt64Coin(bondDenom, 50))}
+	err = collect(genTx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not a MsgCreateValidator")
+}
@@ -1,26 +1,22 @@
 package app
 
 import (
-	"fmt"
-	"time"
-
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
-	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
-	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
 )
 
-func (app *GaiaApp) runtimeInvariants() []simulation.Invariant {
-	return []simulation.Invariant{
-		banksim.NonnegativeBalanceInvariant(app.accountKeeper),
-		distrsim.NonNegativeOutstandingInvariant(app.distrKeeper),
+// registerInvariants registers the runtime invariants on the crisis keeper,
+// making them verifiable through a MsgVerifyInvariant
+func (app *GaiaApp) registerInvariants() {
+	app.mm.RegisterInvariants(&app.crisisKeeper)
+
+	// the supply invariant spans several modules
+	app.crisisKeeper.RegisterRoute(staking.RouterKey, "supply",
 		stakingsim.SupplyInvariants(app.bankKeeper, app.stakingKeeper,
-			app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper),
-		stakingsim.NonNegativePowerInvariant(app.stakingKeeper),
-	}
+			app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper))
 }
 
 func (app *GaiaApp) assertRuntimeInvariants() {
@@ -29,14 +25,5 @@ func (app *GaiaApp) assertRuntimeInvariants() {
 }
 
 func (app *GaiaApp) assertRuntimeInvariantsOnContext(ctx sdk.Context) {
-	start := time.Now()
-	invariants := app.runtimeInvariants()
-	for _, inv := range invariants {
-		if err := inv(ctx); err != nil {
-			panic(fmt.Errorf("invariant broken: %s", err))
-		}
-	}
-	end := time.Now()
-	diff := end.Sub(start)
-	app.BaseApp.Logger.With("module", "invariants").Info("Asserted all invariants", "duration", diff)
+	app.crisisKeeper.AssertInvariants(ctx)
 }
@@ -0,0 +1,25 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// NewGenesisMigrator returns the migrator of the gaia genesis state of the
+// previous release, with the migrations registered by the modules whose
+// genesis state changed since.
+func NewGenesisMigrator() *sdk.GenesisMigrator {
+	m := sdk.NewGenesisMigrator()
+	auth.RegisterGenesisMigration(m)
+	staking.RegisterGenesisMigration(m)
+	distr.RegisterGenesisMigration(m)
+	gov.RegisterGenesisMigration(m)
+	crisis.RegisterGenesisMigration(m)
+	evidence.RegisterGenesisMigration(m)
+	return m
+}
@@ -8,7 +8,6 @@ import (
 	"math/rand"
 	"os"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -17,15 +16,19 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authsim "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrsim "github.com/cosmos/cosmos-sdk/x/distribution/simulation"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
-	"github.com/cosmos/cosmos-sdk/x/mint"
+	mintsim "github.com/cosmos/cosmos-sdk/x/mint/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingsim "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
@@ -42,6 +45,11 @@ var (
 	verbose   bool
 	commit    bool
 	period    int
+
+	exportPath string
+	replayPath string
+
+	importExportPeriod int
 )
 
 func init() {
@@ -52,24 +60,25 @@ func init() {
 	flag.BoolVar(&verbose, "SimulationVerbose", false, "Verbose log output")
 	flag.BoolVar(&commit, "SimulationCommit", false, "Have the simulation commit")
 	flag.IntVar(&period, "SimulationPeriod", 1, "Run slow invariants only once every period assertions")
+	flag.StringVar(&exportPath, "SimulationExportPath", "", "File to export the seed, params and operation schedule of the simulation to")
+	flag.StringVar(&replayPath, "SimulationReplayPath", "", "File of an exported simulation to replay, overriding the other simulation flags")
+	flag.IntVar(&importExportPeriod, "SimulationImportExportPeriod", 0, "Check every period assertions that the exported genesis imports into the same stores, if not 0")
 }
 
-func appStateFn(r *rand.Rand, accs []simulation.Account, genesisTimestamp time.Time) json.RawMessage {
-	var genesisAccounts []GenesisAccount
+// accountsSimulation generates the genesis accounts of the simulation, some of
+// them vesting, and must be the first simulated module.
+type accountsSimulation struct{}
 
-	amount := int64(r.Intn(1e6))
-	numInitiallyBonded := int64(r.Intn(250))
-	numAccs := int64(len(accs))
-	if numInitiallyBonded > numAccs {
-		numInitiallyBonded = numAccs
-	}
-	fmt.Printf("Selected randomly generated parameters for simulated genesis:\n"+
-		"\t{amount of steak per account: %v, initially bonded validators: %v}\n",
-		amount, numInitiallyBonded)
+func (accountsSimulation) Name() string { return "accounts" }
+
+func (accountsSimulation) RandomizedGenesis(simState *simulation.GenesisState) {
+	r := simState.Rand
+
+	var genesisAccounts []GenesisAccount
 
 	// randomly generate some genesis accounts
-	for i, acc := range accs {
-		coins := sdk.Coins{sdk.NewCoin(stakingTypes.DefaultBondDenom, sdk.NewInt(amount))}
+	for i, acc := range simState.Accounts {
+		coins := sdk.Coins{sdk.NewCoin(stakingTypes.DefaultBondDenom, sdk.NewInt(simState.InitialStake))}
 		bacc := auth.NewBaseAccountWithAddress(acc.Address)
 		bacc.SetCoins(coins)
 
@@ -77,20 +86,20 @@ func appStateFn(r *rand.Rand, accs []simulation.Account, genesisTimestamp time.T
 
 		// Only consider making a vesting account once the initial bonded validator
 		// set is exhausted due to needing to track DelegatedVesting.
-		if int64(i) > numInitiallyBonded && r.Intn(100) < 50 {
+		if int64(i) > simState.NumBonded && r.Intn(100) < 50 {
 			var (
 				vacc    auth.VestingAccount
 				endTime int
 			)
 
-			startTime := genesisTimestamp.Unix()
+			startTime := simState.GenesisTimestamp.Unix()
 
 			// Allow for some vesting accounts to vest very quickly while others very
 			// slowly.
 			if r.Intn(100) < 50 {
-				endTime = randIntBetween(r, int(startTime), int(startTime+(60*60*24*30)))
+				endTime = simulation.RandIntBetween(r, int(startTime), int(startTime+(60*60*24*30)))
 			} else {
-				endTime = randIntBetween(r, int(startTime), int(startTime+(60*60*12)))
+				endTime = simulation.RandIntBetween(r, int(startTime), int(startTime+(60*60*12)))
 			}
 
 			if r.Intn(100) < 50 {
@@ -107,149 +116,98 @@ func appStateFn(r *rand.Rand, accs []simulation.Account, genesisTimestamp time.T
 		genesisAccounts = append(genesisAccounts, gacc)
 	}
 
-	authGenesis := auth.GenesisState{
-		Params: auth.Params{
-			MemoCostPerByte:        uint64(r.Intn(10) + 1),
-			MaxMemoCharacters:      uint64(r.Intn(200-100) + 100),
-			TxSigLimit:             uint64(r.Intn(7) + 1),
-			SigVerifyCostED25519:   uint64(r.Intn(1000-500) + 500),
-			SigVerifyCostSecp256k1: uint64(r.Intn(1000-500) + 500),
-		},
-	}
-	fmt.Printf("Selected randomly generated auth parameters:\n\t%+v\n", authGenesis)
-
-	// Random genesis states
-	vp := time.Duration(r.Intn(2*172800)) * time.Second
-	govGenesis := gov.GenesisState{
-		StartingProposalID: uint64(r.Intn(100)),
-		DepositParams: gov.DepositParams{
-			MinDeposit:       sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, int64(r.Intn(1e3)))},
-			MaxDepositPeriod: vp,
-		},
-		VotingParams: gov.VotingParams{
-			VotingPeriod: vp,
-		},
-		TallyParams: gov.TallyParams{
-			Threshold:         sdk.NewDecWithPrec(5, 1),
-			Veto:              sdk.NewDecWithPrec(334, 3),
-			GovernancePenalty: sdk.NewDecWithPrec(1, 2),
-		},
-	}
-	fmt.Printf("Selected randomly generated governance parameters:\n\t%+v\n", govGenesis)
-
-	stakingGenesis := staking.GenesisState{
-		Pool: staking.InitialPool(),
-		Params: staking.Params{
-			UnbondingTime: time.Duration(randIntBetween(r, 60, 60*60*24*3*2)) * time.Second,
-			MaxValidators: uint16(r.Intn(250)),
-			BondDenom:     stakingTypes.DefaultBondDenom,
-		},
-	}
-	fmt.Printf("Selected randomly generated staking parameters:\n\t%+v\n", stakingGenesis)
-
-	slashingGenesis := slashing.GenesisState{
-		Params: slashing.Params{
-			MaxEvidenceAge:          stakingGenesis.Params.UnbondingTime,
-			SignedBlocksWindow:      int64(randIntBetween(r, 10, 1000)),
-			MinSignedPerWindow:      sdk.NewDecWithPrec(int64(r.Intn(10)), 1),
-			DowntimeJailDuration:    time.Duration(randIntBetween(r, 60, 60*60*24)) * time.Second,
-			SlashFractionDoubleSign: sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(50) + 1))),
-			SlashFractionDowntime:   sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1))),
-		},
-	}
-	fmt.Printf("Selected randomly generated slashing parameters:\n\t%+v\n", slashingGenesis)
-
-	mintGenesis := mint.GenesisState{
-		Minter: mint.InitialMinter(
-			sdk.NewDecWithPrec(int64(r.Intn(99)), 2)),
-		Params: mint.NewParams(
-			stakingTypes.DefaultBondDenom,
-			sdk.NewDecWithPrec(int64(r.Intn(99)), 2),
-			sdk.NewDecWithPrec(20, 2),
-			sdk.NewDecWithPrec(7, 2),
-			sdk.NewDecWithPrec(67, 2),
-			uint64(60*60*8766/5)),
-	}
-	fmt.Printf("Selected randomly generated minting parameters:\n\t%+v\n", mintGenesis)
-
-	var validators []staking.Validator
-	var delegations []staking.Delegation
-
-	valAddrs := make([]sdk.ValAddress, numInitiallyBonded)
-	for i := 0; i < int(numInitiallyBonded); i++ {
-		valAddr := sdk.ValAddress(accs[i].Address)
-		valAddrs[i] = valAddr
-
-		validator := staking.NewValidator(valAddr, accs[i].PubKey, staking.Description{})
-		validator.Tokens = sdk.NewInt(amount)
-		validator.DelegatorShares = sdk.NewDec(amount)
-		delegation := staking.Delegation{accs[i].Address, valAddr, sdk.NewDec(amount)}
-		validators = append(validators, validator)
-		delegations = append(delegations, delegation)
-	}
+	simState.SetGenesis("accounts", genesisAccounts)
+}
+
+func (accountsSimulation) WeightedOperations() []simulation.WeightedOperation { return nil }
+
+// simulationManager returns the manager of the simulated modules of the app,
+// the staking module being simulated before the slashing one which depends
+// on its unbonding time
+func simulationManager(app *GaiaApp) *simulation.SimulationManager {
+	return simulation.NewSimulationManager(
+		accountsSimulation{},
+		authsim.NewAppModule(app.accountKeeper, app.feeCollectionKeeper),
+		banksim.NewAppModule(app.accountKeeper, app.bankKeeper),
+		govsim.NewAppModule(app.govKeeper, app.stakingKeeper),
+		stakingsim.NewAppModule(app.accountKeeper, app.stakingKeeper),
+		slashingsim.NewAppModule(app.slashingKeeper),
+		mintsim.NewAppModule(),
+		distrsim.NewAppModule(app.accountKeeper, app.distrKeeper),
+		simulation.NewDefaultGenesisModule(crisis.ModuleName, crisis.DefaultGenesisState()),
+		simulation.NewDefaultGenesisModule(evidence.ModuleName, evidence.DefaultGenesisState()),
+	)
+}
 
-	stakingGenesis.Pool.LooseTokens = sdk.NewInt((amount * numAccs) + (numInitiallyBonded * amount))
-	stakingGenesis.Validators = validators
-	stakingGenesis.Bonds = delegations
+// invariants returns the invariants checked by the simulation every period
+// blocks, dumping the exported state of the app when one is broken
+func invariants(app *GaiaApp) []simulation.Invariant {
+	ir := simulation.NewInvariantRegistry(app.dumpState)
+	ir.RegisterRoute(bank.RouterKey, "nonnegative-balance", banksim.NonnegativeBalanceInvariant(app.accountKeeper))
+	ir.RegisterRoute(gov.RouterKey, "all", govsim.AllInvariants())
+	ir.RegisterRoute(distr.RouterKey, "can-withdraw", distrsim.CanWithdrawInvariant(app.distrKeeper, app.stakingKeeper))
+	ir.RegisterRoute(distr.RouterKey, "nonnegative-outstanding", distrsim.NonNegativeOutstandingInvariant(app.distrKeeper))
+	ir.RegisterRoute(staking.RouterKey, "supply", stakingsim.SupplyInvariants(app.bankKeeper, app.stakingKeeper,
+		app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper))
+	ir.RegisterRoute(staking.RouterKey, "nonnegative-power", stakingsim.NonNegativePowerInvariant(app.stakingKeeper))
+	ir.RegisterRoute(staking.RouterKey, "positive-delegation", stakingsim.PositiveDelegationInvariant(app.stakingKeeper))
+	ir.RegisterRoute(staking.RouterKey, "delegator-shares", stakingsim.DelegatorSharesInvariant(app.stakingKeeper))
+	ir.RegisterRoute(slashing.RouterKey, "all", slashingsim.AllInvariants())
+
+	invs := ir.Invariants(period)
+	if importExportPeriod > 0 {
+		invs = append(invs, simulation.PeriodicInvariant(
+			simulation.ImportExportInvariant(app.exportImport), importExportPeriod, 0))
+	}
+	return invs
+}
 
-	distrGenesis := distr.GenesisState{
-		FeePool:             distr.InitialFeePool(),
-		CommunityTax:        sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
-		BonusProposerReward: sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
-	}
-	fmt.Printf("Selected randomly generated distribution parameters:\n\t%+v\n", distrGenesis)
-
-	genesis := GenesisState{
-		Accounts:     genesisAccounts,
-		AuthData:     authGenesis,
-		StakingData:  stakingGenesis,
-		MintData:     mintGenesis,
-		DistrData:    distrGenesis,
-		SlashingData: slashingGenesis,
-		GovData:      govGenesis,
+// storePairs returns the substores of the app to compare with the ones of the
+// app imported from its exported genesis state
+func storePairs(app, newApp *GaiaApp) []simulation.StorePair {
+	return []simulation.StorePair{
+		{A: app.keyMain, B: newApp.keyMain, SkipPrefixes: [][]byte{}},
+		{A: app.keyAccount, B: newApp.keyAccount, SkipPrefixes: [][]byte{}},
+		{A: app.keyStaking, B: newApp.keyStaking, SkipPrefixes: [][]byte{staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey}}, // ordering may change but it doesn't matter
+		{A: app.keySlashing, B: newApp.keySlashing, SkipPrefixes: [][]byte{}},
+		{A: app.keyMint, B: newApp.keyMint, SkipPrefixes: [][]byte{}},
+		{A: app.keyDistr, B: newApp.keyDistr, SkipPrefixes: [][]byte{}},
+		{A: app.keyFeeCollection, B: newApp.keyFeeCollection, SkipPrefixes: [][]byte{}},
+		{A: app.keyParams, B: newApp.keyParams, SkipPrefixes: [][]byte{}},
+		{A: app.keyGov, B: newApp.keyGov, SkipPrefixes: [][]byte{}},
 	}
+}
 
-	// Marshal genesis
-	appState, err := MakeCodec().MarshalJSON(genesis)
+// exportImport exports the genesis state of the app at the given context and
+// imports it into a fresh app
+func (app *GaiaApp) exportImport(ctx sdk.Context) (sdk.Context, []simulation.StorePair, error) {
+	appState, err := app.dumpState(ctx)
 	if err != nil {
-		panic(err)
+		return sdk.Context{}, nil, err
+	}
+	var genesisState GenesisState
+	if err := app.cdc.UnmarshalJSON(appState, &genesisState); err != nil {
+		return sdk.Context{}, nil, err
 	}
 
-	return appState
-}
-
-func randIntBetween(r *rand.Rand, min, max int) int {
-	return r.Intn(max-min) + min
+	newApp := NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, fauxMerkleModeOpt)
+	newCtx := newApp.NewContext(true, abci.Header{})
+	newApp.initFromGenesisState(newCtx, genesisState)
+	return newCtx, storePairs(app, newApp), nil
 }
 
-func testAndRunTxs(app *GaiaApp) []simulation.WeightedOperation {
-	return []simulation.WeightedOperation{
-		{5, authsim.SimulateDeductFee(app.accountKeeper, app.feeCollectionKeeper)},
-		{100, banksim.SingleInputSendMsg(app.accountKeeper, app.bankKeeper)},
-		{50, distrsim.SimulateMsgSetWithdrawAddress(app.accountKeeper, app.distrKeeper)},
-		{50, distrsim.SimulateMsgWithdrawDelegatorReward(app.accountKeeper, app.distrKeeper)},
-		{50, distrsim.SimulateMsgWithdrawValidatorCommission(app.accountKeeper, app.distrKeeper)},
-		{5, govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, app.stakingKeeper)},
-		{100, govsim.SimulateMsgDeposit(app.govKeeper)},
-		{100, stakingsim.SimulateMsgCreateValidator(app.accountKeeper, app.stakingKeeper)},
-		{5, stakingsim.SimulateMsgEditValidator(app.stakingKeeper)},
-		{100, stakingsim.SimulateMsgDelegate(app.accountKeeper, app.stakingKeeper)},
-		{100, stakingsim.SimulateMsgUndelegate(app.accountKeeper, app.stakingKeeper)},
-		{100, stakingsim.SimulateMsgBeginRedelegate(app.accountKeeper, app.stakingKeeper)},
-		{100, slashingsim.SimulateMsgUnjail(app.slashingKeeper)},
-	}
-}
+// dumpState returns the genesis state exported by the modules of the app at
+// the given context, with its accounts
+func (app *GaiaApp) dumpState(ctx sdk.Context) (json.RawMessage, error) {
+	var accounts []GenesisAccount
+	app.accountKeeper.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		accounts = append(accounts, NewGenesisAccountI(acc))
+		return false
+	})
 
-func invariants(app *GaiaApp) []simulation.Invariant {
-	return []simulation.Invariant{
-		simulation.PeriodicInvariant(banksim.NonnegativeBalanceInvariant(app.accountKeeper), period, 0),
-		simulation.PeriodicInvariant(govsim.AllInvariants(), period, 0),
-		simulation.PeriodicInvariant(distrsim.AllInvariants(app.distrKeeper, app.stakingKeeper), period, 0),
-		simulation.PeriodicInvariant(stakingsim.AllInvariants(app.bankKeeper, app.stakingKeeper,
-			app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper), period, 0),
-		simulation.PeriodicInvariant(slashingsim.AllInvariants(), period, 0),
-	}
+	genesisData := app.mm.ExportGenesis(ctx)
+	genesisData["accounts"] = app.cdc.MustMarshalJSON(accounts)
+	return codec.MarshalJSONIndent(app.cdc, genesisData)
 }
 
 // Pass this in as an option to use a dbStoreAdapter instead of an IAVLStore for simulation speed.
@@ -275,8 +233,8 @@ func BenchmarkFullGaiaSimulation(b *testing.B) {
 	// Run randomized simulation
 	// TODO parameterize numbers, save for a later PR
 	_, err := simulation.SimulateFromSeed(
-		b, app.BaseApp, appStateFn, seed,
-		testAndRunTxs(app),
+		b, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+		simulationManager(app).WeightedOperations(),
 		invariants(app), // these shouldn't get ran
 		numBlocks,
 		blockSize,
@@ -315,15 +273,39 @@ func TestFullGaiaSimulation(t *testing.T) {
 	app := NewGaiaApp(logger, db, nil, true, fauxMerkleModeOpt)
 	require.Equal(t, "GaiaApp", app.Name())
 
-	// Run randomized simulation
-	_, err := simulation.SimulateFromSeed(
-		t, app.BaseApp, appStateFn, seed,
-		testAndRunTxs(app),
-		invariants(app),
-		numBlocks,
-		blockSize,
-		commit,
-	)
+	// Run randomized simulation, or replay an exported one
+	var err error
+	switch {
+	case replayPath != "":
+		record, ierr := simulation.ImportRecord(replayPath)
+		require.NoError(t, ierr)
+		commit = record.Commit
+		_, err = simulation.ReplaySimulation(
+			t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()),
+			simulationManager(app).WeightedOperations(),
+			invariants(app),
+			record,
+		)
+	case exportPath != "":
+		_, err = simulation.SimulateAndExport(
+			t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+			simulationManager(app).WeightedOperations(),
+			invariants(app),
+			numBlocks,
+			blockSize,
+			commit,
+			exportPath,
+		)
+	default:
+		_, err = simulation.SimulateFromSeed(
+			t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+			simulationManager(app).WeightedOperations(),
+			invariants(app),
+			numBlocks,
+			blockSize,
+			commit,
+		)
+	}
 	if commit {
 		// for memdb:
 		// fmt.Println("Database Size", db.Stats()["database.size"])
@@ -358,8 +340,8 @@ func TestGaiaImportExport(t *testing.T) {
 
 	// Run randomized simulation
 	_, err := simulation.SimulateFromSeed(
-		t, app.BaseApp, appStateFn, seed,
-		testAndRunTxs(app),
+		t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+		simulationManager(app).WeightedOperations(),
 		invariants(app),
 		numBlocks,
 		blockSize,
@@ -376,7 +358,7 @@ func TestGaiaImportExport(t *testing.T) {
 
 	fmt.Printf("Exporting genesis...\n")
 
-	appState, _, err := app.ExportAppStateAndValidators(false)
+	appState, _, err := app.ExportAppStateAndValidators(false, -1)
 	if err != nil {
 		panic(err)
 	}
@@ -401,26 +383,10 @@ func TestGaiaImportExport(t *testing.T) {
 
 	fmt.Printf("Comparing stores...\n")
 	ctxA := app.NewContext(true, abci.Header{})
-	type StoreKeysPrefixes struct {
-		A        sdk.StoreKey
-		B        sdk.StoreKey
-		Prefixes [][]byte
-	}
-	storeKeysPrefixes := []StoreKeysPrefixes{
-		{app.keyMain, newApp.keyMain, [][]byte{}},
-		{app.keyAccount, newApp.keyAccount, [][]byte{}},
-		{app.keyStaking, newApp.keyStaking, [][]byte{staking.UnbondingQueueKey, staking.RedelegationQueueKey, staking.ValidatorQueueKey}}, // ordering may change but it doesn't matter
-		{app.keySlashing, newApp.keySlashing, [][]byte{}},
-		{app.keyMint, newApp.keyMint, [][]byte{}},
-		{app.keyDistr, newApp.keyDistr, [][]byte{}},
-		{app.keyFeeCollection, newApp.keyFeeCollection, [][]byte{}},
-		{app.keyParams, newApp.keyParams, [][]byte{}},
-		{app.keyGov, newApp.keyGov, [][]byte{}},
-	}
-	for _, storeKeysPrefix := range storeKeysPrefixes {
-		storeKeyA := storeKeysPrefix.A
-		storeKeyB := storeKeysPrefix.B
-		prefixes := storeKeysPrefix.Prefixes
+	for _, storePair := range storePairs(app, newApp) {
+		storeKeyA := storePair.A
+		storeKeyB := storePair.B
+		prefixes := storePair.SkipPrefixes
 		storeA := ctxA.KVStore(storeKeyA)
 		storeB := ctxB.KVStore(storeKeyB)
 		kvA, kvB, count, equal := sdk.DiffKVStores(storeA, storeB, prefixes)
@@ -454,8 +420,8 @@ func TestGaiaSimulationAfterImport(t *testing.T) {
 
 	// Run randomized simulation
 	stopEarly, err := simulation.SimulateFromSeed(
-		t, app.BaseApp, appStateFn, seed,
-		testAndRunTxs(app),
+		t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+		simulationManager(app).WeightedOperations(),
 		invariants(app),
 		numBlocks,
 		blockSize,
@@ -478,7 +444,7 @@ func TestGaiaSimulationAfterImport(t *testing.T) {
 
 	fmt.Printf("Exporting genesis...\n")
 
-	appState, _, err := app.ExportAppStateAndValidators(true)
+	appState, _, err := app.ExportAppStateAndValidators(true, -1)
 	if err != nil {
 		panic(err)
 	}
@@ -499,8 +465,8 @@ func TestGaiaSimulationAfterImport(t *testing.T) {
 
 	// Run randomized simulation on imported app
 	_, err = simulation.SimulateFromSeed(
-		t, newApp.BaseApp, appStateFn, seed,
-		testAndRunTxs(newApp),
+		t, newApp.BaseApp, simulationManager(newApp).AppStateFn(MakeCodec()), seed,
+		simulationManager(newApp).WeightedOperations(),
 		invariants(newApp),
 		numBlocks,
 		blockSize,
@@ -530,8 +496,8 @@ func TestAppStateDeterminism(t *testing.T) {
 
 			// Run randomized simulation
 			simulation.SimulateFromSeed(
-				t, app.BaseApp, appStateFn, seed,
-				testAndRunTxs(app),
+				t, app.BaseApp, simulationManager(app).AppStateFn(MakeCodec()), seed,
+				simulationManager(app).WeightedOperations(),
 				[]simulation.Invariant{},
 				50,
 				100,
@@ -0,0 +1,222 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// name of the genesis accounts in the genesis errors, which are not the
+// genesis state of a module
+const accountsGenesisName = "accounts"
+
+// GenesisError is an invalid value of the genesis state of a module, located
+// by its JSON path in the genesis state of the module, if any.
+type GenesisError struct {
+	Module string
+	Path   string
+	Err    error
+}
+
+func (e GenesisError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("invalid genesis state of module %s: %v", e.Module, e.Err)
+	}
+	return fmt.Sprintf("invalid genesis state of module %s at %s: %v", e.Module, e.Path, e.Err)
+}
+
+// GenesisErrors are all the invalid values of a genesis state.
+type GenesisErrors []GenesisError
+
+func (errs GenesisErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// err returns the genesis errors, or nil without any
+func (errs GenesisErrors) err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// ValidateGenesisJSON decodes the JSON genesis state of gaia, module by module,
+// and validates it with GaiaValidateGenesisState. It returns the GenesisErrors
+// of all the modules failing to decode, with the JSON path of the first value
+// failing to decode in each of them, or else the ones of the validation.
+func ValidateGenesisJSON(cdc *codec.Codec, appState json.RawMessage) (GenesisState, error) {
+	var genesisState GenesisState
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &sections); err != nil {
+		return genesisState, fmt.Errorf("invalid genesis state: %v", err)
+	}
+
+	var errs GenesisErrors
+	typ := reflect.TypeOf(genesisState)
+	for i := 0; i < typ.NumField(); i++ {
+		name := jsonFieldName(typ.Field(i))
+		section, ok := sections[name]
+		if !ok {
+			continue
+		}
+		path, err := jsonErrorPath(cdc, section, typ.Field(i).Type, "")
+		if err != nil {
+			errs = append(errs, GenesisError{Module: name, Path: strings.TrimPrefix(path, "."), Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return genesisState, errs
+	}
+
+	if err := cdc.UnmarshalJSON(appState, &genesisState); err != nil {
+		return genesisState, err
+	}
+	return genesisState, GaiaValidateGenesisState(genesisState)
+}
+
+// jsonErrorPath returns the JSON path, below the given one, of the first value
+// of bz failing to decode into the type, and the error decoding it. It descends
+// into the fields of the structs, the elements of the slices and the values of
+// the maps, the JSON path being the one of bz if none fails on its own.
+func jsonErrorPath(cdc *codec.Codec, bz json.RawMessage, typ reflect.Type, path string) (string, error) {
+	err := cdc.UnmarshalJSON(bz, reflect.New(typ).Interface())
+	if err == nil {
+		return "", nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(bz, &fields) != nil {
+			break
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := jsonFieldName(field)
+			value, ok := fields[name]
+			if !ok {
+				continue
+			}
+			if fieldPath, fieldErr := jsonErrorPath(cdc, value, field.Type, path+"."+name); fieldErr != nil {
+				return fieldPath, fieldErr
+			}
+		}
+
+	case reflect.Slice:
+		// byte slices, e.g. addresses, are decoded from strings
+		if typ.Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		var elems []json.RawMessage
+		if json.Unmarshal(bz, &elems) != nil {
+			break
+		}
+		for i, elem := range elems {
+			if elemPath, elemErr := jsonErrorPath(cdc, elem, typ.Elem(), fmt.Sprintf("%s[%d]", path, i)); elemErr != nil {
+				return elemPath, elemErr
+			}
+		}
+
+	case reflect.Map:
+		var values map[string]json.RawMessage
+		if typ.Key().Kind() != reflect.String || json.Unmarshal(bz, &values) != nil {
+			break
+		}
+		for key, value := range values {
+			if valuePath, valueErr := jsonErrorPath(cdc, value, typ.Elem(), path+"."+key); valueErr != nil {
+				return valuePath, valueErr
+			}
+		}
+	}
+	return path, err
+}
+
+// jsonFieldName returns the JSON name of the struct field
+func jsonFieldName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// validateGenesisStateSupply checks that the staking pool accounts for the
+// staking tokens held by the other modules: the bonded tokens are the tokens
+// of the bonded validators, and the loose tokens the ones of the accounts, the
+// unbonding delegations, the unbonding and unbonded validators, the collected
+// fees, the community pool and the outstanding rewards.
+func validateGenesisStateSupply(genesisState GenesisState) (errs GenesisErrors) {
+	stakingData := genesisState.StakingData
+	bondDenom := stakingData.Params.BondDenom
+
+	loose, bonded := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, acc := range genesisState.Accounts {
+		loose = loose.Add(sdk.NewDecFromInt(acc.Coins.AmountOf(bondDenom)))
+	}
+	for _, ubd := range stakingData.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			loose = loose.Add(sdk.NewDecFromInt(entry.Balance.Amount))
+		}
+	}
+	for _, validator := range stakingData.Validators {
+		switch validator.Status {
+		case sdk.Bonded:
+			bonded = bonded.Add(sdk.NewDecFromInt(validator.Tokens))
+		case sdk.Unbonding, sdk.Unbonded:
+			loose = loose.Add(sdk.NewDecFromInt(validator.Tokens))
+		}
+	}
+	loose = loose.Add(sdk.NewDecFromInt(genesisState.AuthData.CollectedFees.AmountOf(bondDenom)))
+	loose = loose.Add(genesisState.DistrData.FeePool.CommunityPool.AmountOf(bondDenom))
+	loose = loose.Add(genesisState.DistrData.OutstandingRewards.AmountOf(bondDenom))
+
+	if !sdk.NewDecFromInt(stakingData.Pool.LooseTokens).Equal(loose) {
+		errs = append(errs, GenesisError{Module: "staking", Path: "pool.loose_tokens", Err: fmt.Errorf(
+			"loose tokens %v are not the %v %s of the accounts, unbonding delegations, unbonding "+
+				"and unbonded validators, collected fees, community pool and outstanding rewards",
+			stakingData.Pool.LooseTokens, loose, bondDenom)})
+	}
+	if !sdk.NewDecFromInt(stakingData.Pool.BondedTokens).Equal(bonded) {
+		errs = append(errs, GenesisError{Module: "staking", Path: "pool.bonded_tokens", Err: fmt.Errorf(
+			"bonded tokens %v are not the %v %s of the bonded validators",
+			stakingData.Pool.BondedTokens, bonded, bondDenom)})
+	}
+	return errs
+}
+
+// validateGenesisStateDeposits checks that the deposits of the proposals are
+// backed by the coins of the account holding them.
+func validateGenesisStateDeposits(genesisState GenesisState) (errs GenesisErrors) {
+	var deposits sdk.Coins
+	for _, deposit := range genesisState.GovData.Deposits {
+		deposits = deposits.Plus(deposit.Deposit.Amount)
+	}
+	if deposits.IsZero() {
+		return nil
+	}
+
+	var held sdk.Coins
+	for _, acc := range genesisState.Accounts {
+		if acc.Address.Equals(gov.DepositedCoinsAccAddr) {
+			held = acc.Coins
+		}
+	}
+	if !held.IsAllGTE(deposits) {
+		errs = append(errs, GenesisError{Module: gov.ModuleName, Path: "deposits", Err: fmt.Errorf(
+			"deposits of %v are not backed by the coins %v of the deposit account %s",
+			deposits, held, gov.DepositedCoinsAccAddr)})
+	}
+	return errs
+}
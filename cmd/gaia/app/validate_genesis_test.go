@@ -0,0 +1,106 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// makeValidGenesisState returns the default genesis state with an account
+// whose staking tokens are accounted for by the staking pool
+func makeValidGenesisState() GenesisState {
+	genesisState := NewDefaultGenesisState()
+	genesisState.Accounts = []GenesisAccount{{
+		Address: sdk.AccAddress(pk1.Address()),
+		Coins:   sdk.Coins{sdk.NewInt64Coin(bondDenom, 100)},
+	}}
+	genesisState.StakingData.Pool.LooseTokens = sdk.NewInt(100)
+	return genesisState
+}
+
+// setJSONPath sets the value at the path of the JSON object
+func setJSONPath(t *testing.T, bz json.RawMessage, value interface{}, path ...string) json.RawMessage {
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	var err error
+	if len(path) == 1 {
+		fields[path[0]], err = json.Marshal(value)
+		require.NoError(t, err)
+	} else {
+		fields[path[0]] = setJSONPath(t, fields[path[0]], value, path[1:]...)
+	}
+	bz, err = json.Marshal(fields)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestValidateGenesisJSON(t *testing.T) {
+	cdc := MakeCodec()
+	appState, err := cdc.MarshalJSON(makeValidGenesisState())
+	require.NoError(t, err)
+
+	_, err = ValidateGenesisJSON(cdc, appState)
+	require.NoError(t, err)
+
+	// every module failing to decode is reported with the path of the value
+	invalid := setJSONPath(t, appState, "1.5x", "staking", "pool", "loose_tokens")
+	invalid = setJSONPath(t, invalid, []map[string]interface{}{{
+		"address": sdk.AccAddress(pk1.Address()).String(),
+		"coins":   []map[string]string{{"denom": bondDenom, "amount": "ten"}},
+	}}, "accounts")
+	invalid = setJSONPath(t, invalid, "many", "auth", "params", "MaxMemoCharacters")
+	_, err = ValidateGenesisJSON(cdc, invalid)
+	require.Error(t, err)
+	errs, ok := err.(GenesisErrors)
+	require.True(t, ok)
+	require.Len(t, errs, 3)
+	require.Equal(t, "accounts", errs[0].Module)
+	require.Equal(t, "[0].coins[0].amount", errs[0].Path)
+	require.Equal(t, auth.ModuleName, errs[1].Module)
+	require.Equal(t, "params.MaxMemoCharacters", errs[1].Path)
+	require.Equal(t, "staking", errs[2].Module)
+	require.Equal(t, "pool.loose_tokens", errs[2].Path)
+	require.Contains(t, err.Error(), "invalid genesis state of module staking at pool.loose_tokens")
+}
+
+func TestGaiaValidateGenesisStateAcrossModules(t *testing.T) {
+	require.NoError(t, GaiaValidateGenesisState(makeValidGenesisState()))
+
+	// the staking tokens not accounted for by the pool and the deposits not
+	// backed by the deposit account are both reported
+	genesisState := makeValidGenesisState()
+	genesisState.StakingData.Pool.BondedTokens = sdk.NewInt(10)
+	genesisState.DistrData.OutstandingRewards = sdk.DecCoins{sdk.NewDecCoin(bondDenom, 5)}
+	genesisState.GovData.Deposits = []gov.DepositWithMetadata{{
+		ProposalID: 1,
+		Deposit: gov.Deposit{
+			Depositor:  sdk.AccAddress(pk1.Address()),
+			ProposalID: 1,
+			Amount:     sdk.Coins{sdk.NewInt64Coin(bondDenom, 10)},
+		},
+	}}
+	err := GaiaValidateGenesisState(genesisState)
+	require.Error(t, err)
+	errs, ok := err.(GenesisErrors)
+	require.True(t, ok)
+	require.Len(t, errs, 3)
+	require.Equal(t, "pool.loose_tokens", errs[0].Path)
+	require.Equal(t, "pool.bonded_tokens", errs[1].Path)
+	require.Equal(t, gov.ModuleName, errs[2].Module)
+	require.Equal(t, "deposits", errs[2].Path)
+
+	// the deposit account holding the deposits, accounted for by the pool
+	genesisState.StakingData.Pool = makeValidGenesisState().StakingData.Pool
+	genesisState.DistrData.OutstandingRewards = nil
+	genesisState.Accounts = append(genesisState.Accounts, GenesisAccount{
+		Address: gov.DepositedCoinsAccAddr,
+		Coins:   sdk.Coins{sdk.NewInt64Coin(bondDenom, 10)},
+	})
+	genesisState.StakingData.Pool.LooseTokens = sdk.NewInt(110)
+	require.NoError(t, GaiaValidateGenesisState(genesisState))
+}
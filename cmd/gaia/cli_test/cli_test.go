@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -348,6 +349,7 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 	f.QueryGovParamDeposit()
 	f.QueryGovParamVoting()
 	f.QueryGovParamTallying()
+	f.QueryGovParamProposal()
 
 	fooAddr := f.KeyAddress(keyFoo)
 
@@ -915,3 +917,19 @@ func TestSlashingGetParams(t *testing.T) {
 	require.Equal(t, int64(100), params.SignedBlocksWindow)
 	require.Equal(t, sdk.NewDecWithPrec(5, 1), params.MinSignedPerWindow)
 }
+
+func TestMintQueries(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start gaiad server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	params := f.QueryMintParams()
+	require.Equal(t, mint.DefaultParams().GoalBonded, params.GoalBonded)
+
+	inflation := f.QueryMintInflation()
+	require.True(t, inflation.GTE(params.InflationMin))
+	require.True(t, inflation.LTE(params.InflationMax))
+}
@@ -22,6 +22,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -118,7 +119,7 @@ func (f *Fixtures) Cleanup(dirs ...string) {
 
 // Flags returns the flags necessary for making most CLI calls
 func (f *Fixtures) Flags() string {
-	return fmt.Sprintf("--home=%s --node=%s", f.GCLIHome, f.RPCAddr)
+	return fmt.Sprintf("--home=%s --node=%s --keyring-backend=test", f.GCLIHome, f.RPCAddr)
 }
 
 //___________________________________________________________________________________
@@ -158,7 +159,7 @@ func (f *Fixtures) AddGenesisAccount(address sdk.AccAddress, coins sdk.Coins, fl
 
 // GenTx is gaiad gentx
 func (f *Fixtures) GenTx(name string, flags ...string) {
-	cmd := fmt.Sprintf("gaiad gentx --name=%s --home=%s --home-client=%s", name, f.GDHome, f.GCLIHome)
+	cmd := fmt.Sprintf("gaiad gentx --name=%s --home=%s --home-client=%s --keyring-backend=test", name, f.GDHome, f.GCLIHome)
 	executeWriteCheckErr(f.T, addFlags(cmd, flags), app.DefaultKeyPass)
 }
 
@@ -182,19 +183,19 @@ func (f *Fixtures) GDStart(flags ...string) *tests.Process {
 
 // KeysDelete is gaiacli keys delete
 func (f *Fixtures) KeysDelete(name string, flags ...string) {
-	cmd := fmt.Sprintf("gaiacli keys delete --home=%s %s", f.GCLIHome, name)
+	cmd := fmt.Sprintf("gaiacli keys delete --home=%s --keyring-backend=test %s", f.GCLIHome, name)
 	executeWrite(f.T, addFlags(cmd, append(append(flags, "-y"), "-f")))
 }
 
 // KeysAdd is gaiacli keys add
 func (f *Fixtures) KeysAdd(name string, flags ...string) {
-	cmd := fmt.Sprintf("gaiacli keys add --home=%s %s", f.GCLIHome, name)
+	cmd := fmt.Sprintf("gaiacli keys add --home=%s --keyring-backend=test %s", f.GCLIHome, name)
 	executeWriteCheckErr(f.T, addFlags(cmd, flags), app.DefaultKeyPass)
 }
 
 // KeysShow is gaiacli keys show
 func (f *Fixtures) KeysShow(name string, flags ...string) keys.KeyOutput {
-	cmd := fmt.Sprintf("gaiacli keys show --home=%s %s", f.GCLIHome, name)
+	cmd := fmt.Sprintf("gaiacli keys show --home=%s --keyring-backend=test %s", f.GCLIHome, name)
 	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
 	var ko keys.KeyOutput
 	err := keys.UnmarshalJSON([]byte(out), &ko)
@@ -423,6 +424,17 @@ func (f *Fixtures) QueryGovParamTallying() gov.TallyParams {
 	return tallyingParam
 }
 
+// QueryGovParamProposal is gaiacli query gov param proposal
+func (f *Fixtures) QueryGovParamProposal() gov.ProposalParams {
+	cmd := fmt.Sprintf("gaiacli query gov param proposal %s", f.Flags())
+	out, _ := tests.ExecuteT(f.T, cmd, "")
+	var proposalParam gov.ProposalParams
+	cdc := app.MakeCodec()
+	err := cdc.UnmarshalJSON([]byte(out), &proposalParam)
+	require.NoError(f.T, err, "out %v\n, err %v", out, err)
+	return proposalParam
+}
+
 // QueryGovProposals is gaiacli query gov proposals
 func (f *Fixtures) QueryGovProposals(flags ...string) string {
 	cmd := fmt.Sprintf("gaiacli query gov proposals %v", f.Flags())
@@ -501,6 +513,31 @@ func (f *Fixtures) QuerySlashingParams() slashing.Params {
 	return params
 }
 
+//___________________________________________________________________________________
+// query mint
+
+// QueryMintParams is gaiacli query mint params
+func (f *Fixtures) QueryMintParams() mint.Params {
+	cmd := fmt.Sprintf("gaiacli query mint params %s", f.Flags())
+	res, errStr := tests.ExecuteT(f.T, cmd, "")
+	require.Empty(f.T, errStr)
+	cdc := app.MakeCodec()
+	var params mint.Params
+	err := cdc.UnmarshalJSON([]byte(res), &params)
+	require.NoError(f.T, err)
+	return params
+}
+
+// QueryMintInflation is gaiacli query mint inflation
+func (f *Fixtures) QueryMintInflation() sdk.Dec {
+	cmd := fmt.Sprintf("gaiacli query mint inflation %s", f.Flags())
+	res, errStr := tests.ExecuteT(f.T, cmd, "")
+	require.Empty(f.T, errStr)
+	inflation, err := sdk.NewDecFromStr(res)
+	require.NoError(f.T, err)
+	return inflation
+}
+
 //___________________________________________________________________________________
 // executors
 
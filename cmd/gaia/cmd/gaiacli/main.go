@@ -2,13 +2,9 @@ package main
 
 import (
 	"fmt"
-	"net/http"
 	"os"
-	"path"
 
-	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
@@ -19,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -26,19 +23,30 @@ import (
 	auth "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
 	dist "github.com/cosmos/cosmos-sdk/x/distribution"
+	ev "github.com/cosmos/cosmos-sdk/x/evidence"
+	evidence "github.com/cosmos/cosmos-sdk/x/evidence/client/rest"
 	gv "github.com/cosmos/cosmos-sdk/x/gov"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	mn "github.com/cosmos/cosmos-sdk/x/mint"
+	mint "github.com/cosmos/cosmos-sdk/x/mint/client/rest"
+	pr "github.com/cosmos/cosmos-sdk/x/params"
 	sl "github.com/cosmos/cosmos-sdk/x/slashing"
 	slashing "github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
 	st "github.com/cosmos/cosmos-sdk/x/staking"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/client/rest"
+	up "github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	crisisClient "github.com/cosmos/cosmos-sdk/x/crisis/client"
 	distClient "github.com/cosmos/cosmos-sdk/x/distribution/client"
+	evidenceClient "github.com/cosmos/cosmos-sdk/x/evidence/client"
 	govClient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	mintClient "github.com/cosmos/cosmos-sdk/x/mint/client"
+	paramsClient "github.com/cosmos/cosmos-sdk/x/params/client"
 	slashingClient "github.com/cosmos/cosmos-sdk/x/slashing/client"
 	stakingClient "github.com/cosmos/cosmos-sdk/x/staking/client"
+	upgradecmd "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 
 	_ "github.com/cosmos/cosmos-sdk/client/lcd/statik"
 )
@@ -68,6 +76,10 @@ func main() {
 		distClient.NewModuleClient(dist.StoreKey, cdc),
 		stakingClient.NewModuleClient(st.StoreKey, cdc),
 		slashingClient.NewModuleClient(sl.StoreKey, cdc),
+		mintClient.NewModuleClient(mn.StoreKey, cdc),
+		paramsClient.NewModuleClient(pr.StoreKey, cdc),
+		crisisClient.NewModuleClient(cdc),
+		evidenceClient.NewModuleClient(ev.StoreKey, cdc),
 	}
 
 	rootCmd := &cobra.Command{
@@ -77,8 +89,9 @@ func main() {
 
 	// Add --chain-id to persistent flags and mark it required
 	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
-	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		return initConfig(rootCmd)
+	rootCmd.PersistentFlags().String(client.FlagKeyringBackend, crkeys.BackendLevelDB, "Keyring backend storing the keys (leveldb|os|file|pass|test)")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		return client.InitConfig(cmd)
 	}
 
 	// Construct Root Command
@@ -115,10 +128,12 @@ func queryCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
 	queryCmd.AddCommand(
 		rpc.ValidatorCommand(),
 		rpc.BlockCommand(),
+		rpc.StoreStatsCommand(),
 		tx.SearchTxCmd(cdc),
 		tx.QueryTxCmd(cdc),
 		client.LineBreak,
 		authcmd.GetAccountCmd(at.StoreKey, cdc),
+		upgradecmd.GetCmdQueryPlan(up.StoreKey, cdc),
 	)
 
 	for _, m := range mc {
@@ -154,7 +169,7 @@ func txCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
 // NOTE: details on the routes added for each module are in the module documentation
 // NOTE: If making updates here you also need to update the test helper in client/lcd/test_helper.go
 func registerRoutes(rs *lcd.RestServer) {
-	registerSwaggerUI(rs)
+	rs.RegisterSwaggerUI()
 	keys.RegisterRoutes(rs.Mux, rs.CliCtx.Indent)
 	rpc.RegisterRoutes(rs.CliCtx, rs.Mux)
 	tx.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
@@ -163,36 +178,6 @@ func registerRoutes(rs *lcd.RestServer) {
 	staking.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	slashing.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	gov.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
-}
-
-func registerSwaggerUI(rs *lcd.RestServer) {
-	statikFS, err := fs.New()
-	if err != nil {
-		panic(err)
-	}
-	staticServer := http.FileServer(statikFS)
-	rs.Mux.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", staticServer))
-}
-
-func initConfig(cmd *cobra.Command) error {
-	home, err := cmd.PersistentFlags().GetString(cli.HomeFlag)
-	if err != nil {
-		return err
-	}
-
-	cfgFile := path.Join(home, "config", "config.toml")
-	if _, err := os.Stat(cfgFile); err == nil {
-		viper.SetConfigFile(cfgFile)
-
-		if err := viper.ReadInConfig(); err != nil {
-			return err
-		}
-	}
-	if err := viper.BindPFlag(client.FlagChainID, cmd.PersistentFlags().Lookup(client.FlagChainID)); err != nil {
-		return err
-	}
-	if err := viper.BindPFlag(cli.EncodingFlag, cmd.PersistentFlags().Lookup(cli.EncodingFlag)); err != nil {
-		return err
-	}
-	return viper.BindPFlag(cli.OutputFlag, cmd.PersistentFlags().Lookup(cli.OutputFlag))
+	mint.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	evidence.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 }
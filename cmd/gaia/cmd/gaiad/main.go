@@ -43,6 +43,9 @@ func main() {
 	rootCmd.AddCommand(gaiaInit.TestnetFilesCmd(ctx, cdc))
 	rootCmd.AddCommand(gaiaInit.GenTxCmd(ctx, cdc))
 	rootCmd.AddCommand(gaiaInit.AddGenesisAccountCmd(ctx, cdc))
+	rootCmd.AddCommand(gaiaInit.MigrateGenesisCmd(ctx, cdc))
+	rootCmd.AddCommand(gaiaInit.ValidateGenesisCmd(ctx, cdc))
+	rootCmd.AddCommand(gaiaInit.PatchGenesisCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
@@ -56,23 +59,29 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewGaiaApp(logger, db, traceStore, true,
+	options := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(store.NewPruningOptions(viper.GetString("pruning"))),
 		baseapp.SetMinimumFees(viper.GetString("minimum_fees")),
-	)
+		baseapp.SetHaltHeight(uint64(viper.GetInt64("halt-height"))),
+		baseapp.SetHaltTime(uint64(viper.GetInt64("halt-time"))),
+		baseapp.SetWriteAheadTraceFile(viper.GetString("write-ahead-trace")),
+		baseapp.SetGasProfileBlocks(viper.GetInt("gas-profile-blocks")),
+		baseapp.SetTxTraceFile(viper.GetString("tx-trace")),
+		baseapp.SetStoreStats(viper.GetBool("store-stats")),
+	}
+
+	// the metrics of the app are served with the ones of Tendermint
+	if viper.GetBool("instrumentation.prometheus") {
+		metrics := baseapp.PrometheusMetrics(viper.GetString("instrumentation.namespace"))
+		options = append(options, baseapp.SetMetrics(metrics))
+	}
+
+	return app.NewGaiaApp(logger, db, traceStore, true, options...)
 }
 
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
-	if height != -1 {
-		gApp := app.NewGaiaApp(logger, db, traceStore, false)
-		err := gApp.LoadHeight(height)
-		if err != nil {
-			return nil, nil, err
-		}
-		return gApp.ExportAppStateAndValidators(forZeroHeight)
-	}
 	gApp := app.NewGaiaApp(logger, db, traceStore, true)
-	return gApp.ExportAppStateAndValidators(forZeroHeight)
+	return gApp.ExportAppStateAndValidators(forZeroHeight, height)
 }
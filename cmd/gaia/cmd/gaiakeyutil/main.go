@@ -6,11 +6,17 @@ import (
 	"os"
 
 	"github.com/tendermint/tendermint/libs/bech32"
-)
 
-var bech32Prefixes = []string{"cosmos", "cosmospub", "cosmosvaloper", "cosmosvaloperpub", "cosmosvalcons", "cosmosvalconspub"}
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func main() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(sdk.Bech32PrefixAccAddr, sdk.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(sdk.Bech32PrefixValAddr, sdk.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
+	config.Seal()
+
 	if len(os.Args) < 2 {
 		fmt.Println("Must specify an input string")
 	}
@@ -41,7 +47,7 @@ func runFromHex(hexaddr string) {
 	}
 	fmt.Println("Hex parse:")
 	fmt.Println("Bech32 formats:")
-	for _, prefix := range bech32Prefixes {
+	for _, prefix := range sdk.GetConfig().GetBech32Prefixes() {
 		bech32Addr, err := bech32.ConvertAndEncode(prefix, bz)
 		if err != nil {
 			panic(err)
@@ -0,0 +1,96 @@
+// Package fuzz implements the fuzzing harnesses of the decoding of the
+// untrusted input of gaia, the transactions, their sign documents and their
+// messages, which must never panic before reaching the consensus code.
+//
+// The harnesses are go-fuzz entry points, run with e.g.:
+//
+//	go-fuzz-build -func TxDecoder github.com/cosmos/cosmos-sdk/cmd/gaia/fuzz
+//	go-fuzz -bin fuzz-fuzz.zip -workdir ./txdecoder
+//
+// the seed corpus of real transactions being written into the work
+// directories by:
+//
+//	go test ./cmd/gaia/fuzz -FuzzCorpusDir=.
+package fuzz
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var cdc = app.MakeCodec()
+
+// TxDecoder fuzzes the decoding of a transaction by the application, then its
+// basic validation and the one of its messages. It returns 1 if the data
+// decodes as a transaction, 0 otherwise.
+func TxDecoder(data []byte) int {
+	tx, err := auth.DefaultTxDecoder(cdc)(data)
+	if err != nil {
+		return 0
+	}
+
+	if err := tx.ValidateBasic(); err != nil {
+		return 1
+	}
+	validateMsgs(tx.GetMsgs())
+	return 1
+}
+
+// StdSignDoc fuzzes the parsing of a sign document, as received from a
+// client to sign a transaction, then of its fee and its messages. It returns
+// 1 if the data parses as a sign document, 0 otherwise.
+func StdSignDoc(data []byte) int {
+	var doc auth.StdSignDoc
+	if err := cdc.UnmarshalJSON(data, &doc); err != nil {
+		return 0
+	}
+
+	var fee auth.StdFee
+	if err := cdc.UnmarshalJSON(doc.Fee, &fee); err != nil {
+		return 1
+	}
+	msgs := make([]sdk.Msg, len(doc.Msgs))
+	for i, bz := range doc.Msgs {
+		if err := cdc.UnmarshalJSON(bz, &msgs[i]); err != nil {
+			return 1
+		}
+	}
+	validateMsgs(msgs)
+
+	// the sign bytes of the parsed document are those of the transaction
+	_ = auth.StdSignBytes(doc.ChainID, doc.AccountNumber, doc.Sequence, fee, msgs, doc.Memo)
+	return 1
+}
+
+// Msg fuzzes the decoding of a message of any registered type, then its basic
+// validation. It returns 1 if the data decodes as a message, 0 otherwise.
+func Msg(data []byte) int {
+	var msg sdk.Msg
+	if err := cdc.UnmarshalBinaryBare(data, &msg); err != nil {
+		return 0
+	}
+
+	validateMsgs([]sdk.Msg{msg})
+	return 1
+}
+
+// validateMsgs runs the basic validation of the messages, and computes the
+// sign bytes and signers of the valid ones, as done by the ante handler.
+func validateMsgs(msgs []sdk.Msg) {
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		_, _ = msg.Route(), msg.Type()
+		if err := msg.ValidateBasic(); err != nil {
+			continue
+		}
+		if !json.Valid(msg.GetSignBytes()) {
+			panic("sign bytes of a valid message are not JSON")
+		}
+		_ = msg.GetSigners()
+	}
+}
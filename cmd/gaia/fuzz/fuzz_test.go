@@ -0,0 +1,133 @@
+package fuzz
+
+import (
+	"crypto/sha1"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var corpusDir string
+
+func init() {
+	flag.StringVar(&corpusDir, "FuzzCorpusDir", "", "Directory to write the seed corpus of the harnesses into")
+}
+
+// seedMsgs returns a valid message of each type of the modules of gaia.
+func seedMsgs() []sdk.Msg {
+	priv := secp256k1.GenPrivKeySecp256k1([]byte("fuzz"))
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	valAddr := sdk.ValAddressFromAccAddress(addr)
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	return []sdk.Msg{
+		bank.NewMsgSend([]bank.Input{bank.NewInput(addr, coins)}, []bank.Output{bank.NewOutput(addr, coins)}),
+		staking.NewMsgCreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), coins[0],
+			staking.NewDescription("moniker", "", "", ""),
+			staking.NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))),
+		staking.NewMsgDelegate(addr, valAddr, coins[0]),
+		staking.NewMsgUndelegateAmount(addr, valAddr, coins[0]),
+		staking.NewMsgBeginRedelegate(addr, valAddr, valAddr, sdk.NewDec(10)),
+		gov.NewMsgSubmitProposal("title", "description", gov.ProposalTypeText, addr, coins),
+		gov.NewMsgDeposit(addr, 1, coins),
+		gov.NewMsgVote(addr, 1, gov.OptionYes),
+		distr.NewMsgSetWithdrawAddress(addr, addr),
+		distr.NewMsgWithdrawDelegatorReward(addr, valAddr),
+		distr.NewMsgWithdrawValidatorCommission(valAddr),
+		slashing.NewMsgUnjail(valAddr),
+		crisis.NewMsgVerifyInvariant(addr, "bank", "nonnegative-balance"),
+	}
+}
+
+// seeds returns the seed corpus of each harness: signed transactions, their
+// sign documents and their messages.
+func seeds(t *testing.T) map[string][][]byte {
+	priv := secp256k1.GenPrivKeySecp256k1([]byte("fuzz"))
+	fee := auth.NewStdFee(200000, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+
+	corpus := make(map[string][][]byte)
+	for i, msg := range seedMsgs() {
+		msgs := []sdk.Msg{msg}
+		signBytes := auth.StdSignBytes("fuzz-chain", uint64(i), uint64(i), fee, msgs, "memo")
+		sig, err := priv.Sign(signBytes)
+		require.NoError(t, err)
+
+		tx := auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, "memo")
+		txBytes, err := cdc.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		msgBytes, err := cdc.MarshalBinaryBare(msg)
+		require.NoError(t, err)
+
+		corpus["txdecoder"] = append(corpus["txdecoder"], txBytes)
+		corpus["stdsigndoc"] = append(corpus["stdsigndoc"], signBytes)
+		corpus["msg"] = append(corpus["msg"], msgBytes)
+	}
+	return corpus
+}
+
+var harnesses = map[string]func([]byte) int{
+	"txdecoder":  TxDecoder,
+	"stdsigndoc": StdSignDoc,
+	"msg":        Msg,
+}
+
+func TestHarnessesAcceptSeeds(t *testing.T) {
+	for name, corpus := range seeds(t) {
+		for i, data := range corpus {
+			require.Equal(t, 1, harnesses[name](data), "%s seed %d", name, i)
+		}
+	}
+}
+
+func TestHarnessesTruncatedSeeds(t *testing.T) {
+	for name, corpus := range seeds(t) {
+		for _, data := range corpus {
+			for n := 0; n < len(data); n++ {
+				require.NotPanics(t, func() { harnesses[name](data[:n]) }, "%s %X", name, data[:n])
+			}
+		}
+	}
+}
+
+func TestHarnessesFlippedSeeds(t *testing.T) {
+	for name, corpus := range seeds(t) {
+		for _, data := range corpus {
+			for n := 0; n < len(data); n++ {
+				mutated := append([]byte{}, data...)
+				mutated[n] ^= 0xFF
+				require.NotPanics(t, func() { harnesses[name](mutated) }, "%s %X", name, mutated)
+			}
+		}
+	}
+}
+
+func TestWriteCorpus(t *testing.T) {
+	if corpusDir == "" {
+		t.Skip("-FuzzCorpusDir not set")
+	}
+
+	for name, corpus := range seeds(t) {
+		dir := filepath.Join(corpusDir, name, "corpus")
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		for _, data := range corpus {
+			file := filepath.Join(dir, fmt.Sprintf("%x", sha1.Sum(data)))
+			require.NoError(t, ioutil.WriteFile(file, data, 0644))
+		}
+	}
+}
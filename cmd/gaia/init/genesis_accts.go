@@ -9,20 +9,32 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/common"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
+const (
+	flagVestingAmt   = "vesting-amount"
+	flagVestingStart = "vesting-start-time"
+	flagVestingEnd   = "vesting-end-time"
+)
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command
 func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-genesis-account [address_or_key_name] [coin][,[coin]]",
 		Short: "Add genesis account to genesis.json",
-		Args:  cobra.ExactArgs(2),
+		Long: `Add a genesis account to genesis.json, failing if it already contains the
+account. With --vesting-amount, the given part of the coins vests until
+--vesting-end-time, continuously from --vesting-start-time if set, or else all
+at once at the end time. The times are UNIX timestamps.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(_ *cobra.Command, args []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(cli.HomeFlag))
@@ -45,6 +57,14 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 			}
 			coins.Sort()
 
+			vestingAmt, err := sdk.ParseCoins(viper.GetString(flagVestingAmt))
+			if err != nil {
+				return err
+			}
+			vestingAmt.Sort()
+			vestingStart := viper.GetInt64(flagVestingStart)
+			vestingEnd := viper.GetInt64(flagVestingEnd)
+
 			genFile := config.GenesisFile()
 			if !common.FileExists(genFile) {
 				return fmt.Errorf("%s does not exist, run `gaiad init` first", genFile)
@@ -59,7 +79,7 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 				return err
 			}
 
-			appStateJSON, err := addGenesisAccount(cdc, appState, addr, coins)
+			appStateJSON, err := addGenesisAccount(cdc, appState, addr, coins, vestingAmt, vestingStart, vestingEnd)
 			if err != nil {
 				return err
 			}
@@ -70,18 +90,55 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command
 
 	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
 	cmd.Flags().String(flagClientHome, app.DefaultCLIHome, "client's home directory")
+	cmd.Flags().String(client.FlagKeyringBackend, crkeys.BackendLevelDB, "keyring backend storing the keys (leveldb|os|file|pass|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of the coins vesting, making the account a vesting account")
+	cmd.Flags().Int64(flagVestingStart, 0, "UNIX time the coins start vesting continuously at, if any")
+	cmd.Flags().Int64(flagVestingEnd, 0, "UNIX time the coins are vested at")
 	return cmd
 }
 
-func addGenesisAccount(cdc *codec.Codec, appState app.GenesisState, addr sdk.AccAddress, coins sdk.Coins) (json.RawMessage, error) {
+// addGenesisAccount adds the account of the coins to the application state, a
+// vesting account if the vesting amount is not empty. The vesting end time is
+// then required and the start time optional, a continuous vesting account
+// being created with it and a delayed one without.
+func addGenesisAccount(cdc *codec.Codec, appState app.GenesisState, addr sdk.AccAddress, coins sdk.Coins,
+	vestingAmt sdk.Coins, vestingStart, vestingEnd int64) (json.RawMessage, error) {
+
 	for _, stateAcc := range appState.Accounts {
 		if stateAcc.Address.Equals(addr) {
 			return nil, fmt.Errorf("the application state already contains account %v", addr)
 		}
 	}
+	if err := coins.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid coins %v: %v", coins, err)
+	}
 
 	acc := auth.NewBaseAccountWithAddress(addr)
 	acc.Coins = coins
-	appState.Accounts = append(appState.Accounts, app.NewGenesisAccount(&acc))
+	genAcc := app.NewGenesisAccount(&acc)
+
+	if !vestingAmt.IsZero() {
+		if err := vestingAmt.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid vesting amount %v: %v", vestingAmt, err)
+		}
+		if !coins.IsAllGTE(vestingAmt) {
+			return nil, fmt.Errorf("vesting amount %v exceeds the coins %v of the account", vestingAmt, coins)
+		}
+		if vestingEnd <= 0 {
+			return nil, fmt.Errorf("vesting account %v requires a vesting end time", addr)
+		}
+		if vestingStart < 0 || (vestingStart != 0 && vestingStart >= vestingEnd) {
+			return nil, fmt.Errorf("vesting start time %d must be before the vesting end time %d", vestingStart, vestingEnd)
+		}
+
+		genAcc.Vesting = true
+		genAcc.StartTime = vestingStart
+		genAcc.EndTime = vestingEnd
+		genAcc.OriginalVesting = vestingAmt
+	} else if vestingStart != 0 || vestingEnd != 0 {
+		return nil, fmt.Errorf("vesting times of account %v require a vesting amount", addr)
+	}
+
+	appState.Accounts = append(appState.Accounts, genAcc)
 	return cdc.MarshalJSON(appState)
 }
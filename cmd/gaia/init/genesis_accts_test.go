@@ -9,15 +9,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 func TestAddGenesisAccount(t *testing.T) {
 	cdc := codec.New()
 	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	type args struct {
-		appState app.GenesisState
-		addr     sdk.AccAddress
-		coins    sdk.Coins
+		appState     app.GenesisState
+		addr         sdk.AccAddress
+		coins        sdk.Coins
+		vestingAmt   sdk.Coins
+		vestingStart int64
+		vestingEnd   int64
 	}
 	tests := []struct {
 		name    string
@@ -30,17 +34,68 @@ func TestAddGenesisAccount(t *testing.T) {
 				app.GenesisState{},
 				addr1,
 				sdk.Coins{},
+				sdk.Coins{},
+				0,
+				0,
 			},
 			false},
 		{"dup account", args{
 			app.GenesisState{Accounts: []app.GenesisAccount{{Address: addr1}}},
 			addr1,
-			sdk.Coins{}}, true},
+			sdk.Coins{}, sdk.Coins{}, 0, 0}, true},
+		{"invalid coins", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 0)}, sdk.Coins{}, 0, 0}, true},
+		{"continuous vesting account", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, 1000, 2000}, false},
+		{"delayed vesting account", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, 0, 2000}, false},
+		{"vesting amount exceeding the coins", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 150)}, 0, 2000}, true},
+		{"vesting without end time", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, 1000, 0}, true},
+		{"vesting starting after its end", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, 3000, 2000}, true},
+		{"vesting times without vesting amount", args{
+			app.GenesisState{}, addr1,
+			sdk.Coins{sdk.NewInt64Coin("stake", 100)}, sdk.Coins{}, 0, 2000}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := addGenesisAccount(cdc, tt.args.appState, tt.args.addr, tt.args.coins)
+			_, err := addGenesisAccount(cdc, tt.args.appState, tt.args.addr, tt.args.coins,
+				tt.args.vestingAmt, tt.args.vestingStart, tt.args.vestingEnd)
 			require.Equal(t, tt.wantErr, (err != nil))
 		})
 	}
 }
+
+func TestAddGenesisVestingAccount(t *testing.T) {
+	cdc := app.MakeCodec()
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.Coins{sdk.NewInt64Coin("footoken", 10), sdk.NewInt64Coin("stake", 100)}
+	vestingAmt := sdk.Coins{sdk.NewInt64Coin("stake", 50)}
+
+	bz, err := addGenesisAccount(cdc, app.GenesisState{}, addr, coins, vestingAmt, 1000, 2000)
+	require.NoError(t, err)
+	var appState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &appState))
+	require.Len(t, appState.Accounts, 1)
+
+	acc, ok := appState.Accounts[0].ToAccount().(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, coins, acc.GetCoins())
+	require.Equal(t, vestingAmt, acc.OriginalVesting)
+	require.Equal(t, int64(1000), acc.GetStartTime())
+	require.Equal(t, int64(2000), acc.GetEndTime())
+
+	bz, err = addGenesisAccount(cdc, app.GenesisState{}, addr, coins, vestingAmt, 0, 2000)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(bz, &appState))
+	_, ok = appState.Accounts[0].ToAccount().(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+}
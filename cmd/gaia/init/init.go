@@ -16,12 +16,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 const (
-	flagOverwrite  = "overwrite"
-	flagClientHome = "home-client"
-	flagMoniker    = "moniker"
+	flagOverwrite    = "overwrite"
+	flagClientHome   = "home-client"
+	flagMoniker      = "moniker"
+	flagBondDenom    = "bond-denom"
+	flagDepositDenom = "deposit-denom"
 )
 
 type printInfo struct {
@@ -48,8 +51,15 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize private validator, p2p, genesis, and application configuration files",
-		Long:  `Initialize validators's and node's configuration files.`,
-		Args:  cobra.NoArgs,
+		Long: `Initialize validators's and node's configuration files.
+
+The staking token of the genesis state, bonded by the validators, minted as
+inflation and paid as the constant fee of the invariant checks, is set with
+--bond-denom, and the token of the minimum deposits of the governance proposals
+with --deposit-denom, defaulting to the staking token. An existing genesis.json
+file is only replaced with --overwrite, which keeps the existing node key and
+private validator key.`,
+		Args: cobra.NoArgs,
 		RunE: func(_ *cobra.Command, _ []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(cli.HomeFlag))
@@ -59,21 +69,28 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				chainID = fmt.Sprintf("test-chain-%v", common.RandStr(6))
 			}
 
-			nodeID, _, err := InitializeNodeValidatorFiles(config)
-			if err != nil {
-				return err
+			bondDenom := viper.GetString(flagBondDenom)
+			depositDenom := viper.GetString(flagDepositDenom)
+			if depositDenom == "" {
+				depositDenom = bondDenom
 			}
 
-			config.Moniker = viper.GetString(flagMoniker)
-
-			var appState json.RawMessage
+			// check the genesis file before creating the keys, which are kept
+			// if they exist
 			genFile := config.GenesisFile()
+			appState, err := initializeEmptyGenesis(cdc, genFile, chainID,
+				viper.GetBool(flagOverwrite), bondDenom, depositDenom)
+			if err != nil {
+				return err
+			}
 
-			if appState, err = initializeEmptyGenesis(cdc, genFile, chainID,
-				viper.GetBool(flagOverwrite)); err != nil {
+			nodeID, _, err := InitializeNodeValidatorFiles(config)
+			if err != nil {
 				return err
 			}
 
+			config.Moniker = viper.GetString(flagMoniker)
+
 			if err = ExportGenesisFile(genFile, chainID, nil, appState); err != nil {
 				return err
 			}
@@ -87,9 +104,11 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
-	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
+	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file, keeping the existing keys")
 	cmd.Flags().String(client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().String(flagMoniker, "", "set the validator's moniker")
+	cmd.Flags().String(flagBondDenom, staking.DefaultBondDenom, "denomination of the staking token")
+	cmd.Flags().String(flagDepositDenom, "", "denomination of the minimum deposits of the proposals, defaulting to the staking token")
 	cmd.MarkFlagRequired(flagMoniker)
 
 	return cmd
@@ -35,6 +35,7 @@ func TestInitCmd(t *testing.T) {
 	ctx := server.NewContext(cfg, logger)
 	cdc := app.MakeCodec()
 	cmd := InitCmd(ctx, cdc)
+	viper.BindPFlags(cmd.Flags())
 
 	viper.Set(flagMoniker, "gaianode-test")
 
@@ -42,6 +43,64 @@ func TestInitCmd(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestInitCmdDenomsAndOverwrite(t *testing.T) {
+	defer server.SetupViper(t)()
+	defer setupClientHome(t)()
+
+	logger := log.NewNopLogger()
+	cfg, err := tcmd.ParseConfig()
+	require.Nil(t, err)
+
+	ctx := server.NewContext(cfg, logger)
+	cdc := app.MakeCodec()
+	cmd := InitCmd(ctx, cdc)
+	viper.BindPFlags(cmd.Flags())
+	defer viper.Reset()
+
+	viper.Set(flagMoniker, "gaianode-test")
+	viper.Set(flagBondDenom, "atom")
+	require.NoError(t, cmd.RunE(nil, nil))
+
+	genDoc, err := loadGenesisDoc(cdc, cfg.GenesisFile())
+	require.NoError(t, err)
+	var genesisState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(genDoc.AppState, &genesisState))
+	require.Equal(t, "atom", genesisState.StakingData.Params.BondDenom)
+	require.Equal(t, "atom", genesisState.MintData.Params.MintDenom)
+	require.Equal(t, "atom", genesisState.CrisisData.ConstantFee.Denom)
+	require.Equal(t, "atom", genesisState.GovData.DepositParams.MinDeposit[0].Denom)
+	require.Equal(t, "atom", genesisState.GovData.DepositParams.ExpeditedMinDeposit[0].Denom)
+	require.NoError(t, app.GaiaValidateGenesisState(genesisState))
+
+	nodeKey, err := ioutil.ReadFile(cfg.NodeKeyFile())
+	require.NoError(t, err)
+	pvKey, err := ioutil.ReadFile(cfg.PrivValidatorKeyFile())
+	require.NoError(t, err)
+
+	// the genesis file is only replaced with --overwrite
+	viper.Set(flagDepositDenom, "photino")
+	require.Error(t, cmd.RunE(nil, nil))
+	viper.Set(flagOverwrite, true)
+	require.NoError(t, cmd.RunE(nil, nil))
+
+	genDoc, err = loadGenesisDoc(cdc, cfg.GenesisFile())
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(genDoc.AppState, &genesisState))
+	require.Equal(t, "atom", genesisState.StakingData.Params.BondDenom)
+	require.Equal(t, "photino", genesisState.GovData.DepositParams.MinDeposit[0].Denom)
+
+	// the keys are kept
+	overwrittenNodeKey, err := ioutil.ReadFile(cfg.NodeKeyFile())
+	require.NoError(t, err)
+	require.Equal(t, nodeKey, overwrittenNodeKey)
+	overwrittenPVKey, err := ioutil.ReadFile(cfg.PrivValidatorKeyFile())
+	require.NoError(t, err)
+	require.Equal(t, pvKey, overwrittenPVKey)
+
+	viper.Set(flagBondDenom, "A")
+	require.Error(t, cmd.RunE(nil, nil))
+}
+
 func setupClientHome(t *testing.T) func() {
 	clientDir, err := ioutil.TempDir("", "mock-sdk-cmd")
 	require.Nil(t, err)
@@ -67,6 +126,7 @@ func TestEmptyState(t *testing.T) {
 	viper.Set(flagMoniker, "gaianode-test")
 
 	cmd := InitCmd(ctx, cdc)
+	viper.BindPFlags(cmd.Flags())
 	err = cmd.RunE(nil, nil)
 	require.NoError(t, err)
 
@@ -112,6 +172,7 @@ func TestStartStandAlone(t *testing.T) {
 	ctx := server.NewContext(cfg, logger)
 	cdc := app.MakeCodec()
 	initCmd := InitCmd(ctx, cdc)
+	viper.BindPFlags(initCmd.Flags())
 	err = initCmd.RunE(nil, nil)
 	require.NoError(t, err)
 
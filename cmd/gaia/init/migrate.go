@@ -0,0 +1,80 @@
+package init
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// MigrateGenesisCmd returns the migrate cobra Command, printing a genesis file
+// of the previous release migrated to the schema of the current one
+func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [genesis-file]",
+		Short: "Migrate a genesis file of the previous release to the current one",
+		Long: `Migrate the application state of a genesis file of the previous release to
+the schema of the current one, and print the migrated genesis file. The
+modules renamed since are moved to their current name, the fields added to the
+genesis state of the modules, and the new modules, are set to their default
+values.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			genDoc, err := loadGenesisDoc(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			genDoc, err = migrateGenesisDoc(cdc, genDoc)
+			if err != nil {
+				return err
+			}
+			if chainID := viper.GetString(client.FlagChainID); chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			out, err := codec.MarshalJSONIndent(cdc, genDoc)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(client.FlagChainID, "", "chain ID of the migrated genesis file, defaulting to the one of the genesis file")
+	return cmd
+}
+
+// migrateGenesisDoc migrates the application state of the genesis document,
+// which must be valid once migrated
+func migrateGenesisDoc(cdc *codec.Codec, genDoc types.GenesisDoc) (types.GenesisDoc, error) {
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return genDoc, err
+	}
+
+	appState, err := app.NewGenesisMigrator().Migrate(cdc, appState)
+	if err != nil {
+		return genDoc, err
+	}
+	bz, err := json.Marshal(appState)
+	if err != nil {
+		return genDoc, err
+	}
+
+	genesisState, err := app.ValidateGenesisJSON(cdc, bz)
+	if err != nil {
+		return genDoc, fmt.Errorf("invalid migrated genesis state:\n%v", err)
+	}
+
+	genDoc.AppState, err = codec.MarshalJSONIndent(cdc, genesisState)
+	return genDoc, err
+}
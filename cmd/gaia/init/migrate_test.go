@@ -0,0 +1,66 @@
+package init
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestMigrateGenesisDoc(t *testing.T) {
+	cdc := app.MakeCodec()
+	genDoc, err := loadGenesisDoc(cdc, filepath.Join("testdata", "genesis_v0.29.json"))
+	require.NoError(t, err)
+
+	migrated, err := migrateGenesisDoc(cdc, genDoc)
+	require.NoError(t, err)
+	require.Equal(t, "gaia-v029", migrated.ChainID)
+
+	var genesisState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(migrated.AppState, &genesisState))
+	require.Len(t, genesisState.Accounts, 1)
+
+	// new params and modules
+	require.Equal(t, auth.DefaultParams().SigVerifyCostSecp256r1, genesisState.AuthData.Params.SigVerifyCostSecp256r1)
+	require.Equal(t, staking.DefaultParams().PowerReduction, genesisState.StakingData.Params.PowerReduction)
+	require.True(t, genesisState.DistrData.WithdrawAddrEnabled)
+	require.Equal(t, gov.DefaultGenesisState().ProposalParams, genesisState.GovData.ProposalParams)
+	require.Equal(t, gov.DefaultGenesisState().VotingParams.ExpeditedVotingPeriod,
+		genesisState.GovData.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, crisis.DefaultGenesisState(), genesisState.CrisisData)
+	require.Empty(t, genesisState.EvidenceData.Evidence)
+
+	// the migrated staking state, kept under its current name
+	stakingData := genesisState.StakingData
+	require.True(t, stakingData.Exported)
+	require.Equal(t, sdk.NewInt(1005), stakingData.Pool.LooseTokens)
+	require.Equal(t, sdk.NewInt(30), stakingData.LastTotalPower)
+	require.Equal(t, sdk.NewInt(30), stakingData.LastValidatorPowers[0].Power)
+	require.Equal(t, sdk.NewInt(30), stakingData.Validators[0].Tokens)
+	require.Equal(t, sdk.NewDec(30), stakingData.Validators[0].DelegatorShares)
+
+	completionTime := time.Date(2019, 1, 18, 0, 0, 0, 0, time.UTC)
+	require.Len(t, stakingData.UnbondingDelegations[0].Entries, 1)
+	ubdEntry := stakingData.UnbondingDelegations[0].Entries[0]
+	require.Equal(t, int64(10), ubdEntry.CreationHeight)
+	require.True(t, completionTime.Equal(ubdEntry.CompletionTime))
+	require.Equal(t, sdk.NewInt64Coin("stake", 5), ubdEntry.Balance)
+	require.Len(t, stakingData.Redelegations[0].Entries, 1)
+	redEntry := stakingData.Redelegations[0].Entries[0]
+	require.Equal(t, int64(12), redEntry.CreationHeight)
+	require.True(t, completionTime.Equal(redEntry.CompletionTime))
+	require.Equal(t, sdk.NewDec(3), redEntry.SharesDst)
+
+	// a migrated genesis is left unchanged
+	remigrated, err := migrateGenesisDoc(cdc, migrated)
+	require.NoError(t, err)
+	require.Equal(t, string(migrated.AppState), string(remigrated.AppState))
+}
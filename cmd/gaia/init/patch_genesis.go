@@ -0,0 +1,146 @@
+package init
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagOverride = "override"
+
+// PatchGenesisCmd returns the patch-genesis cobra Command
+func PatchGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch-genesis [patch-file]",
+		Short: "Patch the module sections of genesis.json",
+		Long: `Patch the module sections of the application state of genesis.json with the
+ones of a patch JSON object, keyed by module name like the application state.
+The fields of a section of the patch are merged into the section, recursively
+into the objects, or replace it with --override. The patched genesis state is
+validated, and the fields of the patch must be fields of the genesis state.
+
+Example:
+	echo '{"gov": {"voting_params": {"voting_period": "259200000000000"}}}' > patch.json
+	gaiad patch-genesis patch.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			patch, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			genDoc, err := loadGenesisDoc(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState, err = patchGenesisState(cdc, genDoc.AppState, patch, viper.GetBool(flagOverride))
+			if err != nil {
+				return err
+			}
+			if err = genDoc.ValidateAndComplete(); err != nil {
+				return err
+			}
+			return genDoc.SaveAs(genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Bool(flagOverride, false, "replace the module sections of the patch instead of merging them")
+	return cmd
+}
+
+// patchGenesisState merges the module sections of the patch into the ones of
+// the application state, or replaces them if override, and returns the
+// patched application state once validated.
+func patchGenesisState(cdc *codec.Codec, appState, patch json.RawMessage, override bool) (json.RawMessage, error) {
+	var sections, patchSections map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &sections); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &patchSections); err != nil {
+		return nil, fmt.Errorf("invalid patch: %v", err)
+	}
+
+	names := make([]string, 0, len(patchSections))
+	for name := range patchSections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		section, ok := sections[name]
+		if !ok {
+			return nil, fmt.Errorf("the genesis state has no module section %s", name)
+		}
+		if override {
+			sections[name] = patchSections[name]
+			continue
+		}
+		merged, err := sdk.MergeJSON(section, patchSections[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to patch module section %s: %v", name, err)
+		}
+		sections[name] = merged
+	}
+
+	bz, err := json.Marshal(sections)
+	if err != nil {
+		return nil, err
+	}
+	genesisState, err := app.ValidateGenesisJSON(cdc, bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patched genesis state:\n%v", err)
+	}
+
+	// the fields the genesis state does not have are ignored when decoded
+	patched, err := cdc.MarshalJSON(genesisState)
+	if err != nil {
+		return nil, err
+	}
+	var decoded json.RawMessage
+	if err = json.Unmarshal(patched, &decoded); err != nil {
+		return nil, err
+	}
+	if unknown := unknownJSONFields(patch, decoded, ""); len(unknown) > 0 {
+		return nil, fmt.Errorf("the genesis state has no field %s", strings.Join(unknown, ", "))
+	}
+
+	return codec.MarshalJSONIndent(cdc, genesisState)
+}
+
+// unknownJSONFields returns the paths of the fields of the patch object which
+// the JSON object does not have, recursively into the objects both have
+func unknownJSONFields(patch, object json.RawMessage, path string) (unknown []string) {
+	var patchFields, fields map[string]json.RawMessage
+	if json.Unmarshal(patch, &patchFields) != nil || json.Unmarshal(object, &fields) != nil || fields == nil {
+		return nil
+	}
+
+	for name, value := range patchFields {
+		field, ok := fields[name]
+		if !ok {
+			unknown = append(unknown, path+name)
+			continue
+		}
+		unknown = append(unknown, unknownJSONFields(value, field, path+name+".")...)
+	}
+	sort.Strings(unknown)
+	return unknown
+}
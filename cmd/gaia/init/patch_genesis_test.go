@@ -0,0 +1,61 @@
+package init
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+)
+
+func TestPatchGenesisState(t *testing.T) {
+	cdc := app.MakeCodec()
+	genDoc, err := loadGenesisDoc(cdc, filepath.Join("testdata", "genesis_v0.29.json"))
+	require.NoError(t, err)
+	genDoc, err = migrateGenesisDoc(cdc, genDoc)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(genDoc.AppState, &genesisState))
+
+	// the fields of the patch are merged into the module sections
+	patch := []byte(`{"gov": {"voting_params": {"voting_period": "259200000000000"}}, "auth": {"params": {"MaxMemoCharacters": "512"}}}`)
+	patched, err := patchGenesisState(cdc, genDoc.AppState, patch, false)
+	require.NoError(t, err)
+
+	var patchedState app.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(patched, &patchedState))
+	require.Equal(t, 72*time.Hour, patchedState.GovData.VotingParams.VotingPeriod)
+	require.Equal(t, genesisState.GovData.VotingParams.ExpeditedVotingPeriod, patchedState.GovData.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, genesisState.GovData.DepositParams, patchedState.GovData.DepositParams)
+	require.Equal(t, uint64(512), patchedState.AuthData.Params.MaxMemoCharacters)
+	require.Equal(t, genesisState.AuthData.Params.TxSigLimit, patchedState.AuthData.Params.TxSigLimit)
+	require.Equal(t, genesisState.StakingData, patchedState.StakingData)
+
+	// the module sections of the patch replace the ones of the genesis state
+	override := []byte(`{"crisis": {"constant_fee": {"denom": "stake", "amount": "7"}}}`)
+	patched, err = patchGenesisState(cdc, genDoc.AppState, override, true)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(patched, &patchedState))
+	require.Equal(t, "7", patchedState.CrisisData.ConstantFee.Amount.String())
+
+	// a module section the genesis state lacks
+	_, err = patchGenesisState(cdc, genDoc.AppState, []byte(`{"unknown": {}}`), false)
+	require.Error(t, err)
+
+	// fields the genesis state lacks
+	_, err = patchGenesisState(cdc, genDoc.AppState, []byte(`{"gov": {"voting_params": {"votingperiod": "1"}}}`), false)
+	require.EqualError(t, err, "the genesis state has no field gov.voting_params.votingperiod")
+
+	// a value failing to decode, located by its path
+	_, err = patchGenesisState(cdc, genDoc.AppState, []byte(`{"gov": {"voting_params": {"voting_period": "one"}}}`), false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "module gov at voting_params.voting_period")
+
+	// an invalid genesis state
+	_, err = patchGenesisState(cdc, genDoc.AppState, []byte(`{"staking": {"pool": {"loose_tokens": "1"}}}`), false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "module staking at pool.loose_tokens")
+}
@@ -1,15 +1,18 @@
 package init
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"text/template"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -36,6 +39,7 @@ var (
 	flagNodeCliHome       = "node-cli-home"
 	flagStartingIPAddress = "starting-ip-address"
 	flagMinimumFees       = "minimum-fees"
+	flagDockerCompose     = "docker-compose"
 )
 
 const nodeDirPerm = 0755
@@ -51,6 +55,10 @@ necessary files (private validator, genesis, config, etc.).
 
 Note, strict routability for addresses is turned off in the config file.
 
+With --docker-compose, a docker-compose.yml file is written to the output
+directory, running each node in a tendermint/gaiadnode container at its IP
+address, the gaiad binary being copied to the output directory.
+
 Example:
 	gaiad testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
 	`,
@@ -84,6 +92,12 @@ Example:
 	cmd.Flags().String(
 		flagMinimumFees, fmt.Sprintf("1%s", stakingtypes.DefaultBondDenom), "Validator minimum fees",
 	)
+	cmd.Flags().String(
+		client.FlagKeyringBackend, crkeys.BackendLevelDB, "keyring backend storing the keys of the validators (leveldb|os|file|pass|test)",
+	)
+	cmd.Flags().Bool(flagDockerCompose, false,
+		"Write a docker-compose.yml file running the nodes in containers at their IP address",
+	)
 
 	return cmd
 }
@@ -94,6 +108,17 @@ func initTestnet(config *tmconfig.Config, cdc *codec.Codec) error {
 	outDir := viper.GetString(flagOutputDir)
 	numValidators := viper.GetInt(flagNumValidators)
 
+	// the containers run the nodes of the default directories
+	if viper.GetBool(flagDockerCompose) {
+		if viper.GetString(flagNodeDirPrefix) != "node" || viper.GetString(flagNodeDaemonHome) != "gaiad" {
+			return fmt.Errorf("--%s requires the default --%s and --%s",
+				flagDockerCompose, flagNodeDirPrefix, flagNodeDaemonHome)
+		}
+		if viper.GetString(flagStartingIPAddress) == "" {
+			return fmt.Errorf("--%s requires --%s", flagDockerCompose, flagStartingIPAddress)
+		}
+	}
+
 	chainID = viper.GetString(client.FlagChainID)
 	if chainID == "" {
 		chainID = "chain-" + cmn.RandStr(6)
@@ -134,7 +159,7 @@ func initTestnet(config *tmconfig.Config, cdc *codec.Codec) error {
 			return err
 		}
 
-		monikers = append(monikers, nodeDirName)
+		monikers[i] = nodeDirName
 		config.Moniker = nodeDirName
 
 		ip, err := getIP(i, viper.GetString(flagStartingIPAddress))
@@ -241,10 +266,79 @@ func initTestnet(config *tmconfig.Config, cdc *codec.Codec) error {
 		return err
 	}
 
+	if viper.GetBool(flagDockerCompose) {
+		err = writeDockerCompose(outDir, numValidators, viper.GetString(flagStartingIPAddress))
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("Successfully initialized %d node directories\n", numValidators)
 	return nil
 }
 
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: '3'
+
+services:
+{{- range .Nodes}}
+  gaiadnode{{.ID}}:
+    container_name: gaiadnode{{.ID}}
+    image: "tendermint/gaiadnode"
+    ports:
+      - "{{.P2PPort}}-{{.RPCPort}}:26656-26657"
+    environment:
+      - ID={{.ID}}
+      - LOG=${LOG:-gaiad.log}
+    volumes:
+      - ./:/gaiad:Z
+    networks:
+      localnet:
+        ipv4_address: {{.IP}}
+{{end}}
+networks:
+  localnet:
+    driver: bridge
+    ipam:
+      driver: default
+      config:
+      -
+        subnet: {{.Subnet}}
+`))
+
+// writeDockerCompose writes the docker-compose.yml file of the output
+// directory, running the nodes in containers at their IP address, from the
+// starting one, with their P2P and RPC ports published from 26656 on.
+func writeDockerCompose(outDir string, numValidators int, startingIPAddr string) error {
+	type node struct {
+		ID               int
+		IP               string
+		P2PPort, RPCPort int
+	}
+
+	nodes := make([]node, numValidators)
+	for i := range nodes {
+		ip, err := calculateIP(startingIPAddr, i)
+		if err != nil {
+			return err
+		}
+		nodes[i] = node{ID: i, IP: ip, P2PPort: 26656 + 2*i, RPCPort: 26657 + 2*i}
+	}
+
+	// the /16 network of the starting IP address
+	ipv4 := net.ParseIP(startingIPAddr).To4()
+	subnet := fmt.Sprintf("%d.%d.0.0/16", ipv4[0], ipv4[1])
+
+	var buf bytes.Buffer
+	err := dockerComposeTemplate.Execute(&buf, struct {
+		Nodes  []node
+		Subnet string
+	}{nodes, subnet})
+	if err != nil {
+		return err
+	}
+	return writeFile("docker-compose.yml", outDir, buf.Bytes())
+}
+
 func initGenFiles(
 	cdc *codec.Codec, chainID string, accs []app.GenesisAccount,
 	genFiles []string, numValidators int,
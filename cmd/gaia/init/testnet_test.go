@@ -0,0 +1,32 @@
+package init
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDockerCompose(t *testing.T) {
+	outDir, err := ioutil.TempDir("", "testnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(outDir)
+
+	require.NoError(t, writeDockerCompose(outDir, 3, "192.168.10.2"))
+	bz, err := ioutil.ReadFile(filepath.Join(outDir, "docker-compose.yml"))
+	require.NoError(t, err)
+	compose := string(bz)
+
+	require.Contains(t, compose, "  gaiadnode0:\n")
+	require.Contains(t, compose, "  gaiadnode2:\n")
+	require.NotContains(t, compose, "gaiadnode3")
+	require.Contains(t, compose, "ipv4_address: 192.168.10.2\n")
+	require.Contains(t, compose, "ipv4_address: 192.168.10.4\n")
+	require.Contains(t, compose, `"26660-26661:26656-26657"`)
+	require.Contains(t, compose, "- ID=2\n")
+	require.Contains(t, compose, "subnet: 192.168.0.0/16\n")
+
+	require.Error(t, writeDockerCompose(outDir, 3, "::1"))
+}
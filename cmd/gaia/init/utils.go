@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ExportGenesisFile creates and writes the genesis configuration to disk. An
@@ -102,12 +103,46 @@ func loadGenesisDoc(cdc *amino.Codec, genFile string) (genDoc types.GenesisDoc,
 }
 
 func initializeEmptyGenesis(
-	cdc *codec.Codec, genFile, chainID string, overwrite bool,
+	cdc *codec.Codec, genFile, chainID string, overwrite bool, bondDenom, depositDenom string,
 ) (appState json.RawMessage, err error) {
 
 	if !overwrite && common.FileExists(genFile) {
 		return nil, fmt.Errorf("genesis.json file already exists: %v", genFile)
 	}
 
-	return codec.MarshalJSONIndent(cdc, app.NewDefaultGenesisState())
+	genesisState, err := newGenesisStateWithDenoms(bondDenom, depositDenom)
+	if err != nil {
+		return nil, err
+	}
+	return codec.MarshalJSONIndent(cdc, genesisState)
+}
+
+// newGenesisStateWithDenoms returns the default genesis state with the given
+// staking token, also used by the mint and crisis modules, and token of the
+// minimum deposits of the proposals.
+func newGenesisStateWithDenoms(bondDenom, depositDenom string) (app.GenesisState, error) {
+	genesisState := app.NewDefaultGenesisState()
+	for _, denom := range []string{bondDenom, depositDenom} {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return genesisState, err
+		}
+	}
+
+	genesisState.StakingData.Params.BondDenom = bondDenom
+	genesisState.MintData.Params.MintDenom = bondDenom
+	genesisState.CrisisData.ConstantFee.Denom = bondDenom
+
+	depositParams := &genesisState.GovData.DepositParams
+	depositParams.MinDeposit = withDenom(depositParams.MinDeposit, depositDenom)
+	depositParams.ExpeditedMinDeposit = withDenom(depositParams.ExpeditedMinDeposit, depositDenom)
+	return genesisState, nil
+}
+
+// withDenom returns the amounts of the coins in the given denomination
+func withDenom(coins sdk.Coins, denom string) sdk.Coins {
+	res := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		res[i] = sdk.NewCoin(denom, coin.Amount)
+	}
+	return res.Sort()
 }
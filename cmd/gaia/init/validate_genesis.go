@@ -0,0 +1,52 @@
+package init
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ValidateGenesisCmd returns the validate-genesis cobra Command
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "Validate the genesis file at the default location or at the given one",
+		Long: `Validate the genesis file at the default location or at the given one. The
+genesis state of each module is decoded and validated on its own, then checked
+against the other modules, e.g. the staking pool against the tokens of the
+accounts, and every failure is reported with its module and JSON path.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			genFile := config.GenesisFile()
+			if len(args) > 0 {
+				genFile = args[0]
+			}
+
+			genDoc, err := loadGenesisDoc(cdc, genFile)
+			if err != nil {
+				return fmt.Errorf("failed to read the genesis file %s: %v", genFile, err)
+			}
+			if err = genDoc.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("invalid genesis file %s: %v", genFile, err)
+			}
+			if _, err = app.ValidateGenesisJSON(cdc, genDoc.AppState); err != nil {
+				return fmt.Errorf("invalid genesis file %s:\n%v", genFile, err)
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	return cmd
+}
@@ -0,0 +1,361 @@
+// Package testutil implements an in-process test network of gaia validators,
+// for the integration tests of the clients and the modules which need running
+// nodes, without building nor running the gaiad binary.
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	nm "github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	gapp "github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Config configures a test network.
+type Config struct {
+	NumValidators int   // number of validators, each running a node
+	AccountTokens int64 // bond tokens of the account of each validator
+	StakingTokens int64 // tokens self-delegated by each validator at genesis
+	GasLimit      uint64
+
+	// additional accounts funded at genesis with AccountTokens
+	Accounts []sdk.AccAddress
+	// modifies the genesis state of the network before its genesis
+	// transactions are collected, e.g. to shorten the voting period
+	GenesisState func(*gapp.GenesisState)
+
+	Logger log.Logger
+}
+
+// DefaultConfig returns the configuration of a network of 4 validators of
+// equal power.
+func DefaultConfig() Config {
+	return Config{
+		NumValidators: 4,
+		AccountTokens: 1000,
+		StakingTokens: 100,
+		GasLimit:      200000,
+		Logger:        log.NewNopLogger(),
+	}
+}
+
+// Validator is a validator of a test network, and its running node.
+type Validator struct {
+	Moniker    string
+	Dir        string
+	PrivKey    crypto.PrivKey // key of the account of the operator
+	Address    sdk.AccAddress
+	ValAddress sdk.ValAddress
+	ConsPubKey crypto.PubKey
+	RPCAddress string
+	P2PAddress string
+
+	// context of the clients of the node, querying and broadcasting through
+	// its RPC
+	CLIContext context.CLIContext
+
+	App  *gapp.GaiaApp
+	Node *nm.Node
+
+	network *Network
+	config  *tmcfg.Config
+	privVal *pvm.FilePV
+	nodeKey *p2p.NodeKey
+}
+
+// Network is a network of in-process gaia validators sharing a genesis and
+// connected to each other.
+type Network struct {
+	T          *testing.T
+	Config     Config
+	ChainID    string
+	Codec      *codec.Codec
+	Validators []*Validator
+
+	dir string
+}
+
+// New starts a test network of the given configuration, and waits for its
+// first block. The network must be stopped by Cleanup.
+func New(t *testing.T, cfg Config) *Network {
+	require.True(t, cfg.NumValidators > 0, "a test network needs at least one validator")
+	if cfg.Logger == nil {
+		cfg.Logger = log.NewNopLogger()
+	}
+
+	dir, err := ioutil.TempDir("", "gaia-testnet")
+	require.NoError(t, err)
+
+	n := &Network{
+		T:       t,
+		Config:  cfg,
+		ChainID: fmt.Sprintf("testnet-%s", cmn.RandStr(6)),
+		Codec:   gapp.MakeCodec(),
+		dir:     dir,
+	}
+	for i := 0; i < cfg.NumValidators; i++ {
+		n.Validators = append(n.Validators, n.newValidator(i))
+	}
+
+	genDoc := n.genesisDoc()
+	for i, val := range n.Validators {
+		val.config.P2P.PersistentPeers = n.persistentPeers(i)
+		n.startNode(val, genDoc)
+	}
+
+	_, err = n.WaitForHeight(1)
+	require.NoError(t, err)
+	return n
+}
+
+// newValidator creates the keys and the Tendermint configuration of the i-th
+// validator of the network.
+func (n *Network) newValidator(i int) *Validator {
+	moniker := fmt.Sprintf("validator-%d", i)
+	dir := filepath.Join(n.dir, moniker)
+
+	config := tmcfg.TestConfig().SetRoot(dir)
+	config.Moniker = moniker
+	config.P2P.AddrBookStrict = false
+	config.P2P.AllowDuplicateIP = true
+	config.Consensus.TimeoutCommit = 100 * time.Millisecond
+	config.Consensus.SkipTimeoutCommit = false
+	config.TxIndex.IndexAllTags = true
+	cmn.EnsureDir(filepath.Join(dir, "config"), 0755)
+	cmn.EnsureDir(filepath.Join(dir, "data"), 0755)
+
+	var err error
+	var rpcPort, p2pPort string
+	config.RPC.ListenAddress, rpcPort, err = server.FreeTCPAddr()
+	require.NoError(n.T, err)
+	config.P2P.ListenAddress, p2pPort, err = server.FreeTCPAddr()
+	require.NoError(n.T, err)
+	config.RPC.GRPCListenAddress = ""
+
+	privVal := pvm.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	privVal.Save()
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(n.T, err)
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	return &Validator{
+		Moniker:    moniker,
+		Dir:        dir,
+		PrivKey:    privKey,
+		Address:    addr,
+		ValAddress: sdk.ValAddressFromAccAddress(addr),
+		ConsPubKey: privVal.GetPubKey(),
+		RPCAddress: fmt.Sprintf("tcp://127.0.0.1:%s", rpcPort),
+		P2PAddress: fmt.Sprintf("127.0.0.1:%s", p2pPort),
+		network:    n,
+		config:     config,
+		privVal:    privVal,
+		nodeKey:    nodeKey,
+	}
+}
+
+// genesisDoc returns the genesis of the network, funding the accounts of the
+// validators and the configured accounts, and bonding the validators through
+// genesis transactions.
+func (n *Network) genesisDoc() *tmtypes.GenesisDoc {
+	cfg := n.Config
+	coins := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, cfg.AccountTokens)}
+
+	genState := gapp.NewDefaultGenesisState()
+	addrs := append([]sdk.AccAddress{}, cfg.Accounts...)
+	for _, val := range n.Validators {
+		addrs = append(addrs, val.Address)
+	}
+	for _, addr := range addrs {
+		acc := auth.NewBaseAccountWithAddress(addr)
+		acc.Coins = coins
+		genState.Accounts = append(genState.Accounts, gapp.NewGenesisAccount(&acc))
+	}
+	if cfg.GenesisState != nil {
+		cfg.GenesisState(&genState)
+	}
+
+	genTxs := make([]json.RawMessage, len(n.Validators))
+	for i, val := range n.Validators {
+		msg := staking.NewMsgCreateValidator(
+			val.ValAddress,
+			val.ConsPubKey,
+			sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, cfg.StakingTokens),
+			staking.NewDescription(val.Moniker, "", "", ""),
+			staking.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		)
+		tx, err := val.signTx(0, 0, []sdk.Msg{msg}, auth.StdFee{}, "")
+		require.NoError(n.T, err)
+		genTxs[i] = n.Codec.MustMarshalJSON(tx)
+	}
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:     n.ChainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    n.Codec.MustMarshalJSON(genState),
+	}
+	genState, err := gapp.GaiaAppGenState(n.Codec, *genDoc, genTxs)
+	require.NoError(n.T, err)
+	genDoc.AppState, err = codec.MarshalJSONIndent(n.Codec, genState)
+	require.NoError(n.T, err)
+	return genDoc
+}
+
+// persistentPeers returns the peers of the node of the i-th validator, the
+// nodes of all the other validators.
+func (n *Network) persistentPeers(i int) string {
+	var peers []string
+	for j, val := range n.Validators {
+		if j != i {
+			peers = append(peers, fmt.Sprintf("%s@%s", val.nodeKey.ID(), val.P2PAddress))
+		}
+	}
+	return strings.Join(peers, ",")
+}
+
+// startNode starts the node of the validator, with an in-memory application
+// and databases.
+func (n *Network) startNode(val *Validator, genDoc *tmtypes.GenesisDoc) {
+	logger := n.Config.Logger.With("validator", val.Moniker)
+	val.App = gapp.NewGaiaApp(logger, dbm.NewMemDB(), nil, true)
+
+	node, err := nm.NewNode(
+		val.config,
+		val.privVal,
+		val.nodeKey,
+		proxy.NewLocalClientCreator(val.App),
+		func() (*tmtypes.GenesisDoc, error) { return genDoc, nil },
+		func(*nm.DBContext) (dbm.DB, error) { return dbm.NewMemDB(), nil },
+		nm.DefaultMetricsProvider(val.config.Instrumentation),
+		logger.With("module", "node"),
+	)
+	require.NoError(n.T, err)
+	require.NoError(n.T, node.Start())
+	val.Node = node
+
+	val.CLIContext = context.CLIContext{}.
+		WithCodec(n.Codec).
+		WithAccountDecoder(n.Codec).
+		WithAccountStore(auth.StoreKey).
+		WithNodeURI(val.RPCAddress).
+		WithTrustNode(true)
+}
+
+// LatestHeight returns the height of the last block committed by the node of
+// the first validator.
+func (n *Network) LatestHeight() (int64, error) {
+	status, err := n.Validators[0].CLIContext.Client.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight waits for the node of the first validator to commit the block
+// of the given height, for at most 10 seconds per block, and returns the
+// latest height.
+func (n *Network) WaitForHeight(height int64) (int64, error) {
+	timeout := time.After(time.Duration(height) * 10 * time.Second)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timeout:
+			return 0, fmt.Errorf("timeout waiting for height %d", height)
+		case <-ticker.C:
+			latest, err := n.LatestHeight()
+			if err == nil && latest >= height {
+				return latest, nil
+			}
+		}
+	}
+}
+
+// WaitForNextBlock waits for the next block to be committed.
+func (n *Network) WaitForNextBlock() error {
+	latest, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+	_, err = n.WaitForHeight(latest + 1)
+	return err
+}
+
+// Cleanup stops the nodes of the network and removes their files.
+func (n *Network) Cleanup() {
+	for _, val := range n.Validators {
+		if val.Node != nil && val.Node.IsRunning() {
+			val.Node.Stop()
+			val.Node.Wait()
+		}
+	}
+	os.RemoveAll(n.dir)
+}
+
+// SignTx returns the transaction of the given messages signed by the
+// operator of the validator, with its current account number and sequence.
+func (val *Validator) SignTx(msgs []sdk.Msg, fee auth.StdFee, memo string) (auth.StdTx, error) {
+	acc, err := val.CLIContext.GetAccount(val.Address)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+	return val.signTx(acc.GetAccountNumber(), acc.GetSequence(), msgs, fee, memo)
+}
+
+func (val *Validator) signTx(accNum, sequence uint64, msgs []sdk.Msg,
+	fee auth.StdFee, memo string) (auth.StdTx, error) {
+
+	signBytes := auth.StdSignBytes(val.network.ChainID, accNum, sequence, fee, msgs, memo)
+	sig, err := val.PrivKey.Sign(signBytes)
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+	stdSig := auth.StdSignature{PubKey: val.PrivKey.PubKey(), Signature: sig}
+	return auth.NewStdTx(msgs, fee, []auth.StdSignature{stdSig}, memo), nil
+}
+
+// BroadcastMsgs signs the messages by the operator of the validator, without
+// fees, and broadcasts them through its node, waiting for their commit.
+func (val *Validator) BroadcastMsgs(msgs ...sdk.Msg) (*ctypes.ResultBroadcastTxCommit, error) {
+	fee := auth.NewStdFee(val.network.Config.GasLimit, nil)
+	tx, err := val.SignTx(msgs, fee, "")
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := val.network.Codec.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return nil, err
+	}
+	return val.CLIContext.BroadcastTx(txBytes)
+}
@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestNetwork(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.NumValidators = 2
+	n := New(t, cfg)
+	defer n.Cleanup()
+
+	_, err := n.WaitForHeight(2)
+	require.NoError(t, err)
+
+	// all the nodes commit the blocks of the validators
+	for _, val := range n.Validators {
+		status, err := val.CLIContext.Client.Status()
+		require.NoError(t, err)
+		require.Equal(t, n.ChainID, status.NodeInfo.Network)
+		require.True(t, status.SyncInfo.LatestBlockHeight >= 1)
+	}
+	vals, err := n.Validators[1].CLIContext.Client.Validators(nil)
+	require.NoError(t, err)
+	require.Len(t, vals.Validators, 2)
+
+	// a transaction broadcast through a node is applied by the others
+	from, to := n.Validators[0], n.Validators[1]
+	coins := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}
+	msg := bank.NewMsgSend([]bank.Input{bank.NewInput(from.Address, coins)},
+		[]bank.Output{bank.NewOutput(to.Address, coins)})
+	res, err := from.BroadcastMsgs(msg)
+	require.NoError(t, err)
+	require.True(t, res.CheckTx.IsOK(), res.CheckTx.Log)
+	require.True(t, res.DeliverTx.IsOK(), res.DeliverTx.Log)
+
+	_, err = n.WaitForHeight(res.Height + 1)
+	require.NoError(t, err)
+	acc, err := to.CLIContext.GetAccount(to.Address)
+	require.NoError(t, err)
+	expected := cfg.AccountTokens - cfg.StakingTokens + 10
+	require.Equal(t, expected, acc.GetCoins().AmountOf(stakingTypes.DefaultBondDenom).Int64())
+}
@@ -6,6 +6,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto/encoding/amino"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
 // amino codec to marshal/unmarshal
@@ -16,9 +18,11 @@ func New() *Codec {
 	return cdc
 }
 
-// Register the go-crypto to the codec
+// Register the go-crypto to the codec, along with the keys of the signing
+// algorithms added by the SDK
 func RegisterCrypto(cdc *Codec) {
 	cryptoAmino.RegisterAmino(cdc)
+	secp256r1.RegisterAmino(cdc)
 }
 
 // attempt to make some pretty json
@@ -132,6 +132,12 @@ func NewFundraiserParams(account uint32, addressIdx uint32) *BIP44Params {
 	return NewParams(44, 118, account, false, addressIdx)
 }
 
+// CreateHDPath creates a BIP 44 parameter object from the params:
+// m / 44' / coinType' / account' / 0 / address_index
+func CreateHDPath(coinType, account, addressIdx uint32) *BIP44Params {
+	return NewParams(44, coinType, account, false, addressIdx)
+}
+
 // Return the BIP44 fields as an array.
 func (p BIP44Params) DerivationPath() []uint32 {
 	change := uint32(0)
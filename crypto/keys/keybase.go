@@ -16,8 +16,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 
 	tmcrypto "github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/encoding/amino"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
@@ -52,22 +50,57 @@ const (
 	infoSuffix    = "info"
 )
 
+var languageNames = map[Language]string{
+	English:            "english",
+	Japanese:           "japanese",
+	Korean:             "korean",
+	Spanish:            "spanish",
+	ChineseSimplified:  "chinese_simplified",
+	ChineseTraditional: "chinese_traditional",
+	French:             "french",
+	Italian:            "italian",
+}
+
+// String returns the name of the language as used by the BIP 39 word lists.
+func (lang Language) String() string {
+	return languageNames[lang]
+}
+
+// LanguageFromString returns the language with the given name, as used by
+// the BIP 39 word lists, e.g. "english" or "chinese_simplified".
+func LanguageFromString(name string) (Language, error) {
+	for lang, langName := range languageNames {
+		if langName == strings.ToLower(name) {
+			return lang, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown language %q", name)
+}
+
 const (
 	// used for deriving seed from mnemonic
 	defaultBIP39Passphrase = ""
 
 	// bits of entropy to draw when creating a mnemonic
 	defaultEntropySize = 256
+
+	// number of words of a mnemonic created by default
+	defaultMnemonicWords = 24
 )
 
 var (
 	// ErrUnsupportedSigningAlgo is raised when the caller tries to use a
-	// different signing scheme than secp256k1.
-	ErrUnsupportedSigningAlgo = errors.New("unsupported signing algo: only secp256k1 is supported")
+	// signing scheme which isn't supported, e.g. other than secp256k1 for a
+	// Ledger key.
+	ErrUnsupportedSigningAlgo = errors.New("unsupported signing algo")
 
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedMnemonicLength is raised when the caller tries to use a
+	// mnemonic of another number of words than 12 or 24.
+	ErrUnsupportedMnemonicLength = errors.New("unsupported mnemonic length: only 12 and 24 words are supported")
 )
 
 // dbKeybase combines encryption and storage implementation to provide
@@ -90,17 +123,28 @@ func New(db dbm.DB) Keybase {
 // generate a key for the given algo type, or if another key is
 // already stored under the same name.
 func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info, mnemonic string, err error) {
+	return kb.NewMnemonic(name, language, defaultMnemonicWords, defaultBIP39Passphrase, passwd, algo)
+}
+
+// NewMnemonic generates a new mnemonic of the given language and number of
+// words, derives a key from it and the BIP39 passphrase and persists the key,
+// encrypted using encryptPasswd.
+// It returns the generated mnemonic and the key Info.
+func (kb dbKeybase) NewMnemonic(name string, language Language, words int, bip39Passphrase, encryptPasswd string, algo SigningAlgo) (info Info, mnemonic string, err error) {
 	if language != English {
 		return nil, "", ErrUnsupportedLanguage
 	}
-	if algo != Secp256k1 {
+	if !IsSupportedAlgorithm(algo) {
 		err = ErrUnsupportedSigningAlgo
 		return
 	}
+	entropySize, err := MnemonicEntropySize(words)
+	if err != nil {
+		return
+	}
 
-	// default number of words (24):
 	// this generates a mnemonic directly from the number of words by reading system entropy.
-	entropy, err := bip39.NewEntropy(defaultEntropySize)
+	entropy, err := bip39.NewEntropy(entropySize)
 	if err != nil {
 		return
 	}
@@ -109,11 +153,39 @@ func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string
 		return
 	}
 
-	seed := bip39.NewSeed(mnemonic, defaultBIP39Passphrase)
-	info, err = kb.persistDerivedKey(seed, passwd, name, hd.FullFundraiserPath)
+	seed := bip39.NewSeed(mnemonic, bip39Passphrase)
+	info, err = kb.persistDerivedKey(seed, encryptPasswd, name, types.GetConfig().GetFullFundraiserPath(), algo)
 	return
 }
 
+// MnemonicEntropySize returns the bits of entropy of a mnemonic of the given
+// number of words.
+func MnemonicEntropySize(words int) (int, error) {
+	switch words {
+	case 12:
+		return 128, nil
+	case 24:
+		return 256, nil
+	default:
+		return 0, ErrUnsupportedMnemonicLength
+	}
+}
+
+// ValidateMnemonic returns an error if the mnemonic is not a valid 12 or 24
+// word mnemonic of the given language.
+func ValidateMnemonic(mnemonic string, language Language) error {
+	if language != English {
+		return ErrUnsupportedLanguage
+	}
+	if _, err := MnemonicEntropySize(len(strings.Fields(mnemonic))); err != nil {
+		return err
+	}
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return fmt.Errorf("invalid %s mnemonic", language)
+	}
+	return nil
+}
+
 // TEMPORARY METHOD UNTIL WE FIGURE OUT USER FACING HD DERIVATION API
 func (kb dbKeybase) CreateKey(name, mnemonic, passwd string) (info Info, err error) {
 	words := strings.Split(mnemonic, " ")
@@ -125,7 +197,7 @@ func (kb dbKeybase) CreateKey(name, mnemonic, passwd string) (info Info, err err
 	if err != nil {
 		return
 	}
-	info, err = kb.persistDerivedKey(seed, passwd, name, hd.FullFundraiserPath)
+	info, err = kb.persistDerivedKey(seed, passwd, name, types.GetConfig().GetFullFundraiserPath(), Secp256k1)
 	return
 }
 
@@ -142,20 +214,38 @@ func (kb dbKeybase) CreateFundraiserKey(name, mnemonic, passwd string) (info Inf
 	if err != nil {
 		return
 	}
-	info, err = kb.persistDerivedKey(seed, passwd, name, hd.FullFundraiserPath)
+	info, err = kb.persistDerivedKey(seed, passwd, name, hd.FullFundraiserPath, Secp256k1)
 	return
 }
 
 func (kb dbKeybase) Derive(name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params) (info Info, err error) {
+	return kb.DeriveWithAlgo(name, mnemonic, bip39Passphrase, encryptPasswd, params, Secp256k1)
+}
+
+// DeriveWithAlgo derives a key of the given signing algorithm from the mnemonic
+// and the BIP39 passphrase at the BIP44 path, and persists it encrypted with
+// encryptPasswd.
+func (kb dbKeybase) DeriveWithAlgo(name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (info Info, err error) {
+	if !IsSupportedAlgorithm(algo) {
+		return nil, ErrUnsupportedSigningAlgo
+	}
 	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
 	if err != nil {
 		return
 	}
-	info, err = kb.persistDerivedKey(seed, encryptPasswd, name, params.String())
+	info, err = kb.persistDerivedKey(seed, encryptPasswd, name, params.String(), algo)
 
 	return
 }
 
+// CreateAccount converts a mnemonic to the private key of the given account
+// and address index, derived with the configured coin type, and persists it
+// encrypted with the given password.
+func (kb dbKeybase) CreateAccount(name, mnemonic, bip39Passphrase, encryptPasswd string, account, index uint32) (Info, error) {
+	params := hd.CreateHDPath(types.GetConfig().GetCoinType(), account, index)
+	return kb.Derive(name, mnemonic, bip39Passphrase, encryptPasswd, *params)
+}
+
 // CreateLedger creates a new locally-stored reference to a Ledger keypair
 // It returns the created key info and an error if the Ledger could not be queried
 func (kb dbKeybase) CreateLedger(name string, path crypto.DerivationPath, algo SigningAlgo) (Info, error) {
@@ -170,27 +260,53 @@ func (kb dbKeybase) CreateLedger(name string, path crypto.DerivationPath, algo S
 	return kb.writeLedgerKey(pub, path, name), nil
 }
 
+// ShowLedgerAddress re-derives the given Ledger key on the device and displays
+// its address, with the given bech32 prefix, on the device screen for the user
+// to compare it with the address displayed by the host.
+// It returns an error if the key isn't a Ledger key or the device key differs.
+func ShowLedgerAddress(info Info, bech32Prefix string) error {
+	var path crypto.DerivationPath
+	switch linfo := info.(type) {
+	case ledgerInfo:
+		path = linfo.Path
+	case *ledgerInfo:
+		path = linfo.Path
+	default:
+		return fmt.Errorf("key %s is not a Ledger key", info.GetName())
+	}
+	return crypto.LedgerShowAddress(path, info.GetPubKey().Address(), bech32Prefix)
+}
+
 // CreateOffline creates a new reference to an offline keypair
 // It returns the created key info
 func (kb dbKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
 	return kb.writeOfflineKey(pub, name), nil
 }
 
-func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string) (info Info, err error) {
+// CreateRemote creates a new reference to a keypair held by a remote signing
+// service, which signs with it through NewRemoteSigner.
+// It returns the created key info
+func (kb dbKeybase) CreateRemote(name string, pub tmcrypto.PubKey, url string) (Info, error) {
+	info := newRemoteInfo(name, pub, url)
+	kb.writeInfo(info, name)
+	return info, nil
+}
+
+func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string, algo SigningAlgo) (info Info, err error) {
 	// create master key and derive first key:
 	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
 	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
 	if err != nil {
 		return
 	}
+	priv := privKeyGens[algo](derivedPriv)
 
 	// if we have a password, use it to encrypt the private key and store it
 	// else store the public key only
 	if passwd != "" {
-		info = kb.writeLocalKey(secp256k1.PrivKeySecp256k1(derivedPriv), name, passwd)
+		info = kb.writeLocalKey(priv, name, passwd)
 	} else {
-		pubk := secp256k1.PrivKeySecp256k1(derivedPriv).PubKey()
-		info = kb.writeOfflineKey(pubk, name)
+		info = kb.writeOfflineKey(priv.PubKey(), name)
 	}
 	return
 }
@@ -276,6 +392,16 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub t
 		}
 		cdc.MustUnmarshalBinaryLengthPrefixed([]byte(signed), sig)
 		return sig, linfo.GetPubKey(), nil
+	case remoteInfo:
+		rinfo := info.(remoteInfo)
+		sig, pub, err = NewRemoteSigner(rinfo.URL).Sign(name, passphrase, msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !pub.Equals(rinfo.PubKey) {
+			return nil, nil, fmt.Errorf("remote signer %s signed with another key than %s", rinfo.URL, name)
+		}
+		return sig, pub, nil
 	}
 	sig, err = priv.Sign(msg)
 	if err != nil {
@@ -304,7 +430,7 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 		}
 	case ledgerInfo:
 		return nil, errors.New("Only works on local private keys")
-	case offlineInfo:
+	case offlineInfo, remoteInfo:
 		return nil, errors.New("Only works on local private keys")
 	}
 	return priv, nil
@@ -333,6 +459,17 @@ func (kb dbKeybase) ExportPubKey(name string) (armor string, err error) {
 	return mintkey.ArmorPubKeyBytes(info.GetPubKey().Bytes()), nil
 }
 
+// ExportPrivKey returns a private key in ASCII armored format.
+// It decrypts the locally-stored private key with decryptPassphrase, and
+// encrypts the exported key with encryptPassphrase.
+func (kb dbKeybase) ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (armor string, err error) {
+	priv, err := kb.ExportPrivateKeyObject(name, decryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+	return mintkey.EncryptArmorPrivKey(priv, encryptPassphrase), nil
+}
+
 func (kb dbKeybase) Import(name string, armor string) (err error) {
 	bz := kb.db.Get(infoKey(name))
 	if len(bz) > 0 {
@@ -358,14 +495,29 @@ func (kb dbKeybase) ImportPubKey(name string, armor string) (err error) {
 	if err != nil {
 		return
 	}
-	pubKey, err := cryptoAmino.PubKeyFromBytes(pubBytes)
-	if err != nil {
+	var pubKey tmcrypto.PubKey
+	if err = cdc.UnmarshalBinaryBare(pubBytes, &pubKey); err != nil {
 		return
 	}
 	kb.writeOfflineKey(pubKey, name)
 	return
 }
 
+// ImportPrivKey imports an ASCII armored private key.
+// It decrypts the key with passphrase and stores a new local key, encrypted
+// with the same passphrase.
+func (kb dbKeybase) ImportPrivKey(name, armor, passphrase string) error {
+	if bz := kb.db.Get(infoKey(name)); len(bz) > 0 {
+		return errors.New("Cannot overwrite data for name " + name)
+	}
+	priv, err := mintkey.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return err
+	}
+	kb.writeLocalKey(priv, name, passphrase)
+	return nil
+}
+
 // Delete removes key forever, but we must present the
 // proper passphrase before deleting it (for security).
 // It returns an error if the key doesn't exist or
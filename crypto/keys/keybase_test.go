@@ -2,6 +2,7 @@ package keys
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -268,6 +270,43 @@ func TestExportImportPubKey(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestExportImportPrivKey(t *testing.T) {
+	// make the storage with reasonable defaults
+	cstore := New(dbm.NewMemDB())
+
+	info, _, err := cstore.CreateMnemonic("john", English, "secretcpw", Secp256k1)
+	require.NoError(t, err)
+
+	// the key passphrase is needed to export the private key
+	_, err = cstore.ExportPrivKey("john", "wrongpw", "exportpw")
+	require.Error(t, err)
+	armor, err := cstore.ExportPrivKey("john", "secretcpw", "exportpw")
+	require.NoError(t, err)
+
+	// and the export passphrase to import it, in another keybase
+	other := New(dbm.NewMemDB())
+	require.Error(t, other.ImportPrivKey("john", armor, "secretcpw"))
+	require.NoError(t, other.ImportPrivKey("john", armor, "exportpw"))
+
+	john, err := other.Get("john")
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, john.GetType())
+	require.Equal(t, info.GetPubKey(), john.GetPubKey())
+
+	// the imported key is encrypted with the export passphrase
+	msg := []byte("message")
+	sig, pub, err := other.Sign("john", "exportpw", msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// keys cannot be overwritten, nor exported if not stored locally
+	require.Error(t, other.ImportPrivKey("john", armor, "exportpw"))
+	_, err = cstore.CreateOffline("offline", info.GetPubKey())
+	require.NoError(t, err)
+	_, err = cstore.ExportPrivKey("offline", "", "exportpw")
+	require.Error(t, err)
+}
+
 // TestAdvancedKeyManagement verifies update, import, export functionality
 func TestAdvancedKeyManagement(t *testing.T) {
 
@@ -351,6 +390,106 @@ func TestSeedPhrase(t *testing.T) {
 	require.Equal(t, info.GetPubKey(), newInfo.GetPubKey())
 }
 
+func TestCreateAccount(t *testing.T) {
+	cstore := New(dbm.NewMemDB())
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+	// the first account is the one recovered from the mnemonic
+	first, err := cstore.CreateKey("first", mnemonic, "pw")
+	require.NoError(t, err)
+	account, err := cstore.CreateAccount("account", mnemonic, "", "pw", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, first.GetPubKey(), account.GetPubKey())
+
+	// the accounts and indexes derive distinct keys, with the same path as Derive
+	other, err := cstore.CreateAccount("other", mnemonic, "", "pw", 1, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, first.GetPubKey(), other.GetPubKey())
+	params, err := hd.NewParamsFromPath(fmt.Sprintf("44'/%d'/1'/0/2", types.CoinType))
+	require.NoError(t, err)
+	derived, err := cstore.Derive("derived", mnemonic, "", "pw", *params)
+	require.NoError(t, err)
+	require.Equal(t, other.GetPubKey(), derived.GetPubKey())
+
+	// another coin type derives another key
+	params, err = hd.NewParamsFromPath("44'/60'/1'/0/2")
+	require.NoError(t, err)
+	derived, err = cstore.Derive("coin", mnemonic, "", "pw", *params)
+	require.NoError(t, err)
+	require.NotEqual(t, other.GetPubKey(), derived.GetPubKey())
+}
+
+func TestSigningAlgos(t *testing.T) {
+	cstore := New(dbm.NewMemDB())
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	params := *hd.NewFundraiserParams(0, 0)
+
+	k1, err := cstore.DeriveWithAlgo("k1", mnemonic, "", "pw", params, Secp256k1)
+	require.NoError(t, err)
+	r1, err := cstore.DeriveWithAlgo("r1", mnemonic, "", "pw", params, Secp256r1)
+	require.NoError(t, err)
+	require.IsType(t, secp256r1.PubKeySecp256r1{}, r1.GetPubKey())
+	require.NotEqual(t, k1.GetAddress(), r1.GetAddress())
+	_, err = cstore.DeriveWithAlgo("ed", mnemonic, "", "pw", params, Ed25519)
+	require.Equal(t, ErrUnsupportedSigningAlgo, err)
+
+	// the stored key signs with the algorithm
+	msg := []byte("message")
+	sig, pub, err := cstore.Sign("r1", "pw", msg)
+	require.NoError(t, err)
+	require.Equal(t, r1.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the derivation is deterministic, and exports and imports
+	again, err := cstore.DeriveWithAlgo("again", mnemonic, "", "pw", params, Secp256r1)
+	require.NoError(t, err)
+	require.Equal(t, r1.GetPubKey(), again.GetPubKey())
+	armor, err := cstore.ExportPubKey("r1")
+	require.NoError(t, err)
+	require.NoError(t, cstore.ImportPubKey("imported", armor))
+	imported, err := cstore.Get("imported")
+	require.NoError(t, err)
+	require.Equal(t, r1.GetPubKey(), imported.GetPubKey())
+}
+
+func TestNewMnemonic(t *testing.T) {
+	cstore := New(dbm.NewMemDB())
+
+	// the number of words and the language are checked
+	_, _, err := cstore.NewMnemonic("foo", English, 15, "", "pw", Secp256k1)
+	require.Equal(t, ErrUnsupportedMnemonicLength, err)
+	_, _, err = cstore.NewMnemonic("foo", Japanese, 12, "", "pw", Secp256k1)
+	require.Equal(t, ErrUnsupportedLanguage, err)
+
+	// the key is derived from the mnemonic and the BIP39 passphrase
+	info, mnemonic, err := cstore.NewMnemonic("foo", English, 12, "bip39pw", "pw", Secp256k1)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 12)
+	require.NoError(t, ValidateMnemonic(mnemonic, English))
+	recovered, err := cstore.CreateAccount("bar", mnemonic, "bip39pw", "pw", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), recovered.GetPubKey())
+	other, err := cstore.CreateAccount("baz", mnemonic, "", "pw", 0, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, info.GetPubKey(), other.GetPubKey())
+
+	_, mnemonic, err = cstore.NewMnemonic("qux", English, 24, "", "pw", Secp256k1)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 24)
+
+	// invalid mnemonics are rejected
+	words := strings.Fields(mnemonic)
+	require.Equal(t, ErrUnsupportedMnemonicLength, ValidateMnemonic(strings.Join(words[:23], " "), English))
+	words[0] = "notaword"
+	require.Error(t, ValidateMnemonic(strings.Join(words, " "), English))
+
+	lang, err := LanguageFromString("Chinese_Simplified")
+	require.NoError(t, err)
+	require.Equal(t, ChineseSimplified, lang)
+	_, err = LanguageFromString("klingon")
+	require.Error(t, err)
+}
+
 func ExampleNew() {
 	// Select the encryption and storage for your cryptostore
 	cstore := New(
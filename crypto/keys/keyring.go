@@ -0,0 +1,321 @@
+package keys
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// Backends of the keyring storing the keys of a keybase.
+const (
+	// BackendLevelDB stores the keys in the LevelDB keybase of former
+	// versions, under the keys directory of the root directory.
+	BackendLevelDB = "leveldb"
+	// BackendOS stores the keys in the credential store of the operating
+	// system: the keychain on macOS, the Secret Service on Linux.
+	BackendOS = "os"
+	// BackendFile stores the keys in files encrypted with a passphrase.
+	BackendFile = "file"
+	// BackendPass stores the keys in the pass password manager.
+	BackendPass = "pass"
+	// BackendTest stores the keys unencrypted in files, for testing only.
+	BackendTest = "test"
+)
+
+// keyring stores binary items under their keys. Get returns nil if the key
+// does not exist.
+type keyring interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte) error
+	Remove(key string) error
+	Keys() ([]string, error)
+}
+
+// NewKeyring creates a keybase storing its keys in the given keyring backend.
+// The items of the os and pass backends are namespaced by the app name, and
+// those of the leveldb, file and test backends are stored under the root
+// directory.
+// The passphrase of the file backend is read with getPassphrase, which is told
+// whether the keyring is being created.
+func NewKeyring(appName, backend, rootDir string, getPassphrase func(create bool) (string, error)) (Keybase, error) {
+	var (
+		kr  keyring
+		err error
+	)
+	switch backend {
+	case BackendLevelDB:
+		db, err := dbm.NewGoLevelDB("keys", filepath.Join(rootDir, "keys"))
+		if err != nil {
+			return nil, err
+		}
+		return New(db), nil
+	case BackendOS:
+		kr, err = newOSKeyring(appName)
+	case BackendFile:
+		kr, err = newFileKeyring(filepath.Join(rootDir, "keyring-file"), getPassphrase)
+	case BackendPass:
+		kr, err = newPassKeyring(appName)
+	case BackendTest:
+		kr, err = newFileKeyring(filepath.Join(rootDir, "keyring-test"), nil)
+	default:
+		return nil, fmt.Errorf("unknown keyring backend %q", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return keyringKeybase{New(keyringDB{kr})}, nil
+}
+
+//-----------------------------------------------------------------
+// keyringDB
+
+var _ dbm.DB = keyringDB{}
+
+// keyringDB adapts a keyring to the DB of a keybase. As the DB interface does
+// not return errors, it panics with a keyringError if the keyring fails, which
+// keyringKeybase returns as the error of the failed operation.
+type keyringDB struct {
+	kr keyring
+}
+
+// keyringError is the panic value of a keyringDB whose keyring failed.
+type keyringError struct {
+	err error
+}
+
+// check panics with a keyringError if err is not nil.
+func (db keyringDB) check(err error) {
+	if err != nil {
+		panic(keyringError{err})
+	}
+}
+
+// Implements DB.
+func (db keyringDB) Get(key []byte) []byte {
+	value, err := db.kr.Get(string(key))
+	db.check(err)
+	return value
+}
+
+// Implements DB.
+func (db keyringDB) Has(key []byte) bool {
+	return db.Get(key) != nil
+}
+
+// Implements DB.
+func (db keyringDB) Set(key []byte, value []byte) {
+	db.check(db.kr.Set(string(key), value))
+}
+
+// Implements DB.
+func (db keyringDB) SetSync(key []byte, value []byte) {
+	db.Set(key, value)
+}
+
+// Implements DB.
+func (db keyringDB) Delete(key []byte) {
+	db.check(db.kr.Remove(string(key)))
+}
+
+// Implements DB.
+func (db keyringDB) DeleteSync(key []byte) {
+	db.Delete(key)
+}
+
+// Implements DB. The items of the keyring are loaded in memory.
+func (db keyringDB) Iterator(start, end []byte) dbm.Iterator {
+	return db.load().Iterator(start, end)
+}
+
+// Implements DB. The items of the keyring are loaded in memory.
+func (db keyringDB) ReverseIterator(start, end []byte) dbm.Iterator {
+	return db.load().ReverseIterator(start, end)
+}
+
+func (db keyringDB) load() dbm.DB {
+	keys, err := db.kr.Keys()
+	db.check(err)
+
+	memDB := dbm.NewMemDB()
+	for _, key := range keys {
+		if value := db.Get([]byte(key)); value != nil {
+			memDB.Set([]byte(key), value)
+		}
+	}
+	return memDB
+}
+
+// Implements DB.
+func (db keyringDB) Close() {}
+
+// Implements DB. Batches are not supported.
+func (db keyringDB) NewBatch() dbm.Batch {
+	panic("keyring does not support batches")
+}
+
+// Implements DB.
+func (db keyringDB) Print() {
+	keys, err := db.kr.Keys()
+	db.check(err)
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Printf("[%X]:\t[%X]\n", []byte(key), db.Get([]byte(key)))
+	}
+}
+
+// Implements DB.
+func (db keyringDB) Stats() map[string]string {
+	keys, err := db.kr.Keys()
+	db.check(err)
+	return map[string]string{"database.type": "keyring", "database.size": fmt.Sprintf("%d", len(keys))}
+}
+
+//-----------------------------------------------------------------
+// keyringKeybase
+
+var _ Keybase = keyringKeybase{}
+
+// keyringKeybase is a keybase over a keyringDB, returning the failures of the
+// keyring as errors instead of panicking.
+type keyringKeybase struct {
+	kb Keybase
+}
+
+// recoverKeyringError sets err to the error of a keyringDB whose keyring
+// failed, and re-panics on any other panic.
+func recoverKeyringError(err *error) {
+	if r := recover(); r != nil {
+		kerr, ok := r.(keyringError)
+		if !ok {
+			panic(r)
+		}
+		*err = kerr.err
+	}
+}
+
+func (kb keyringKeybase) List() (infos []Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.List()
+}
+
+func (kb keyringKeybase) Get(name string) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Get(name)
+}
+
+func (kb keyringKeybase) GetByAddress(address types.AccAddress) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.GetByAddress(address)
+}
+
+func (kb keyringKeybase) Delete(name, passphrase string, skipPass bool) (err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Delete(name, passphrase, skipPass)
+}
+
+func (kb keyringKeybase) Sign(name, passphrase string, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Sign(name, passphrase, msg)
+}
+
+func (kb keyringKeybase) CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info, seed string, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateMnemonic(name, language, passwd, algo)
+}
+
+func (kb keyringKeybase) NewMnemonic(name string, language Language, words int, bip39Passwd, encryptPasswd string, algo SigningAlgo) (info Info, seed string, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.NewMnemonic(name, language, words, bip39Passwd, encryptPasswd, algo)
+}
+
+func (kb keyringKeybase) CreateKey(name, mnemonic, passwd string) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateKey(name, mnemonic, passwd)
+}
+
+func (kb keyringKeybase) CreateFundraiserKey(name, mnemonic, passwd string) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateFundraiserKey(name, mnemonic, passwd)
+}
+
+func (kb keyringKeybase) Derive(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, params)
+}
+
+func (kb keyringKeybase) DeriveWithAlgo(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.DeriveWithAlgo(name, mnemonic, bip39Passwd, encryptPasswd, params, algo)
+}
+
+func (kb keyringKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account, index uint32) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd, account, index)
+}
+
+func (kb keyringKeybase) CreateLedger(name string, path crypto.DerivationPath, algo SigningAlgo) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateLedger(name, path, algo)
+}
+
+func (kb keyringKeybase) CreateOffline(name string, pubkey tmcrypto.PubKey) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateOffline(name, pubkey)
+}
+
+func (kb keyringKeybase) CreateRemote(name string, pubkey tmcrypto.PubKey, url string) (info Info, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.CreateRemote(name, pubkey, url)
+}
+
+func (kb keyringKeybase) Update(name, oldpass string, getNewpass func() (string, error)) (err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Update(name, oldpass, getNewpass)
+}
+
+func (kb keyringKeybase) Import(name string, armor string) (err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Import(name, armor)
+}
+
+func (kb keyringKeybase) ImportPubKey(name string, armor string) (err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.ImportPubKey(name, armor)
+}
+
+func (kb keyringKeybase) Export(name string) (armor string, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.Export(name)
+}
+
+func (kb keyringKeybase) ExportPubKey(name string) (armor string, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.ExportPubKey(name)
+}
+
+func (kb keyringKeybase) ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (armor string, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.ExportPrivKey(name, decryptPassphrase, encryptPassphrase)
+}
+
+func (kb keyringKeybase) ImportPrivKey(name, armor, passphrase string) (err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.ImportPrivKey(name, armor, passphrase)
+}
+
+func (kb keyringKeybase) ExportPrivateKeyObject(name string, passphrase string) (priv tmcrypto.PrivKey, err error) {
+	defer recoverKeyringError(&err)
+	return kb.kb.ExportPrivateKeyObject(name, passphrase)
+}
+
+func (kb keyringKeybase) CloseDB() {
+	kb.kb.CloseDB()
+}
@@ -0,0 +1,394 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+)
+
+const (
+	// file of a file keyring holding the salt of its passphrase and a
+	// check of the passphrase
+	keyringPassphraseFile = "keyring.passphrase"
+	keyringPassphraseSalt = 16
+	keyringCheck          = "keyring"
+
+	// item of an indexed keyring listing its keys
+	keyringIndexKey = "keyring.index"
+)
+
+//-----------------------------------------------------------------
+// file and test backends
+
+// fileKeyring stores each item in a file, named after its escaped key and
+// encrypted if the keyring has a secret.
+type fileKeyring struct {
+	dir    string
+	secret []byte
+}
+
+// newFileKeyring opens a file keyring in the given directory, creating it if
+// needed. The keyring is encrypted with a passphrase read with getPassphrase,
+// unless the latter is nil.
+func newFileKeyring(dir string, getPassphrase func(create bool) (string, error)) (keyring, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	kr := fileKeyring{dir: dir}
+	if getPassphrase == nil {
+		return kr, nil
+	}
+
+	passphrasePath := filepath.Join(dir, keyringPassphraseFile)
+	bz, err := ioutil.ReadFile(passphrasePath)
+	create := os.IsNotExist(err)
+	if err != nil && !create {
+		return nil, err
+	}
+
+	passphrase, err := getPassphrase(create)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := crypto.CRandBytes(keyringPassphraseSalt)
+	if !create {
+		if len(bz) < keyringPassphraseSalt {
+			return nil, fmt.Errorf("invalid keyring passphrase file %s", passphrasePath)
+		}
+		salt = bz[:keyringPassphraseSalt]
+	}
+
+	key, err := bcrypt.GenerateFromPassword(salt, []byte(passphrase), mintkey.BcryptSecurityParameter)
+	if err != nil {
+		return nil, err
+	}
+	kr.secret = crypto.Sha256(key)
+
+	if create {
+		check := xsalsa20symmetric.EncryptSymmetric([]byte(keyringCheck), kr.secret)
+		if err := ioutil.WriteFile(passphrasePath, append(salt, check...), 0600); err != nil {
+			return nil, err
+		}
+		return kr, nil
+	}
+
+	check, err := xsalsa20symmetric.DecryptSymmetric(bz[keyringPassphraseSalt:], kr.secret)
+	if err != nil || string(check) != keyringCheck {
+		return nil, keyerror.NewErrWrongPassword()
+	}
+	return kr, nil
+}
+
+func (kr fileKeyring) path(key string) string {
+	return filepath.Join(kr.dir, url.PathEscape(key))
+}
+
+// Implements keyring.
+func (kr fileKeyring) Get(key string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(kr.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil || kr.secret == nil {
+		return bz, err
+	}
+	return xsalsa20symmetric.DecryptSymmetric(bz, kr.secret)
+}
+
+// Implements keyring.
+func (kr fileKeyring) Set(key string, value []byte) error {
+	if kr.secret != nil {
+		value = xsalsa20symmetric.EncryptSymmetric(value, kr.secret)
+	}
+	return ioutil.WriteFile(kr.path(key), value, 0600)
+}
+
+// Implements keyring.
+func (kr fileKeyring) Remove(key string) error {
+	err := os.Remove(kr.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Implements keyring.
+func (kr fileKeyring) Keys() ([]string, error) {
+	files, err := ioutil.ReadDir(kr.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, file := range files {
+		if file.IsDir() || file.Name() == keyringPassphraseFile {
+			continue
+		}
+		key, err := url.PathUnescape(file.Name())
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+//-----------------------------------------------------------------
+// os and pass backends
+
+// itemStore stores items which cannot be listed.
+type itemStore interface {
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte) error
+	Remove(key string) error
+}
+
+// indexedKeyring lists the keys of an item store in an index item.
+type indexedKeyring struct {
+	itemStore
+}
+
+// Implements keyring.
+func (kr indexedKeyring) Set(key string, value []byte) error {
+	if err := kr.itemStore.Set(key, value); err != nil {
+		return err
+	}
+
+	keys, err := kr.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if k == key {
+			return nil
+		}
+	}
+	return kr.setKeys(append(keys, key))
+}
+
+// Implements keyring.
+func (kr indexedKeyring) Remove(key string) error {
+	if err := kr.itemStore.Remove(key); err != nil {
+		return err
+	}
+
+	keys, err := kr.Keys()
+	if err != nil {
+		return err
+	}
+	var remaining []string
+	for _, k := range keys {
+		if k != key {
+			remaining = append(remaining, k)
+		}
+	}
+	return kr.setKeys(remaining)
+}
+
+// Implements keyring.
+func (kr indexedKeyring) Keys() ([]string, error) {
+	bz, err := kr.itemStore.Get(keyringIndexKey)
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var keys []string
+	err = json.Unmarshal(bz, &keys)
+	return keys, err
+}
+
+func (kr indexedKeyring) setKeys(keys []string) error {
+	bz, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	return kr.itemStore.Set(keyringIndexKey, bz)
+}
+
+// osKeyring stores the items, hex encoded, in the credential store of the
+// operating system, through the security tool on macOS and the secret-tool
+// of libsecret on Linux.
+type osKeyring struct {
+	service string
+}
+
+func newOSKeyring(appName string) (keyring, error) {
+	var tool string
+	switch runtime.GOOS {
+	case "darwin":
+		tool = "security"
+	case "linux":
+		tool = "secret-tool"
+	default:
+		return nil, fmt.Errorf("the os keyring backend is not supported on %s", runtime.GOOS)
+	}
+	if _, err := exec.LookPath(tool); err != nil {
+		return nil, fmt.Errorf("the os keyring backend requires %s: %v", tool, err)
+	}
+
+	return indexedKeyring{osKeyring{service: appName}}, nil
+}
+
+// Implements itemStore.
+func (kr osKeyring) Get(key string) ([]byte, error) {
+	if runtime.GOOS == "darwin" {
+		out, err := runKeyringTool("", "security", "find-generic-password", "-s", kr.service, "-a", key, "-w")
+		return decodeKeyringItem(out, err, isSecurityItemNotFound)
+	}
+	out, err := runKeyringTool("", "secret-tool", "lookup", "service", kr.service, "key", key)
+	return decodeKeyringItem(out, err, isSecretToolItemNotFound)
+}
+
+// isSecurityItemNotFound returns true if the security tool failed with
+// errSecItemNotFound, the item being missing from the keychain.
+func isSecurityItemNotFound(err keyringToolError) bool {
+	return err.exitCode == 44 || strings.Contains(err.stderr, "could not be found")
+}
+
+// isSecretToolItemNotFound returns true if secret-tool lookup failed without
+// any message, which it does only when the item is missing.
+func isSecretToolItemNotFound(err keyringToolError) bool {
+	return err.exitCode == 1 && err.stderr == ""
+}
+
+// Implements itemStore.
+func (kr osKeyring) Set(key string, value []byte) error {
+	var err error
+	if runtime.GOOS == "darwin" {
+		// the command is read by the interactive mode of security from its
+		// standard input, so that the secret is not in the command line of
+		// the process, visible to the other users
+		command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+			quoteSecurityArg(kr.service), quoteSecurityArg(key), hex.EncodeToString(value))
+		_, err = runKeyringTool(command, "security", "-i")
+	} else {
+		_, err = runKeyringTool(hex.EncodeToString(value), "secret-tool", "store",
+			"--label", kr.service+" "+key, "service", kr.service, "key", key)
+	}
+	return err
+}
+
+// Implements itemStore.
+func (kr osKeyring) Remove(key string) error {
+	if value, err := kr.Get(key); err != nil || value == nil {
+		return err
+	}
+
+	var err error
+	if runtime.GOOS == "darwin" {
+		_, err = runKeyringTool("", "security", "delete-generic-password", "-s", kr.service, "-a", key)
+	} else {
+		_, err = runKeyringTool("", "secret-tool", "clear", "service", kr.service, "key", key)
+	}
+	return err
+}
+
+// quoteSecurityArg quotes an argument of a command of the interactive mode of
+// the security tool, which splits the commands as a shell does
+func quoteSecurityArg(arg string) string {
+	return "'" + strings.Replace(arg, "'", `'"'"'`, -1) + "'"
+}
+
+// passKeyring stores the items, hex encoded, in the pass password manager,
+// under the app name.
+type passKeyring struct {
+	prefix string
+}
+
+func newPassKeyring(appName string) (keyring, error) {
+	if _, err := exec.LookPath("pass"); err != nil {
+		return nil, fmt.Errorf("the pass keyring backend requires pass: %v", err)
+	}
+	return indexedKeyring{passKeyring{prefix: appName}}, nil
+}
+
+// Implements itemStore.
+func (kr passKeyring) Get(key string) ([]byte, error) {
+	out, err := runKeyringTool("", "pass", "show", kr.prefix+"/"+key)
+	return decodeKeyringItem(out, err, isPassItemNotFound)
+}
+
+// isPassItemNotFound returns true if pass failed as the item is missing from
+// the password store.
+func isPassItemNotFound(err keyringToolError) bool {
+	return strings.Contains(err.stderr, "is not in the password store")
+}
+
+// Implements itemStore.
+func (kr passKeyring) Set(key string, value []byte) error {
+	_, err := runKeyringTool(hex.EncodeToString(value), "pass", "insert", "--multiline", "--force", kr.prefix+"/"+key)
+	return err
+}
+
+// Implements itemStore.
+func (kr passKeyring) Remove(key string) error {
+	if value, err := kr.Get(key); err != nil || value == nil {
+		return err
+	}
+
+	_, err := runKeyringTool("", "pass", "rm", "--force", kr.prefix+"/"+key)
+	return err
+}
+
+// runKeyringTool runs a command with the given input, returning its output
+func runKeyringTool(input string, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		exitCode := -1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+				exitCode = status.ExitStatus()
+			}
+		}
+		return "", keyringToolError{err: err, exitCode: exitCode, stderr: strings.TrimSpace(stderr.String())}
+	}
+	return stdout.String(), nil
+}
+
+// keyringToolError is returned when a keyring command fails, with its exit
+// code, -1 if it did not exit
+type keyringToolError struct {
+	err      error
+	exitCode int
+	stderr   string
+}
+
+func (e keyringToolError) Error() string {
+	return fmt.Sprintf("%v: %s", e.err, e.stderr)
+}
+
+// decodeKeyringItem decodes the output of a command looking up an item,
+// returning nil if the command failed as notFound reports the item missing,
+// and any other failure as an error.
+func decodeKeyringItem(out string, err error, notFound func(keyringToolError) bool) ([]byte, error) {
+	if toolErr, ok := err.(keyringToolError); ok && notFound(toolErr) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(strings.TrimSpace(out))
+}
@@ -0,0 +1,146 @@
+package keys
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+)
+
+func TestKeyringBackends(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	passphrase := func(pass string) func(bool) (string, error) {
+		return func(bool) (string, error) { return pass, nil }
+	}
+
+	_, err = NewKeyring("test", "unknown", dir, nil)
+	require.Error(t, err)
+
+	for _, backend := range []string{BackendTest, BackendFile} {
+		kb, err := NewKeyring("test", backend, dir, passphrase("12345678"))
+		require.NoError(t, err, backend)
+		_, _, err = kb.CreateMnemonic("foo", English, "1234", Secp256k1)
+		require.NoError(t, err, backend)
+		_, _, err = kb.CreateMnemonic("bar", English, "1234", Secp256k1)
+		require.NoError(t, err, backend)
+		require.NoError(t, kb.Delete("bar", "1234", false), backend)
+
+		// the keys are persisted
+		kb, err = NewKeyring("test", backend, dir, passphrase("12345678"))
+		require.NoError(t, err, backend)
+		infos, err := kb.List()
+		require.NoError(t, err, backend)
+		require.Len(t, infos, 1, backend)
+		require.Equal(t, "foo", infos[0].GetName(), backend)
+		_, err = kb.GetByAddress(infos[0].GetAddress())
+		require.NoError(t, err, backend)
+	}
+
+	// the file backend is encrypted with its passphrase
+	_, err = NewKeyring("test", BackendFile, dir, passphrase("wrong"))
+	require.True(t, keyerror.IsErrWrongPassword(err))
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, "keyring-test", "foo.info"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "foo")
+	bz, err = ioutil.ReadFile(filepath.Join(dir, "keyring-file", "foo.info"))
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "foo")
+}
+
+// memItemStore stores the items in memory
+type memItemStore map[string][]byte
+
+func (s memItemStore) Get(key string) ([]byte, error)     { return s[key], nil }
+func (s memItemStore) Set(key string, value []byte) error { s[key] = value; return nil }
+func (s memItemStore) Remove(key string) error            { delete(s, key); return nil }
+
+func TestIndexedKeyring(t *testing.T) {
+	kr := indexedKeyring{memItemStore{}}
+	keys, err := kr.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	require.NoError(t, kr.Set("a", []byte("1")))
+	require.NoError(t, kr.Set("b", []byte("2")))
+	require.NoError(t, kr.Set("a", []byte("3")))
+	keys, err = kr.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, keys)
+
+	require.NoError(t, kr.Remove("a"))
+	keys, err = kr.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, keys)
+	value, err := kr.Get("b")
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+}
+
+func TestQuoteSecurityArg(t *testing.T) {
+	require.Equal(t, `'gaiacli'`, quoteSecurityArg("gaiacli"))
+	require.Equal(t, `'my key.info'`, quoteSecurityArg("my key.info"))
+	require.Equal(t, `'it'"'"'s'`, quoteSecurityArg("it's"))
+}
+
+func TestDecodeKeyringItem(t *testing.T) {
+	value, err := decodeKeyringItem("666f6f\n", nil, isPassItemNotFound)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), value)
+
+	// only the failures reporting the item missing are not errors
+	notFound := []struct {
+		err      keyringToolError
+		notFound func(keyringToolError) bool
+	}{
+		{keyringToolError{exitCode: 44, stderr: "security: SecKeychainSearchCopyNext: The specified item could not be found in the keychain."}, isSecurityItemNotFound},
+		{keyringToolError{exitCode: 1}, isSecretToolItemNotFound},
+		{keyringToolError{exitCode: 1, stderr: "Error: cosmos/foo is not in the password store."}, isPassItemNotFound},
+	}
+	for i, tc := range notFound {
+		value, err := decodeKeyringItem("", tc.err, tc.notFound)
+		require.NoError(t, err, "tc #%d", i)
+		require.Nil(t, value, "tc #%d", i)
+	}
+
+	failures := []struct {
+		err      keyringToolError
+		notFound func(keyringToolError) bool
+	}{
+		{keyringToolError{exitCode: 51, stderr: "security: SecKeychainSearchCopyNext: User interaction is not allowed."}, isSecurityItemNotFound},
+		{keyringToolError{exitCode: 1, stderr: "secret-tool: Cannot autolaunch D-Bus without X11 $DISPLAY"}, isSecretToolItemNotFound},
+		{keyringToolError{exitCode: -1, stderr: "signal: killed"}, isSecretToolItemNotFound},
+		{keyringToolError{exitCode: 2, stderr: "gpg: decryption failed: No secret key"}, isPassItemNotFound},
+	}
+	for i, tc := range failures {
+		_, err := decodeKeyringItem("", tc.err, tc.notFound)
+		require.Error(t, err, "tc #%d", i)
+	}
+}
+
+// failingKeyring fails every operation
+type failingKeyring struct{}
+
+func (failingKeyring) Get(key string) ([]byte, error)     { return nil, errors.New("keyring failure") }
+func (failingKeyring) Set(key string, value []byte) error { return errors.New("keyring failure") }
+func (failingKeyring) Remove(key string) error            { return errors.New("keyring failure") }
+func (failingKeyring) Keys() ([]string, error)            { return nil, errors.New("keyring failure") }
+
+func TestKeyringKeybaseErrors(t *testing.T) {
+	kb := keyringKeybase{New(keyringDB{failingKeyring{}})}
+
+	_, err := kb.List()
+	require.EqualError(t, err, "keyring failure")
+	_, err = kb.Get("foo")
+	require.EqualError(t, err, "keyring failure")
+	_, _, err = kb.CreateMnemonic("foo", English, "1234", Secp256k1)
+	require.EqualError(t, err, "keyring failure")
+}
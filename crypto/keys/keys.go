@@ -1,12 +1,51 @@
 package keys
 
+import (
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
 // SigningAlgo defines an algorithm to derive key-pairs which can be used for cryptographic signing.
 type SigningAlgo string
 
 const (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = SigningAlgo("secp256k1")
+	// Secp256r1 uses the NIST P-256 ECDSA parameters, as supported by secure
+	// enclaves.
+	Secp256r1 = SigningAlgo("secp256r1")
 	// Ed25519 represents the Ed25519 signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519 = SigningAlgo("ed25519")
 )
+
+// PrivKeyGen creates the private key of a signing algorithm from the 32 byte
+// secret derived from a mnemonic at a BIP44 path.
+type PrivKeyGen func(secret [32]byte) crypto.PrivKey
+
+// privKeyGens are the signing algorithms supported for keys derived from a
+// mnemonic.
+var privKeyGens = map[SigningAlgo]PrivKeyGen{
+	Secp256k1: func(secret [32]byte) crypto.PrivKey {
+		return secp256k1.PrivKeySecp256k1(secret)
+	},
+	Secp256r1: func(secret [32]byte) crypto.PrivKey {
+		return secp256r1.GenPrivKeySecp256r1(secret[:])
+	},
+}
+
+// RegisterSigningAlgo adds a signing algorithm to the ones supported for keys
+// derived from a mnemonic. The keys of the algorithm must be registered in the
+// codec of the application too, to be usable in transactions.
+func RegisterSigningAlgo(algo SigningAlgo, privKeyGen PrivKeyGen) {
+	privKeyGens[algo] = privKeyGen
+}
+
+// IsSupportedAlgorithm returns true if keys of the signing algorithm can be
+// derived from a mnemonic.
+func IsSupportedAlgorithm(algo SigningAlgo) bool {
+	_, ok := privKeyGens[algo]
+	return ok
+}
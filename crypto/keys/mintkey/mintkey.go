@@ -8,11 +8,11 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/armor"
-	"github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
 )
 
@@ -151,6 +151,6 @@ func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privK
 	} else if err != nil {
 		return privKey, err
 	}
-	privKey, err = cryptoAmino.PrivKeyFromBytes(privKeyBytes)
+	err = codec.Cdc.UnmarshalBinaryBare(privKeyBytes, &privKey)
 	return privKey, err
 }
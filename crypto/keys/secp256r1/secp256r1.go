@@ -0,0 +1,199 @@
+// Package secp256r1 implements ECDSA keys on the NIST P-256 curve, also known
+// as secp256r1, which is the curve supported by most secure enclaves and
+// hardware security modules.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+//-------------------------------------
+const (
+	PrivKeyAminoName = "cosmos/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos/PubKeySecp256r1"
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKeySecp256r1{},
+		PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{},
+		PrivKeyAminoName, nil)
+}
+
+// RegisterAmino registers the secp256r1 keys in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{},
+		PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{},
+		PrivKeyAminoName, nil)
+}
+
+var (
+	curve = elliptic.P256()
+
+	// half the order of the curve, the largest s of a canonical signature
+	halfOrder = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements PrivKey. It is the big-endian scalar of the key.
+type PrivKeySecp256r1 [32]byte
+
+// Bytes marshalls the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates an ECDSA signature on curve secp256r1, using SHA256 on the msg.
+// The signature is the concatenation of r and s, each of 32 bytes, with s in
+// the lower half of the order of the curve to prevent malleability.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(crypto.CReader(), privKey.ecdsa(), crypto.Sha256(msg))
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig, nil
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	priv := privKey.ecdsa()
+	var pubKey PubKeySecp256r1
+	copy(pubKey[:], elliptic.MarshalCompressed(curve, priv.X, priv.Y))
+	return pubKey
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySecp256r1) ecdsa() *ecdsa.PrivateKey {
+	priv := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	priv.Curve = curve
+	priv.X, priv.Y = curve.ScalarBaseMult(privKey[:])
+	return priv
+}
+
+// GenPrivKey generates a new ECDSA private key on curve secp256r1.
+// It uses OS randomness in conjunction with the current global random seed
+// in tendermint/libs/common to generate the private key.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	var privKey PrivKeySecp256r1
+	for {
+		if _, err := io.ReadFull(rand, privKey[:]); err != nil {
+			panic(err)
+		}
+		// the scalar must be in [1, n-1]
+		d := new(big.Int).SetBytes(privKey[:])
+		if d.Sign() > 0 && d.Cmp(curve.Params().N) < 0 {
+			return privKey
+		}
+	}
+}
+
+// GenPrivKeySecp256r1 hashes the secret with SHA2, and maps that 32 byte
+// output into [1, n-1] to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeySecp256r1(secret []byte) PrivKeySecp256r1 {
+	secretHash := sha256.Sum256(secret)
+	nMinusOne := new(big.Int).Sub(curve.Params().N, big.NewInt(1))
+	d := new(big.Int).SetBytes(secretHash[:])
+	d.Mod(d, nMinusOne).Add(d, big.NewInt(1))
+
+	var privKey PrivKeySecp256r1
+	d.FillBytes(privKey[:])
+	return privKey
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1Size is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySecp256r1Size = 33
+
+// PubKeySecp256r1 implements crypto.PubKey.
+// It is the compressed form of the pubkey, a 0x02 or 0x03 byte depending on
+// the parity of the y-coordinate, followed by the x-coordinate.
+type PubKeySecp256r1 [PubKeySecp256r1Size]byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes returns the pubkey marshalled with amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies a signature created by PrivKeySecp256r1.Sign, rejecting
+// signatures whose s isn't in canonical form.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey[:])
+	if x == nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, crypto.Sha256(msg), r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
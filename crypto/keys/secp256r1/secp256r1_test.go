@@ -0,0 +1,59 @@
+package secp256r1
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("message to sign")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes([]byte("other message"), sig))
+	require.False(t, GenPrivKey().PubKey().VerifyBytes(msg, sig))
+	require.False(t, pubKey.VerifyBytes(msg, sig[:63]))
+
+	// the malleated signature (r, n - s) is rejected
+	s := new(big.Int).SetBytes(sig[32:])
+	malleated := append([]byte{}, sig[:32]...)
+	malleated = append(malleated, new(big.Int).Sub(curve.Params().N, s).FillBytes(make([]byte, 32))...)
+	require.False(t, pubKey.VerifyBytes(msg, malleated))
+}
+
+func TestGenPrivKeySecp256r1(t *testing.T) {
+	secret := []byte("secret")
+	require.Equal(t, GenPrivKeySecp256r1(secret), GenPrivKeySecp256r1(secret))
+	require.NotEqual(t, GenPrivKeySecp256r1(secret), GenPrivKeySecp256r1([]byte("other")))
+
+	privKey := GenPrivKeySecp256r1(secret)
+	sig, err := privKey.Sign(secret)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifyBytes(secret, sig))
+}
+
+func TestAminoRoundTrip(t *testing.T) {
+	cdc := amino.NewCodec()
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterAmino(cdc)
+
+	privKey := GenPrivKey()
+	var decodedPriv crypto.PrivKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(privKey.Bytes(), &decodedPriv))
+	require.True(t, privKey.Equals(decodedPriv))
+
+	pubKey := privKey.PubKey()
+	var decodedPub crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(pubKey.Bytes(), &decodedPub))
+	require.True(t, pubKey.Equals(decodedPub))
+	require.Equal(t, pubKey.Address(), decodedPub.Address())
+}
@@ -0,0 +1,80 @@
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+)
+
+// Signer signs messages with the key of the given name. Besides the Keybase,
+// which signs with the keys it stores, a Signer may delegate to a remote
+// signing service, e.g. in front of an HSM or a KMS, so that the private key
+// never leaves it.
+type Signer interface {
+	Sign(name, passphrase string, msg []byte) ([]byte, tmcrypto.PubKey, error)
+}
+
+var _ Signer = Keybase(nil)
+
+// RemoteSignRequest is the body of the requests of a remote signer.
+type RemoteSignRequest struct {
+	KeyName string `json:"key_name"`
+	Msg     []byte `json:"msg"`
+}
+
+// RemoteSignResponse is the body of the responses of a remote signing service.
+type RemoteSignResponse struct {
+	Signature []byte          `json:"signature"`
+	PubKey    tmcrypto.PubKey `json:"pub_key"`
+}
+
+// remoteSigner requests signatures from a remote signing service over HTTP.
+type remoteSigner struct {
+	url    string
+	client *http.Client
+}
+
+// NewRemoteSigner returns a Signer which posts a RemoteSignRequest, encoded
+// with amino JSON, to the signing service at the given URL for each message to
+// sign, and reads the signature and the public key of the key from its
+// RemoteSignResponse. The passphrase isn't sent to the service.
+func NewRemoteSigner(url string) Signer {
+	return remoteSigner{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (rs remoteSigner) Sign(name, _ string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	body, err := cdc.MarshalJSON(RemoteSignRequest{KeyName: name, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := rs.client.Post(rs.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("remote signer %s: %s: %s", rs.url, resp.Status, body)
+	}
+
+	var res RemoteSignResponse
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return nil, nil, err
+	}
+	if res.PubKey == nil || !res.PubKey.VerifyBytes(msg, res.Signature) {
+		return nil, nil, fmt.Errorf("remote signer %s returned an invalid signature", rs.url)
+	}
+	return res.Signature, res.PubKey, nil
+}
@@ -0,0 +1,78 @@
+package keys
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// newTestSigningService returns a signing service signing with the given key,
+// whatever the name of the key but "unknown"
+func newTestSigningService(t *testing.T, priv secp256k1.PrivKeySecp256k1) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req RemoteSignRequest
+		require.NoError(t, cdc.UnmarshalJSON(body, &req))
+		if req.KeyName == "unknown" {
+			http.Error(w, "unknown key", http.StatusNotFound)
+			return
+		}
+
+		sig, err := priv.Sign(req.Msg)
+		require.NoError(t, err)
+		w.Write(cdc.MustMarshalJSON(RemoteSignResponse{Signature: sig, PubKey: priv.PubKey()}))
+	}))
+}
+
+func TestRemoteSigner(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	server := newTestSigningService(t, priv)
+	defer server.Close()
+
+	msg := []byte("message")
+	sig, pub, err := NewRemoteSigner(server.URL).Sign("remote", "", msg)
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	_, _, err = NewRemoteSigner(server.URL).Sign("unknown", "", msg)
+	require.Error(t, err)
+}
+
+func TestRemoteKey(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	server := newTestSigningService(t, priv)
+	defer server.Close()
+
+	cstore := New(dbm.NewMemDB())
+	info, err := cstore.CreateRemote("remote", priv.PubKey(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+	info, err = cstore.Get("remote")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+	require.Equal(t, priv.PubKey(), info.GetPubKey())
+
+	// the keybase signs with the remote key through the signing service
+	msg := []byte("message")
+	sig, pub, err := cstore.Sign("remote", "", msg)
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the signing service must sign with the referenced key
+	_, err = cstore.CreateRemote("other", secp256k1.GenPrivKey().PubKey(), server.URL)
+	require.NoError(t, err)
+	_, _, err = cstore.Sign("other", "", msg)
+	require.Error(t, err)
+
+	_, err = cstore.ExportPrivateKeyObject("remote", "")
+	require.Error(t, err)
+	require.NoError(t, cstore.Delete("remote", "", true))
+}
@@ -23,6 +23,9 @@ type Keybase interface {
 	// CreateMnemonic creates a new mnemonic, and derives a hierarchical deterministic
 	// key from that.
 	CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info, seed string, err error)
+	// NewMnemonic creates a new mnemonic of the given language and number of
+	// words (12 or 24), and derives a key from that and the BIP39 passphrase.
+	NewMnemonic(name string, language Language, words int, bip39Passwd, encryptPasswd string, algo SigningAlgo) (info Info, seed string, err error)
 	// CreateKey takes a mnemonic and derives, a password. This method is temporary
 	CreateKey(name, mnemonic, passwd string) (info Info, err error)
 	// CreateFundraiserKey takes a mnemonic and derives, a password
@@ -33,12 +36,23 @@ type Keybase interface {
 	// See https://github.com/cosmos/cosmos-sdk/issues/2095
 	Derive(name, mnemonic, bip39Passwd,
 		encryptPasswd string, params hd.BIP44Params) (Info, error)
+	// DeriveWithAlgo is like Derive, for a key of the given signing algorithm.
+	DeriveWithAlgo(name, mnemonic, bip39Passwd, encryptPasswd string,
+		params hd.BIP44Params, algo SigningAlgo) (Info, error)
+	// CreateAccount derives the key of the given account and address index,
+	// i.e. of the BIP44 path 44'/coin_type'/account'/0/index with the
+	// configured coin type.
+	CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account, index uint32) (Info, error)
 	// Create, store, and return a new Ledger key reference
 	CreateLedger(name string, path ccrypto.DerivationPath, algo SigningAlgo) (info Info, err error)
 
 	// Create, store, and return a new offline key reference
 	CreateOffline(name string, pubkey crypto.PubKey) (info Info, err error)
 
+	// CreateRemote stores a reference to a key held by the remote signing
+	// service at the given URL, which signs with it in place of the keybase.
+	CreateRemote(name string, pubkey crypto.PubKey, url string) (info Info, err error)
+
 	// The following operations will *only* work on locally-stored keys
 	Update(name, oldpass string, getNewpass func() (string, error)) error
 	Import(name string, armor string) (err error)
@@ -46,6 +60,13 @@ type Keybase interface {
 	Export(name string) (armor string, err error)
 	ExportPubKey(name string) (armor string, err error)
 
+	// ExportPrivKey returns the private key of a locally-stored key in ASCII
+	// armored format, encrypted with encryptPassphrase.
+	ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (armor string, err error)
+	// ImportPrivKey stores a local key from an ASCII armored private key
+	// encrypted with passphrase, which also encrypts it on disk.
+	ImportPrivKey(name, armor, passphrase string) error
+
 	// *only* works on locally-stored keys. Temporary method until we redo the exporting API
 	ExportPrivateKeyObject(name string, passphrase string) (crypto.PrivKey, error)
 
@@ -61,12 +82,14 @@ const (
 	TypeLocal   KeyType = 0
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
+	TypeRemote  KeyType = 3
 )
 
 var keyTypes = map[KeyType]string{
 	TypeLocal:   "local",
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
+	TypeRemote:  "remote",
 }
 
 // String implements the stringer interface for KeyType.
@@ -89,6 +112,7 @@ type Info interface {
 var _ Info = &localInfo{}
 var _ Info = &ledgerInfo{}
 var _ Info = &offlineInfo{}
+var _ Info = &remoteInfo{}
 
 // localInfo is the public information about a locally stored key
 type localInfo struct {
@@ -181,6 +205,38 @@ func (i offlineInfo) GetAddress() types.AccAddress {
 	return i.PubKey.Address().Bytes()
 }
 
+// remoteInfo is the public information about a key held by a remote signing
+// service
+type remoteInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+	URL    string        `json:"url"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey, url string) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+		URL:    url,
+	}
+}
+
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
 // encoding info
 func writeInfo(i Info) []byte {
 	return cdc.MustMarshalBinaryLengthPrefixed(i)
@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,13 @@ type (
 		SignSECP256K1([]uint32, []byte) ([]byte, error)
 	}
 
+	// LedgerShowAddressSECP256K1 reflects the interface a Ledger API
+	// implements if it can display the bech32 address of a SECP256K1 key,
+	// with the given prefix, on the device screen.
+	LedgerShowAddressSECP256K1 interface {
+		ShowAddressSECP256K1([]uint32, string) error
+	}
+
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
 	// cache the PubKey from the first call to use it later.
 	PrivKeyLedgerSecp256k1 struct {
@@ -70,6 +78,37 @@ func NewPrivKeyLedgerSecp256k1(path DerivationPath) (tmcrypto.PrivKey, error) {
 	return pkl, err
 }
 
+// LedgerShowAddress re-derives the public key at the given path on the Ledger
+// device, checks that it matches the expected address and displays the address
+// on the device screen, with the given bech32 prefix, so that the user can
+// compare it to the address displayed by the host. This protects against a
+// compromised host substituting its own address for the one of the device.
+func LedgerShowAddress(path DerivationPath, expectedAddr tmcrypto.Address, bech32Prefix string) error {
+	if discoverLedger == nil {
+		return errors.New("no Ledger discovery function defined")
+	}
+
+	device, err := discoverLedger()
+	if err != nil {
+		return errors.Wrap(err, "failed to show Ledger address")
+	}
+
+	pkl := &PrivKeyLedgerSecp256k1{Path: path, ledger: device}
+	pubKey, err := pkl.getPubKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pubKey.Address(), expectedAddr) {
+		return fmt.Errorf("the Ledger key at path %v doesn't match the address %s", path, expectedAddr)
+	}
+
+	displayer, ok := device.(LedgerShowAddressSECP256K1)
+	if !ok {
+		return errors.New("the Ledger API doesn't support displaying addresses on the device")
+	}
+	return displayer.ShowAddressSECP256K1(path, bech32Prefix)
+}
+
 // PubKey returns the cached public key.
 func (pkl PrivKeyLedgerSecp256k1) PubKey() tmcrypto.PubKey {
 	return pkl.CachedPubKey
@@ -5,8 +5,10 @@ import (
 	"os"
 	"testing"
 
+	secp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/encoding/amino"
+	tmsecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 var ledgerEnabledEnv = "TEST_WITH_LEDGER"
@@ -62,3 +64,51 @@ func TestRealLedgerErrorHandling(t *testing.T) {
 	_, err := NewPrivKeyLedgerSecp256k1(path)
 	require.Error(t, err)
 }
+
+// mockLedger is a Ledger API holding a single key, which records the
+// addresses it displays
+type mockLedger struct {
+	priv  *secp256k1.PrivateKey
+	shown []string
+}
+
+func (ml *mockLedger) GetPublicKeySECP256K1([]uint32) ([]byte, error) {
+	return ml.priv.PubKey().SerializeUncompressed(), nil
+}
+
+func (ml *mockLedger) SignSECP256K1([]uint32, []byte) ([]byte, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (ml *mockLedger) ShowAddressSECP256K1(path []uint32, hrp string) error {
+	ml.shown = append(ml.shown, fmt.Sprintf("%s:%v", hrp, path))
+	return nil
+}
+
+func TestLedgerShowAddress(t *testing.T) {
+	priv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	require.NoError(t, err)
+	device := &mockLedger{priv: priv}
+
+	defer func(discover discoverLedgerFn) { discoverLedger = discover }(discoverLedger)
+	discoverLedger = func() (LedgerSECP256K1, error) { return device, nil }
+
+	var pub tmsecp256k1.PubKeySecp256k1
+	copy(pub[:], priv.PubKey().SerializeCompressed())
+	path := DerivationPath{44, 118, 0, 0, 0}
+
+	// the address is displayed if it matches the key of the device
+	require.NoError(t, LedgerShowAddress(path, pub.Address(), "cosmos"))
+	require.Equal(t, []string{"cosmos:[44 118 0 0 0]"}, device.shown)
+
+	// another address is rejected without being displayed
+	other := tmsecp256k1.GenPrivKey().PubKey().Address()
+	require.Error(t, LedgerShowAddress(path, other, "cosmos"))
+	require.Len(t, device.shown, 1)
+
+	// a Ledger API which can't display addresses is reported
+	discoverLedger = func() (LedgerSECP256K1, error) {
+		return struct{ LedgerSECP256K1 }{device}, nil
+	}
+	require.Error(t, LedgerShowAddress(path, pub.Address(), "cosmos"))
+}
@@ -14,6 +14,21 @@ const (
 type BaseConfig struct {
 	// Tx minimum fee
 	MinFees string `mapstructure:"minimum_fees"`
+
+	// HaltHeight contains a non-zero block height at which a node will
+	// gracefully halt and shutdown that can be used to assist upgrades and
+	// testing.
+	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// HaltTime contains a non-zero minimum block time (in Unix seconds) at
+	// which a node will gracefully halt and shutdown that can be used to
+	// assist upgrades and testing.
+	HaltTime uint64 `mapstructure:"halt-time"`
+
+	// ModuleLogLevels contains the log levels of the modules of the
+	// application, as comma-separated module=level pairs, overriding the
+	// log level of Tendermint.
+	ModuleLogLevels string `mapstructure:"module-log-levels"`
 }
 
 // Config defines the server's top level configuration
@@ -15,6 +15,20 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 # Validators reject any tx from the mempool with less than the minimum fee per gas.
 minimum_fees = "{{ .BaseConfig.MinFees }}"
+
+# HaltHeight contains a non-zero block height at which a node will gracefully
+# halt and shutdown that can be used to assist upgrades and testing.
+halt-height = {{ .BaseConfig.HaltHeight }}
+
+# HaltTime contains a non-zero minimum block time (in Unix seconds) at which
+# a node will gracefully halt and shutdown that can be used to assist upgrades
+# and testing.
+halt-time = {{ .BaseConfig.HaltTime }}
+
+# ModuleLogLevels contains the log levels of the modules of the application, as
+# comma-separated module=level pairs overriding the log_level of Tendermint,
+# e.g. "x/staking=debug,x/bank=error". The levels are debug, info, error and none.
+module-log-levels = "{{ .BaseConfig.ModuleLogLevels }}"
 `
 
 var configTemplate *template.Template
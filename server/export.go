@@ -76,8 +76,8 @@ func ExportCmd(ctx *Context, cdc *codec.Codec, appExporter AppExporter) *cobra.C
 			return nil
 		},
 	}
-	cmd.Flags().Int64(flagHeight, -1, "Export state from a particular height (-1 means latest height)")
-	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().Int64(flagHeight, -1, "Export state from a particular height, which must not be pruned (-1 means latest height)")
+	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start a new chain at height zero, withdrawing the rewards, completing the unbondings and redelegations, and resetting the heights and missed blocks")
 	return cmd
 }
 
@@ -74,6 +74,18 @@ func (ms multiStore) LoadVersion(ver int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) LoadLatestVersionAndUpgrade(upgrades *sdk.StoreUpgrades) error {
+	panic("not implemented")
+}
+
+func (ms multiStore) CacheMultiStoreWithVersion(_ int64) (sdk.CacheMultiStore, error) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetStoreObserver(_ sdk.StoreObserver) {
+	panic("not implemented")
+}
+
 func (ms multiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
 	return ms.kv[key]
 }
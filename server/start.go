@@ -16,11 +16,18 @@ import (
 )
 
 const (
-	flagWithTendermint = "with-tendermint"
-	flagAddress        = "address"
-	flagTraceStore     = "trace-store"
-	flagPruning        = "pruning"
-	flagMinimumFees    = "minimum_fees"
+	flagWithTendermint  = "with-tendermint"
+	flagAddress         = "address"
+	flagTraceStore      = "trace-store"
+	flagPruning         = "pruning"
+	flagMinimumFees     = "minimum_fees"
+	flagHaltHeight      = "halt-height"
+	flagHaltTime        = "halt-time"
+	flagWriteAheadTrace = "write-ahead-trace"
+	flagGasProfile      = "gas-profile-blocks"
+	flagTxTrace         = "tx-trace"
+	flagStoreStats      = "store-stats"
+	flagModuleLogLevels = "module-log-levels"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -48,6 +55,13 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
 	cmd.Flags().String(flagMinimumFees, "", "Minimum fees validator will accept for transactions")
+	cmd.Flags().Uint64(flagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint64(flagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().String(flagWriteAheadTrace, "", "Append the KV mutations committed by each block to an output file, before committing it")
+	cmd.Flags().Int(flagGasProfile, 0, "Number of last blocks whose gas consumed by message type is queried under /custom/metrics/gas, disabled if 0")
+	cmd.Flags().String(flagTxTrace, "", "Append the timings and gas of the steps of the transactions and of the commits to an output file")
+	cmd.Flags().Bool(flagStoreStats, false, "Count the operations on the stores, queried under /custom/metrics/store")
+	cmd.Flags().String(flagModuleLogLevels, "", "Log levels of the modules overriding the log level, e.g. x/staking=debug,x/bank=error")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -2,10 +2,12 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
@@ -61,8 +63,12 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 		if err != nil {
 			return err
 		}
+		logLevel, err := withModuleLogLevels(config.LogLevel, viper.GetString(flagModuleLogLevels))
+		if err != nil {
+			return err
+		}
 		logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
-		logger, err = tmflags.ParseLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel())
+		logger, err = tmflags.ParseLogLevel(logLevel, logger, cfg.DefaultLogLevel())
 		if err != nil {
 			return err
 		}
@@ -76,6 +82,29 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 	}
 }
 
+// withModuleLogLevels returns the Tendermint log level with the log levels of
+// the modules of the application, given as comma-separated module=level pairs,
+// e.g. "x/staking=debug,x/bank=error". They set the level of the loggers of the
+// modules, i.e. Context.ModuleLogger, overriding the Tendermint log level.
+func withModuleLogLevels(logLevel, moduleLogLevels string) (string, error) {
+	if moduleLogLevels == "" {
+		return logLevel, nil
+	}
+
+	// prefix a simple one word level with "*" like Tendermint
+	if !strings.Contains(logLevel, ":") {
+		logLevel = "*:" + logLevel
+	}
+	for _, item := range strings.Split(moduleLogLevels, ",") {
+		moduleAndLevel := strings.Split(strings.TrimSpace(item), "=")
+		if len(moduleAndLevel) != 2 || moduleAndLevel[0] == "" {
+			return "", fmt.Errorf("expected module log levels in a form of \"module=level\" pairs, given pair %s", item)
+		}
+		logLevel += fmt.Sprintf(",%s:%s", moduleAndLevel[0], moduleAndLevel[1])
+	}
+	return logLevel, nil
+}
+
 // If a new config is created, change some of the default tendermint settings
 func interceptLoadConfig() (conf *cfg.Config, err error) {
 	tmpConf := cfg.DefaultConfig()
@@ -212,12 +241,13 @@ func TrapSignal(cleanupFunc func()) {
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		// os.Exit doesn't run the deferred calls, so clean up first
 		switch sig {
 		case syscall.SIGTERM:
-			defer cleanupFunc()
+			cleanupFunc()
 			os.Exit(128 + int(syscall.SIGTERM))
 		case syscall.SIGINT:
-			defer cleanupFunc()
+			cleanupFunc()
 			os.Exit(128 + int(syscall.SIGINT))
 		}
 	}()
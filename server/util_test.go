@@ -1,10 +1,14 @@
 package server
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	cfg "github.com/tendermint/tendermint/config"
+	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -39,3 +43,42 @@ func TestInsertKeyJSON(t *testing.T) {
 
 	require.Equal(t, bar, resBar, "appended: %v", appended)
 }
+
+func TestWithModuleLogLevels(t *testing.T) {
+	logLevel, err := withModuleLogLevels("info", "")
+	require.NoError(t, err)
+	require.Equal(t, "info", logLevel)
+
+	logLevel, err = withModuleLogLevels("info", "x/staking=debug, x/bank=error")
+	require.NoError(t, err)
+	require.Equal(t, "*:info,x/staking:debug,x/bank:error", logLevel)
+
+	logLevel, err = withModuleLogLevels("main:info,*:error", "x/gov=none")
+	require.NoError(t, err)
+	require.Equal(t, "main:info,*:error,x/gov:none", logLevel)
+
+	_, err = withModuleLogLevels("info", "x/staking")
+	require.Error(t, err)
+	_, err = withModuleLogLevels("info", "=debug")
+	require.Error(t, err)
+}
+
+func TestModuleLogLevelsFilter(t *testing.T) {
+	logLevel, err := withModuleLogLevels("info", "x/staking=debug,x/bank=error")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger, err := tmflags.ParseLogLevel(logLevel, log.NewTMLogger(&buf), cfg.DefaultLogLevel())
+	require.NoError(t, err)
+	logger = logger.With("module", "main")
+
+	logger.With("module", "x/staking").Debug("staking debug")
+	logger.With("module", "x/bank").Info("bank info")
+	logger.With("module", "x/gov").Info("gov info")
+	logger.With("module", "x/gov").Debug("gov debug")
+
+	require.Contains(t, buf.String(), "staking debug")
+	require.NotContains(t, buf.String(), "bank info")
+	require.Contains(t, buf.String(), "gov info")
+	require.NotContains(t, buf.String(), "gov debug")
+}
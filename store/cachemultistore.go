@@ -20,20 +20,34 @@ type cacheMultiStore struct {
 
 	traceWriter  io.Writer
 	traceContext TraceContext
+
+	// observer of the operations on the KVStores, may be nil
+	observer StoreObserver
 }
 
 var _ CacheMultiStore = cacheMultiStore{}
 
 func newCacheMultiStoreFromRMS(rms *rootMultiStore) cacheMultiStore {
+	stores := make(map[StoreKey]CacheWrapper, len(rms.stores))
+	for key, store := range rms.stores {
+		stores[key] = store
+	}
+	return newCacheMultiStoreFromStores(rms, stores)
+}
+
+// newCacheMultiStoreFromStores cache wraps the given stores of the
+// rootMultiStore, e.g. its stores at a previous version.
+func newCacheMultiStoreFromStores(rms *rootMultiStore, stores map[StoreKey]CacheWrapper) cacheMultiStore {
 	cms := cacheMultiStore{
 		db:           NewCacheKVStore(dbStoreAdapter{rms.db}),
-		stores:       make(map[StoreKey]CacheWrap, len(rms.stores)),
+		stores:       make(map[StoreKey]CacheWrap, len(stores)),
 		keysByName:   rms.keysByName,
 		traceWriter:  rms.traceWriter,
 		traceContext: rms.traceContext,
+		observer:     rms.observer,
 	}
 
-	for key, store := range rms.stores {
+	for key, store := range stores {
 		if cms.TracingEnabled() {
 			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
 		} else {
@@ -50,6 +64,7 @@ func newCacheMultiStoreFromCMS(cms cacheMultiStore) cacheMultiStore {
 		stores:       make(map[StoreKey]CacheWrap, len(cms.stores)),
 		traceWriter:  cms.traceWriter,
 		traceContext: cms.traceContext,
+		observer:     cms.observer,
 	}
 
 	for key, store := range cms.stores {
@@ -132,7 +147,11 @@ func (cms cacheMultiStore) GetStore(key StoreKey) Store {
 
 // Implements MultiStore.
 func (cms cacheMultiStore) GetKVStore(key StoreKey) KVStore {
-	return cms.stores[key].(KVStore)
+	store := cms.stores[key].(KVStore)
+	if cms.observer != nil {
+		store = NewInstrumentedKVStore(store, key.Name(), cms.observer)
+	}
+	return store
 }
 
 // Implements MultiStore.
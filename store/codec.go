@@ -24,8 +24,10 @@ type (
 	CommitID         = types.CommitID
 	StoreKey         = types.StoreKey
 	StoreType        = types.StoreType
+	StoreUpgrades    = types.StoreUpgrades
 	Queryable        = types.Queryable
 	TraceContext     = types.TraceContext
+	StoreObserver    = types.StoreObserver
 	Gas              = types.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = types.GasConfig
@@ -167,6 +167,83 @@ func (st *iavlStore) ReverseIterator(start, end []byte) Iterator {
 	return newIAVLIterator(st.tree.ImmutableTree, start, end, false)
 }
 
+// GetImmutable returns a read-only store of the tree at the given version. An
+// error is returned if the version isn't stored.
+func (st *iavlStore) GetImmutable(version int64) (*immutableIAVLStore, error) {
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return &immutableIAVLStore{tree}, nil
+}
+
+//----------------------------------------
+
+var _ KVStore = (*immutableIAVLStore)(nil)
+
+// immutableIAVLStore is a read-only KVStore of a version of an IAVL tree.
+type immutableIAVLStore struct {
+	tree *iavl.ImmutableTree
+}
+
+// Implements Store.
+func (st *immutableIAVLStore) GetStoreType() StoreType {
+	return sdk.StoreTypeIAVL
+}
+
+// Implements Store.
+func (st *immutableIAVLStore) CacheWrap() CacheWrap {
+	return NewCacheKVStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *immutableIAVLStore) CacheWrapWithTrace(w io.Writer, tc TraceContext) CacheWrap {
+	return NewCacheKVStore(NewTraceKVStore(st, w, tc))
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Set(key, value []byte) {
+	panic("cannot set a key of an immutable IAVL store")
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Get(key []byte) (value []byte) {
+	_, v := st.tree.Get(key)
+	return v
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Has(key []byte) (exists bool) {
+	return st.tree.Has(key)
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Delete(key []byte) {
+	panic("cannot delete a key of an immutable IAVL store")
+}
+
+// Implements KVStore
+func (st *immutableIAVLStore) Prefix(prefix []byte) KVStore {
+	return prefixStore{st, prefix}
+}
+
+// Implements KVStore
+func (st *immutableIAVLStore) Gas(meter GasMeter, config GasConfig) KVStore {
+	return NewGasKVStore(meter, config, st)
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Iterator(start, end []byte) Iterator {
+	return newIAVLIterator(st.tree, start, end, true)
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) ReverseIterator(start, end []byte) Iterator {
+	return newIAVLIterator(st.tree, start, end, false)
+}
+
+//----------------------------------------
+
 // Handle gatest the latest height, if height is 0
 func getHeight(tree *iavl.MutableTree, req abci.RequestQuery) int64 {
 	height := req.Height
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// instrumentedKVStore reports the duration of the operations on a KVStore to a
+// StoreObserver, delegating every call to the parent KVStore.
+type instrumentedKVStore struct {
+	KVStore
+	name     string
+	observer StoreObserver
+}
+
+// NewInstrumentedKVStore returns a KVStore reporting the operations on the
+// parent KVStore to the observer, under the given store name.
+func NewInstrumentedKVStore(parent KVStore, name string, observer StoreObserver) KVStore {
+	return instrumentedKVStore{KVStore: parent, name: name, observer: observer}
+}
+
+func (iks instrumentedKVStore) observe(op sdk.StoreOperation, start time.Time) {
+	iks.observer.ObserveStoreOperation(iks.name, op, time.Since(start))
+}
+
+// Get implements the KVStore interface.
+func (iks instrumentedKVStore) Get(key []byte) []byte {
+	defer iks.observe(sdk.StoreOperationRead, time.Now())
+	return iks.KVStore.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (iks instrumentedKVStore) Has(key []byte) bool {
+	defer iks.observe(sdk.StoreOperationRead, time.Now())
+	return iks.KVStore.Has(key)
+}
+
+// Set implements the KVStore interface.
+func (iks instrumentedKVStore) Set(key, value []byte) {
+	defer iks.observe(sdk.StoreOperationWrite, time.Now())
+	iks.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (iks instrumentedKVStore) Delete(key []byte) {
+	defer iks.observe(sdk.StoreOperationWrite, time.Now())
+	iks.KVStore.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (iks instrumentedKVStore) Iterator(start, end []byte) Iterator {
+	defer iks.observe(sdk.StoreOperationIterate, time.Now())
+	return iks.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (iks instrumentedKVStore) ReverseIterator(start, end []byte) Iterator {
+	defer iks.observe(sdk.StoreOperationIterate, time.Now())
+	return iks.KVStore.ReverseIterator(start, end)
+}
+
+// Prefix implements the KVStore interface.
+func (iks instrumentedKVStore) Prefix(prefix []byte) KVStore {
+	return prefixStore{iks, prefix}
+}
+
+// Gas implements the KVStore interface.
+func (iks instrumentedKVStore) Gas(meter GasMeter, config GasConfig) KVStore {
+	return NewGasKVStore(meter, config, iks)
+}
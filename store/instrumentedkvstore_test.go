@@ -0,0 +1,84 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type observedOperation struct {
+	store string
+	op    sdk.StoreOperation
+}
+
+type testStoreObserver struct {
+	ops []observedOperation
+}
+
+func (o *testStoreObserver) ObserveStoreOperation(storeName string, op sdk.StoreOperation, _ time.Duration) {
+	o.ops = append(o.ops, observedOperation{storeName, op})
+}
+
+func TestInstrumentedKVStore(t *testing.T) {
+	observer := &testStoreObserver{}
+	store := NewInstrumentedKVStore(NewCacheKVStore(dbStoreAdapter{dbm.NewMemDB()}), "store1", observer)
+
+	store.Set(keyFmt(1), valFmt(1))
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+	require.True(t, store.Has(keyFmt(1)))
+	store.Delete(keyFmt(1))
+	store.Iterator(nil, nil).Close()
+	store.ReverseIterator(nil, nil).Close()
+
+	// the prefixed and gas consuming stores are observed
+	store.Prefix([]byte("prefix")).Set(keyFmt(2), valFmt(2))
+	store.Gas(sdk.NewInfiniteGasMeter(), sdk.KVGasConfig()).Get(keyFmt(2))
+
+	expected := []observedOperation{
+		{"store1", sdk.StoreOperationWrite},
+		{"store1", sdk.StoreOperationRead},
+		{"store1", sdk.StoreOperationRead},
+		{"store1", sdk.StoreOperationWrite},
+		{"store1", sdk.StoreOperationIterate},
+		{"store1", sdk.StoreOperationIterate},
+		{"store1", sdk.StoreOperationWrite},
+		{"store1", sdk.StoreOperationRead},
+	}
+	require.Equal(t, expected, observer.ops)
+}
+
+func TestMultiStoreObserver(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.NoError(t, store.LoadLatestVersion())
+	key1, key2 := store.keysByName["store1"], store.keysByName["store2"]
+
+	// the stores are not observed without observer
+	store.GetKVStore(key1).Set(keyFmt(1), valFmt(1))
+
+	observer := &testStoreObserver{}
+	store.SetStoreObserver(observer)
+	store.GetKVStore(key1).Get(keyFmt(1))
+
+	// the cache wraps of the multistore are observed
+	cms := store.CacheMultiStore()
+	cms.GetKVStore(key2).Set(keyFmt(2), valFmt(2))
+	cms.CacheMultiStore().GetKVStore(key1).Has(keyFmt(1))
+
+	// the write of the cache is not an operation on the observed stores
+	cms.Write()
+
+	expected := []observedOperation{
+		{"store1", sdk.StoreOperationRead},
+		{"store2", sdk.StoreOperationWrite},
+		{"store1", sdk.StoreOperationRead},
+	}
+	require.Equal(t, expected, observer.ops)
+
+	store.SetStoreObserver(nil)
+	store.GetKVStore(key1).Get(keyFmt(1))
+	require.Len(t, observer.ops, 3)
+}
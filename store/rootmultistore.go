@@ -29,8 +29,15 @@ type rootMultiStore struct {
 	stores       map[StoreKey]CommitStore
 	keysByName   map[string]StoreKey
 
+	// stores deleted by the upgrade of the loaded version, whose data is
+	// removed once the upgraded version is committed
+	deletedStores []string
+
 	traceWriter  io.Writer
 	traceContext TraceContext
+
+	// observer of the operations on the KVStores, may be nil
+	observer StoreObserver
 }
 
 var _ CommitMultiStore = (*rootMultiStore)(nil)
@@ -94,8 +101,18 @@ func (rs *rootMultiStore) LoadLatestVersion() error {
 	return rs.LoadVersion(ver)
 }
 
+// Implements CommitMultiStore.
+func (rs *rootMultiStore) LoadLatestVersionAndUpgrade(upgrades *StoreUpgrades) error {
+	ver := getLatestVersion(rs.db)
+	return rs.loadVersion(ver, upgrades)
+}
+
 // Implements CommitMultiStore.
 func (rs *rootMultiStore) LoadVersion(ver int64) error {
+	return rs.loadVersion(ver, nil)
+}
+
+func (rs *rootMultiStore) loadVersion(ver int64, upgrades *StoreUpgrades) error {
 
 	// Special logic for version 0
 	if ver == 0 {
@@ -109,6 +126,7 @@ func (rs *rootMultiStore) LoadVersion(ver int64) error {
 		}
 
 		rs.lastCommitID = CommitID{}
+		rs.deletedStores = nil
 		return nil
 	}
 	// Otherwise, version is 1 or greater
@@ -119,18 +137,30 @@ func (rs *rootMultiStore) LoadVersion(ver int64) error {
 		return err
 	}
 
-	// Convert StoreInfos slice to map
+	// Convert StoreInfos slice to map, skipping the deleted stores
 	infos := make(map[StoreKey]storeInfo)
+	var deleted []string
 	for _, storeInfo := range cInfo.StoreInfos {
+		if upgrades.IsDeleted(storeInfo.Name) {
+			deleted = append(deleted, storeInfo.Name)
+			continue
+		}
 		infos[rs.nameToKey(storeInfo.Name)] = storeInfo
 	}
 
 	// Load each Store
 	var newStores = make(map[StoreKey]CommitStore)
 	for key, storeParams := range rs.storesParams {
+		if upgrades.IsDeleted(key.Name()) {
+			return fmt.Errorf("failed to load rootMultiStore: deleted store %s is mounted", key.Name())
+		}
+
 		var id CommitID
 		info, ok := infos[key]
 		if ok {
+			if upgrades.IsAdded(key.Name()) {
+				return fmt.Errorf("failed to load rootMultiStore: added store %s already exists", key.Name())
+			}
 			id = info.Core.CommitID
 		}
 
@@ -141,9 +171,12 @@ func (rs *rootMultiStore) LoadVersion(ver int64) error {
 		newStores[key] = store
 	}
 
-	// Success.
+	// Success. The data of the deleted stores is kept until the upgraded
+	// version is committed, so that the loaded version stays intact if the
+	// upgrade is aborted.
 	rs.lastCommitID = cInfo.CommitID()
 	rs.stores = newStores
+	rs.deletedStores = deleted
 	return nil
 }
 
@@ -175,6 +208,11 @@ func (rs *rootMultiStore) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// SetStoreObserver implements the CommitMultiStore interface.
+func (rs *rootMultiStore) SetStoreObserver(observer StoreObserver) {
+	rs.observer = observer
+}
+
 // ResetTraceContext resets the current tracing context.
 func (rs *rootMultiStore) ResetTraceContext() MultiStore {
 	rs.traceContext = nil
@@ -184,8 +222,12 @@ func (rs *rootMultiStore) ResetTraceContext() MultiStore {
 //----------------------------------------
 // +CommitStore
 
-// Implements Committer/CommitStore.
+// Implements Committer/CommitStore. Before any version is loaded, it returns
+// the latest persisted version, so that store loaders can depend on it.
 func (rs *rootMultiStore) LastCommitID() CommitID {
+	if len(rs.stores) == 0 {
+		return CommitID{Version: getLatestVersion(rs.db)}
+	}
 	return rs.lastCommitID
 }
 
@@ -202,6 +244,12 @@ func (rs *rootMultiStore) Commit() CommitID {
 	setLatestVersion(batch, version)
 	batch.Write()
 
+	// Remove the data of the deleted stores, which are no longer committed
+	for _, name := range rs.deletedStores {
+		deleteStoreData(rs.db, name)
+	}
+	rs.deletedStores = nil
+
 	// Prepare for next version.
 	commitID := CommitID{
 		Version: version,
@@ -229,6 +277,25 @@ func (rs *rootMultiStore) CacheMultiStore() CacheMultiStore {
 	return newCacheMultiStoreFromRMS(rs)
 }
 
+// CacheMultiStoreWithVersion implements CommitMultiStore. The IAVL stores are
+// read at the given version, and are read-only.
+func (rs *rootMultiStore) CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error) {
+	stores := make(map[StoreKey]CacheWrapper, len(rs.stores))
+	for key, store := range rs.stores {
+		iavl, ok := store.(*iavlStore)
+		if !ok {
+			stores[key] = store
+			continue
+		}
+		immutable, err := iavl.GetImmutable(version)
+		if err != nil {
+			return nil, err
+		}
+		stores[key] = immutable
+	}
+	return newCacheMultiStoreFromStores(rs, stores), nil
+}
+
 // Implements MultiStore.
 // If the store does not exist, panics.
 func (rs *rootMultiStore) GetStore(key StoreKey) Store {
@@ -241,7 +308,8 @@ func (rs *rootMultiStore) GetStore(key StoreKey) Store {
 
 // GetKVStore implements the MultiStore interface. If tracing is enabled on the
 // rootMultiStore, a wrapped TraceKVStore will be returned with the given
-// tracer, otherwise, the original KVStore will be returned.
+// tracer, otherwise, the original KVStore will be returned. The returned
+// KVStore reports its operations to the store observer, if any.
 // If the store does not exist, panics.
 func (rs *rootMultiStore) GetKVStore(key StoreKey) KVStore {
 	store := rs.stores[key].(KVStore)
@@ -249,6 +317,9 @@ func (rs *rootMultiStore) GetKVStore(key StoreKey) KVStore {
 	if rs.TracingEnabled() {
 		store = NewTraceKVStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.observer != nil {
+		store = NewInstrumentedKVStore(store, key.Name(), rs.observer)
+	}
 
 	return store
 }
@@ -458,6 +529,24 @@ func (si storeInfo) Hash() []byte {
 //----------------------------------------
 // Misc.
 
+// deleteStoreData removes the data of the named store from the db
+func deleteStoreData(db dbm.DB, name string) {
+	storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+name+"/"))
+
+	var keys [][]byte
+	iter := storeDB.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	batch := storeDB.NewBatch()
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+	batch.WriteSync()
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	var latest int64
 	latestBytes := db.Get([]byte(latestVersionKey))
@@ -99,6 +99,65 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreLoadWithUpgrade(t *testing.T) {
+	db := dbm.NewMemDB()
+	k, v := []byte("key"), []byte("value")
+
+	store := NewCommitMultiStore(db)
+	store.MountStoreWithDB(sdk.NewKVStoreKey("store1"), sdk.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(sdk.NewKVStoreKey("store2"), sdk.StoreTypeIAVL, nil)
+	require.Nil(t, store.LoadLatestVersion())
+	store.getStoreByName("store1").(KVStore).Set(k, v)
+	store.getStoreByName("store2").(KVStore).Set(k, v)
+	store.Commit()
+
+	// the upgraded application deletes store2 and adds store3
+	upgrades := &StoreUpgrades{Added: []string{"store3"}, Deleted: []string{"store2"}}
+	newUpgradedStore := func() *rootMultiStore {
+		store := NewCommitMultiStore(db)
+		store.MountStoreWithDB(sdk.NewKVStoreKey("store1"), sdk.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(sdk.NewKVStoreKey("store3"), sdk.StoreTypeIAVL, nil)
+		return store
+	}
+
+	// the last commit is known before loading
+	store = newUpgradedStore()
+	require.Equal(t, int64(1), store.LastCommitID().Version)
+
+	// deleted stores cannot be mounted
+	invalid := newUpgradedStore()
+	invalid.MountStoreWithDB(sdk.NewKVStoreKey("store2"), sdk.StoreTypeIAVL, nil)
+	require.NotNil(t, invalid.LoadLatestVersionAndUpgrade(upgrades))
+
+	// added stores must not exist
+	invalid = newUpgradedStore()
+	require.NotNil(t, invalid.LoadLatestVersionAndUpgrade(&StoreUpgrades{Added: []string{"store1"}, Deleted: []string{"store2"}}))
+
+	require.Nil(t, store.LoadLatestVersionAndUpgrade(upgrades))
+
+	// the data of the deleted store is kept until the upgrade is committed
+	iter := dbm.NewPrefixDB(db, []byte("s/k:store2/")).Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	iter.Close()
+
+	require.Equal(t, v, store.getStoreByName("store1").(KVStore).Get(k))
+	require.Nil(t, store.getStoreByName("store2"))
+	require.Nil(t, store.getStoreByName("store3").(KVStore).Get(k))
+	store.getStoreByName("store3").(KVStore).Set(k, v)
+	commitID := store.Commit()
+
+	// the data of the deleted store is removed
+	iter = dbm.NewPrefixDB(db, []byte("s/k:store2/")).Iterator(nil, nil)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	// the upgraded stores are then loaded as usual
+	store = newUpgradedStore()
+	require.Nil(t, store.LoadLatestVersion())
+	require.Equal(t, commitID, store.LastCommitID())
+	require.Equal(t, v, store.getStoreByName("store3").(KVStore).Get(k))
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -190,6 +249,36 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	key := multi.keysByName["store1"]
+	k, v, v2 := []byte("wind"), []byte("blows"), []byte("howls")
+
+	multi.GetKVStore(key).Set(k, v)
+	cid := multi.Commit()
+	multi.GetKVStore(key).Set(k, v2)
+	multi.Commit()
+
+	// the previous version is read
+	cms, err := multi.CacheMultiStoreWithVersion(cid.Version)
+	require.Nil(t, err)
+	store := cms.GetKVStore(key)
+	require.Equal(t, v, store.Get(k))
+
+	// writes stay in the cache
+	store.Set(k, []byte("freezes"))
+	require.Equal(t, v2, multi.GetKVStore(key).Get(k))
+	require.Panics(t, func() { cms.Write() })
+
+	// unknown versions aren't read
+	_, err = multi.CacheMultiStoreWithVersion(cid.Version + 5)
+	require.NotNil(t, err)
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// state sizes, in number of keys, of the benchmarked stores
+var benchStateSizes = []int{100, 1000, 10000}
+
+// benchStores are the store stacks benchmarked, from the IAVL store alone to
+// the gas store wrapping a cache-wrapped IAVL store as used by the handlers.
+var benchStores = []struct {
+	name string
+	wrap func(KVStore) KVStore
+}{
+	{"iavl", func(st KVStore) KVStore { return st }},
+	{"cache-iavl", func(st KVStore) KVStore { return NewCacheKVStore(st) }},
+	{"gas-iavl", func(st KVStore) KVStore {
+		return NewGasKVStore(sdk.NewInfiniteGasMeter(), sdk.KVGasConfig(), st)
+	}},
+	{"gas-cache-iavl", func(st KVStore) KVStore {
+		return NewGasKVStore(sdk.NewInfiniteGasMeter(), sdk.KVGasConfig(), NewCacheKVStore(st))
+	}},
+}
+
+func benchKey(i int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(i))
+	return key
+}
+
+var benchValue = make([]byte, 32)
+
+// newBenchIAVLStore returns an IAVL store of a committed tree of the given
+// number of keys.
+func newBenchIAVLStore(b *testing.B, size int) KVStore {
+	tree := iavl.NewMutableTree(dbm.NewMemDB(), cacheSize)
+	for i := 0; i < size; i++ {
+		tree.Set(benchKey(i), benchValue)
+	}
+	if _, _, err := tree.SaveVersion(); err != nil {
+		b.Fatal(err)
+	}
+	return newIAVLStore(tree, numRecent, storeEvery)
+}
+
+// runStoreBenchmarks runs the given benchmark on each store stack for each
+// state size.
+func runStoreBenchmarks(b *testing.B, bench func(b *testing.B, st KVStore, size int)) {
+	for _, stack := range benchStores {
+		for _, size := range benchStateSizes {
+			b.Run(fmt.Sprintf("%s/%d", stack.name, size), func(b *testing.B) {
+				st := stack.wrap(newBenchIAVLStore(b, size))
+				b.ReportAllocs()
+				b.ResetTimer()
+				bench(b, st, size)
+			})
+		}
+	}
+}
+
+func BenchmarkStoreGet(b *testing.B) {
+	runStoreBenchmarks(b, func(b *testing.B, st KVStore, size int) {
+		for i := 0; i < b.N; i++ {
+			st.Get(benchKey(i % size))
+		}
+	})
+}
+
+func BenchmarkStoreGetNoKeyFound(b *testing.B) {
+	runStoreBenchmarks(b, func(b *testing.B, st KVStore, size int) {
+		for i := 0; i < b.N; i++ {
+			st.Get(benchKey(size + i))
+		}
+	})
+}
+
+func BenchmarkStoreSet(b *testing.B) {
+	runStoreBenchmarks(b, func(b *testing.B, st KVStore, size int) {
+		for i := 0; i < b.N; i++ {
+			st.Set(benchKey(i%(2*size)), benchValue)
+		}
+	})
+}
+
+func BenchmarkStoreIterate(b *testing.B) {
+	runStoreBenchmarks(b, func(b *testing.B, st KVStore, size int) {
+		for i := 0; i < b.N; i++ {
+			iter := st.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				_ = iter.Value()
+			}
+			iter.Close()
+		}
+	})
+}
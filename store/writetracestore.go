@@ -0,0 +1,83 @@
+package store
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// writeTraceKVStore traces the writes and deletes of a KVStore, delegating
+// every call to the parent KVStore.
+type writeTraceKVStore struct {
+	KVStore
+	writer  io.Writer
+	context TraceContext
+}
+
+// Set implements the KVStore interface. It traces a write operation.
+func (wts writeTraceKVStore) Set(key []byte, value []byte) {
+	writeOperation(wts.writer, writeOp, wts.context, key, value)
+	wts.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It traces a delete operation.
+func (wts writeTraceKVStore) Delete(key []byte) {
+	writeOperation(wts.writer, deleteOp, wts.context, key, nil)
+	wts.KVStore.Delete(key)
+}
+
+// writeTraceMultiStore is a cacheMultiStore whose stores trace the mutations
+// they flush to their parent, writing them in the order of the store names.
+type writeTraceMultiStore struct {
+	cacheMultiStore
+	names []string
+}
+
+// NewWriteTraceMultiStore returns a cache of a cache-wrapped multistore whose
+// Write traces the writes and deletes it flushes to the persisted stores of the
+// parent, with the name of their store as metadata. The stores are written in
+// the order of their names, so that the trace is deterministic. Caches of the
+// returned multistore do not trace.
+func NewWriteTraceMultiStore(parent CacheMultiStore, w io.Writer) CacheMultiStore {
+	cms, ok := parent.(cacheMultiStore)
+	if !ok {
+		panic(fmt.Sprintf("cannot trace the writes to a %T", parent))
+	}
+
+	wtms := writeTraceMultiStore{
+		cacheMultiStore: cacheMultiStore{
+			db:         NewCacheKVStore(cms.db),
+			stores:     make(map[StoreKey]CacheWrap, len(cms.stores)),
+			keysByName: make(map[string]StoreKey, len(cms.stores)),
+			observer:   cms.observer,
+		},
+	}
+
+	for key, store := range cms.stores {
+		kvStore := store.(KVStore)
+		if _, ok := key.(*sdk.TransientStoreKey); !ok {
+			kvStore = writeTraceKVStore{
+				KVStore: kvStore,
+				writer:  w,
+				context: TraceContext{"store": key.Name()},
+			}
+		}
+		wtms.stores[key] = NewCacheKVStore(kvStore)
+		wtms.keysByName[key.Name()] = key
+		wtms.names = append(wtms.names, key.Name())
+	}
+	sort.Strings(wtms.names)
+
+	return wtms
+}
+
+// Write implements the CacheMultiStore interface, writing the stores in the
+// order of their names.
+func (wtms writeTraceMultiStore) Write() {
+	wtms.db.Write()
+	for _, name := range wtms.names {
+		wtms.stores[wtms.keysByName[name]].Write()
+	}
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWriteTraceMultiStore(t *testing.T) {
+	key1, key2 := sdk.NewKVStoreKey("store1"), sdk.NewKVStoreKey("store2")
+	tkey := sdk.NewTransientStoreKey("transient")
+
+	ms := NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key2, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(key1, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetKVStore(key1).Set([]byte("deleted"), []byte("value"))
+
+	var buf bytes.Buffer
+	cms := ms.CacheMultiStore()
+	wtms := NewWriteTraceMultiStore(cms, &buf)
+	wtms.GetKVStore(key2).Set([]byte("key2"), []byte("value2"))
+	wtms.GetKVStore(key1).Set([]byte("key1"), []byte("value1"))
+	wtms.GetKVStore(key1).Delete([]byte("deleted"))
+	wtms.GetKVStore(tkey).Set([]byte("transient"), []byte("value"))
+
+	// nothing is traced before the cache is written, nor by its caches
+	wtms.CacheMultiStore().GetKVStore(key1).Set([]byte("key3"), []byte("value3"))
+	require.Zero(t, buf.Len())
+
+	// the mutations are written in the order of the store names, without the
+	// reads and the transient stores
+	wtms.Write()
+	expectedOut := "{\"operation\":\"delete\",\"key\":\"ZGVsZXRlZA==\",\"value\":\"\",\"metadata\":{\"store\":\"store1\"}}\n" +
+		"{\"operation\":\"write\",\"key\":\"a2V5MQ==\",\"value\":\"dmFsdWUx\",\"metadata\":{\"store\":\"store1\"}}\n" +
+		"{\"operation\":\"write\",\"key\":\"a2V5Mg==\",\"value\":\"dmFsdWUy\",\"metadata\":{\"store\":\"store2\"}}\n"
+	require.Equal(t, expectedOut, buf.String())
+
+	// the mutations are written to the parent
+	require.Equal(t, []byte("value1"), cms.GetKVStore(key1).Get([]byte("key1")))
+	require.Nil(t, cms.GetKVStore(key1).Get([]byte("deleted")))
+	require.Equal(t, []byte("value"), cms.GetKVStore(tkey).Get([]byte("transient")))
+
+	// only the caches of multistores can be traced
+	require.Panics(t, func() { NewWriteTraceMultiStore(nil, &buf) })
+}
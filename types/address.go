@@ -11,12 +11,17 @@ import (
 	"github.com/tendermint/tendermint/crypto/encoding/amino"
 
 	"github.com/tendermint/tendermint/libs/bech32"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 const (
 	// AddrLen defines a valid address length
 	AddrLen = 20
 
+	// CoinType is the BIP44 coin type of the keys, as registered in SLIP-0044
+	CoinType = 118
+
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32PrefixAccAddr = "cosmos"
 	// Bech32PrefixAccPub defines the Bech32 prefix of an account's public key
@@ -31,9 +36,11 @@ const (
 	Bech32PrefixConsPub = "cosmosvalconspub"
 )
 
-// Address is a common interface for different types of addresses used by the SDK
+// Address is a common interface for different types of addresses used by the SDK.
+// It has no Equals method: each address type only equals addresses of the same
+// type, so that comparing e.g. an account address with a validator address
+// fails to compile, and must be done explicitly with the conversion functions.
 type Address interface {
-	Equals(Address) bool
 	Empty() bool
 	Marshal() ([]byte, error)
 	MarshalJSON() ([]byte, error)
@@ -69,6 +76,12 @@ func AccAddressFromHex(address string) (addr AccAddress, err error) {
 	return AccAddress(bz), nil
 }
 
+// ModuleAddress returns the address of the account of a module, which no
+// private key controls. Modules sign the messages they dispatch with it.
+func ModuleAddress(moduleName string) AccAddress {
+	return AccAddress(crypto.AddressHash([]byte(moduleName)))
+}
+
 // AccAddressFromBech32 creates an AccAddress from a Bech32 string.
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
@@ -81,7 +94,7 @@ func AccAddressFromBech32(address string) (addr AccAddress, err error) {
 }
 
 // Returns boolean for whether two AccAddresses are Equal
-func (aa AccAddress) Equals(aa2 Address) bool {
+func (aa AccAddress) Equals(aa2 AccAddress) bool {
 	if aa.Empty() && aa2.Empty() {
 		return true
 	}
@@ -196,8 +209,20 @@ func ValAddressFromBech32(address string) (addr ValAddress, err error) {
 	return ValAddress(bz), nil
 }
 
+// ValAddressFromAccAddress returns the operator address of the validator
+// created by the given account, which has the same bytes.
+func ValAddressFromAccAddress(addr AccAddress) ValAddress {
+	return ValAddress(addr)
+}
+
+// AccAddressFromValAddress returns the address of the account operating the
+// given validator, which has the same bytes.
+func AccAddressFromValAddress(addr ValAddress) AccAddress {
+	return AccAddress(addr)
+}
+
 // Returns boolean for whether two ValAddresses are Equal
-func (va ValAddress) Equals(va2 Address) bool {
+func (va ValAddress) Equals(va2 ValAddress) bool {
 	if va.Empty() && va2.Empty() {
 		return true
 	}
@@ -319,7 +344,7 @@ func GetConsAddress(pubkey crypto.PubKey) ConsAddress {
 }
 
 // Returns boolean for whether two ConsAddress are Equal
-func (ca ConsAddress) Equals(ca2 Address) bool {
+func (ca ConsAddress) Equals(ca2 ConsAddress) bool {
 	if ca.Empty() && ca2.Empty() {
 		return true
 	}
@@ -467,8 +492,7 @@ func GetAccPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
 		return nil, err
 	}
 
-	pk, err = cryptoAmino.PubKeyFromBytes(bz)
-	if err != nil {
+	if err = codec.Cdc.UnmarshalBinaryBare(bz, &pk); err != nil {
 		return nil, err
 	}
 
@@ -495,8 +519,7 @@ func GetValPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
 		return nil, err
 	}
 
-	pk, err = cryptoAmino.PubKeyFromBytes(bz)
-	if err != nil {
+	if err = codec.Cdc.UnmarshalBinaryBare(bz, &pk); err != nil {
 		return nil, err
 	}
 
@@ -554,8 +577,33 @@ func GetFromBech32(bech32str, prefix string) ([]byte, error) {
 	}
 
 	if hrp != prefix {
+		if kind := bech32PrefixKind(hrp); kind != "" {
+			return nil, fmt.Errorf("invalid Bech32 prefix; expected %s, got %s of a %s", prefix, hrp, kind)
+		}
 		return nil, fmt.Errorf("invalid Bech32 prefix; expected %s, got %s", prefix, hrp)
 	}
 
 	return bz, nil
 }
+
+// bech32PrefixKind returns the kind of addresses or public keys of the given
+// Bech32 prefix, or "" if it is not one of the configured prefixes.
+func bech32PrefixKind(prefix string) string {
+	config := GetConfig()
+	switch prefix {
+	case config.GetBech32AccountAddrPrefix():
+		return "account address"
+	case config.GetBech32ValidatorAddrPrefix():
+		return "validator operator address"
+	case config.GetBech32ConsensusAddrPrefix():
+		return "consensus node address"
+	case config.GetBech32AccountPubPrefix():
+		return "account public key"
+	case config.GetBech32ValidatorPubPrefix():
+		return "validator operator public key"
+	case config.GetBech32ConsensusPubPrefix():
+		return "consensus node public key"
+	default:
+		return ""
+	}
+}
@@ -249,3 +249,43 @@ func TestAddressInterface(t *testing.T) {
 	}
 
 }
+
+func TestAddressConversion(t *testing.T) {
+	var pub ed25519.PubKeyEd25519
+	rand.Read(pub[:])
+
+	accAddr := types.AccAddress(pub.Address())
+	valAddr := types.ValAddressFromAccAddress(accAddr)
+	require.Equal(t, accAddr.Bytes(), valAddr.Bytes())
+	require.True(t, valAddr.Equals(types.ValAddress(pub.Address())))
+	require.True(t, accAddr.Equals(types.AccAddressFromValAddress(valAddr)))
+	require.NotEqual(t, accAddr.String(), valAddr.String())
+}
+
+func TestUnmarshalJSONWrongAddressType(t *testing.T) {
+	var pub ed25519.PubKeyEd25519
+	rand.Read(pub[:])
+
+	valAddr := types.ValAddress(pub.Address())
+	bz, err := valAddr.MarshalJSON()
+	require.Nil(t, err)
+
+	var accAddr types.AccAddress
+	err = accAddr.UnmarshalJSON(bz)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "validator operator address")
+	require.Nil(t, accAddr)
+
+	var consAddr types.ConsAddress
+	err = consAddr.UnmarshalJSON(bz)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "validator operator address")
+
+	var valAddr2 types.ValAddress
+	require.Nil(t, valAddr2.UnmarshalJSON(bz))
+	require.True(t, valAddr.Equals(valAddr2))
+
+	_, err = types.GetAccPubKeyBech32(types.MustBech32ifyConsPub(pub))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "consensus node public key")
+}
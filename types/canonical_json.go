@@ -0,0 +1,196 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// CanonicalizeJSON returns the canonical form of the given JSON, as signed in
+// the sign bytes of the transactions, e.g. by the ledger integration. Signers
+// written in other languages must produce the same bytes, which
+// CanonicalJSONTestVectors helps verify. The canonical form:
+//
+//   - has no white space between the tokens;
+//   - sorts the keys of the objects by the bytes of their UTF-8 encoding, the
+//     last value of a duplicate key being kept, and keeps the order of arrays;
+//   - writes the numbers as IEEE 754 doubles in the shortest form which parses
+//     back to the same double, as JavaScript does: without exponent if
+//     1e-6 <= |n| < 1e21 or n is 0, else as e.g. 1e+21 or 1.5e-7, so without
+//     trailing zeros after the decimal point. Integers beyond 2^53 lose
+//     precision, which is why amino encodes 64-bit integers as strings;
+//   - escapes in the strings and keys '"' and '\' as \" and \\, the control
+//     characters \n, \r and \t as such, the other control characters, including
+//     \b and \f, '<', '>' and '&' as \u00XX with lowercase hex digits, and
+//     U+2028 and U+2029 as \u2028 and \u2029, as encoding/json of Go 1.11
+//     does. The other characters, including non-ASCII ones, are written as is,
+//     invalid UTF-8 and lone surrogates being replaced by U+FFFD.
+//
+// It returns an error if the given JSON is invalid.
+func CanonicalizeJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid JSON: data after the top-level value")
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonicalJSON(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		return writeCanonicalNumber(buf, v)
+	case string:
+		writeCanonicalString(buf, v)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, elem := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, key)
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value %T", value)
+	}
+	return nil
+}
+
+func writeCanonicalNumber(buf *bytes.Buffer, n json.Number) error {
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil {
+		return fmt.Errorf("invalid JSON number %s: %v", n, err)
+	}
+
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b := strconv.AppendFloat(nil, f, format, -1, 64)
+	if format == 'e' {
+		// e-07 is written e-7
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	buf.Write(b)
+	return nil
+}
+
+const lowerHex = "0123456789abcdef"
+
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			switch {
+			case b == '"' || b == '\\':
+				buf.WriteByte('\\')
+				buf.WriteByte(b)
+			case b == '\n':
+				buf.WriteString(`\n`)
+			case b == '\r':
+				buf.WriteString(`\r`)
+			case b == '\t':
+				buf.WriteString(`\t`)
+			case b < 0x20 || b == '<' || b == '>' || b == '&':
+				buf.WriteString(`\u00`)
+				buf.WriteByte(lowerHex[b>>4])
+				buf.WriteByte(lowerHex[b&0xF])
+			default:
+				buf.WriteByte(b)
+			}
+			i++
+			continue
+		}
+
+		c, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case c == utf8.RuneError && size == 1:
+			// invalid UTF-8, only in strings not decoded from JSON
+			buf.WriteString(`\ufffd`)
+		case c == '\u2028' || c == '\u2029':
+			buf.WriteString(`\u202`)
+			buf.WriteByte(lowerHex[c&0xF])
+		default:
+			buf.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	buf.WriteByte('"')
+}
+
+// CanonicalJSONTestVector is a JSON document along with its canonical form.
+type CanonicalJSONTestVector struct {
+	Name      string `json:"name"`
+	Input     string `json:"input"`
+	Canonical string `json:"canonical"`
+}
+
+// CanonicalJSONTestVectors returns test vectors of CanonicalizeJSON covering
+// each of its rules, for the signers written in other languages to verify
+// that they produce the same sign bytes.
+func CanonicalJSONTestVectors() []CanonicalJSONTestVector {
+	return []CanonicalJSONTestVector{
+		{"white space", " { \"a\" : [ 1 , 2 ] ,\n\t\"b\" : null } ", `{"a":[1,2],"b":null}`},
+		{"sorted keys", `{"b":1,"a":2,"c":{"z":true,"y":false}}`, `{"a":2,"b":1,"c":{"y":false,"z":true}}`},
+		{"keys sorted by UTF-8 bytes", `{"\u00e9":1,"z":2,"Z":3,"\ud83d\ude00":4,"\uffff":5}`, "{\"Z\":3,\"z\":2,\"\u00e9\":1,\"\uffff\":5,\"\U0001f600\":4}"},
+		{"duplicate keys", `{"a":1,"a":2}`, `{"a":2}`},
+		{"array order", `[3,1,2,{"b":1,"a":2}]`, `[3,1,2,{"a":2,"b":1}]`},
+		{"literals", `[true,false,null]`, `[true,false,null]`},
+		{"integers", `[0,-0,1,-1,9007199254740992,9007199254740993]`, `[0,-0,1,-1,9007199254740992,9007199254740992]`},
+		{"decimals", `[1.0,1.50,0.1,-2.500,100e-2,1E2]`, `[1,1.5,0.1,-2.5,1,100]`},
+		{"exponents", `[1e20,1e21,1.5e-6,1.5e-7,123456789e30,-1e-100]`, `[100000000000000000000,1e+21,0.0000015,1.5e-7,1.23456789e+38,-1e-100]`},
+		{"string escapes", `"quote \" backslash \\ slash \/ tab \t newline \n return \r backspace \b formfeed \f"`, `"quote \" backslash \\ slash / tab \t newline \n return \r backspace \u0008 formfeed \u000c"`},
+		{"control characters", `"\u0000\u0001\u0008\u000c\u001f\u007f"`, `"\u0000\u0001\u0008\u000c\u001f` + "\u007f" + `"`},
+		{"HTML characters", `"<a href=\"x\">&amp;</a>"`, `"\u003ca href=\"x\"\u003e\u0026amp;\u003c/a\u003e"`},
+		{"unicode", `"café 世界 😀 caf\u00e9"`, `"café 世界 😀 café"`},
+		{"line and paragraph separators", `"\u2028 \u2029"`, `"\u2028 \u2029"`},
+		{"sign document", `{"sequence":"1","account_number":"0","chain_id":"test-chain","memo":"memo <&>","msgs":[{"type":"cosmos-sdk/Send","value":{"outputs":[{"coins":[{"amount":"10","denom":"stake"}],"address":"cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e"}],"inputs":[{"coins":[{"amount":"10","denom":"stake"}],"address":"cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszkr5jd7"}]}}],"fee":{"gas":"200000","amount":[{"amount":"5","denom":"stake"}]}}`,
+			`{"account_number":"0","chain_id":"test-chain","fee":{"amount":[{"amount":"5","denom":"stake"}],"gas":"200000"},"memo":"memo \u003c\u0026\u003e","msgs":[{"type":"cosmos-sdk/Send","value":{"inputs":[{"address":"cosmos1qgpqyqszqgpqyqszqgpqyqszqgpqyqszkr5jd7","coins":[{"amount":"10","denom":"stake"}]}],"outputs":[{"address":"cosmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnrk363e","coins":[{"amount":"10","denom":"stake"}]}]}}],"sequence":"1"}`},
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var updateVectors = flag.Bool("update-vectors", false, "update the canonical JSON test vectors of testdata")
+
+func TestCanonicalizeJSON(t *testing.T) {
+	cases := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{`{"b":[2,1],"a":{"d":null,"c":true}}`, `{"a":{"c":true,"d":null},"b":[2,1]}`, false},
+		{`[1.50,-0.0,1e2,1e21,0.0000001,123456789012345678]`, `[1.5,-0,100,1e+21,1e-7,123456789012345680]`, false},
+		{`"\b\f\n\r\t\u0001<>&\u2028\u00e9"`, `"\u0008\u000c\n\r\t\u0001\u003c\u003e\u0026\u2028` + "\u00e9" + `"`, false},
+		{`"\ud800"`, "\"\uFFFD\"", false},
+		{`{"a":1} {"b":2}`, "", true},
+		{`{"a":1,}`, "", true},
+		{`1e400`, "", true},
+		{``, "", true},
+	}
+
+	for i, tc := range cases {
+		got, err := CanonicalizeJSON([]byte(tc.input))
+		if tc.wantErr {
+			require.Error(t, err, "tc #%d", i)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", i)
+		require.Equal(t, tc.want, string(got), "tc #%d", i)
+	}
+}
+
+// The test vectors are written to testdata for the signers written in other
+// languages, and kept in sync with CanonicalJSONTestVectors.
+func TestCanonicalJSONTestVectors(t *testing.T) {
+	vectors := CanonicalJSONTestVectors()
+	for _, vector := range vectors {
+		canonical, err := CanonicalizeJSON([]byte(vector.Input))
+		require.NoError(t, err, vector.Name)
+		require.Equal(t, vector.Canonical, string(canonical), vector.Name)
+
+		// the canonical form is canonical
+		canonical, err = CanonicalizeJSON([]byte(vector.Canonical))
+		require.NoError(t, err, vector.Name)
+		require.Equal(t, vector.Canonical, string(canonical), vector.Name)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	require.NoError(t, enc.Encode(vectors))
+	path := filepath.Join("testdata", "canonical_json_vectors.json")
+	if *updateVectors {
+		require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+	}
+	expected, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), buf.String(),
+		"the test vectors changed, run go test ./types -run TestCanonicalJSONTestVectors -update-vectors")
+}
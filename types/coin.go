@@ -25,14 +25,13 @@ type Coin struct {
 }
 
 // NewCoin returns a new coin with a denomination and amount. It will panic if
-// the amount is negative.
+// the amount is negative or the denomination contains upper case characters
+// not allowed by the config, see ValidateDenom.
 func NewCoin(denom string, amount Int) Coin {
 	if amount.LT(ZeroInt()) {
 		panic(fmt.Sprintf("negative coin amount: %v\n", amount))
 	}
-	if strings.ToLower(denom) != denom {
-		panic(fmt.Sprintf("denom cannot contain upper case characters: %s\n", denom))
-	}
+	mustValidateDenomCase(denom)
 
 	return Coin{
 		Denom:  denom,
@@ -136,40 +135,38 @@ func (coins Coins) String() string {
 }
 
 // IsValid asserts the Coins are sorted, have positive amount,
-// and Denom does not contain upper case characters.
+// and valid denominations, see Validate.
 func (coins Coins) IsValid() bool {
-	switch len(coins) {
-	case 0:
-		return true
-	case 1:
-		if strings.ToLower(coins[0].Denom) != coins[0].Denom {
-			return false
+	return coins.Validate() == nil
+}
+
+// Validate returns an error describing why the Coins aren't valid, i.e. if a
+// denomination is empty or invalid, see ValidateDenom, if an amount isn't
+// positive, or if the denominations aren't sorted or are duplicated.
+func (coins Coins) Validate() error {
+	for i, coin := range coins {
+		if coin.Denom == "" {
+			return fmt.Errorf("empty denomination of coin %s", coin)
 		}
-		return coins[0].IsPositive()
-	default:
-		// check single coin case
-		if !(Coins{coins[0]}).IsValid() {
-			return false
+		if err := ValidateDenom(coin.Denom); err != nil {
+			return err
 		}
-
-		lowDenom := coins[0].Denom
-		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
-				return false
-			}
-			if coin.Denom <= lowDenom {
-				return false
-			}
-			if !coin.IsPositive() {
-				return false
-			}
-
-			// we compare each coin against the last denom
-			lowDenom = coin.Denom
+		if !coin.IsPositive() {
+			return fmt.Errorf("amount of coin %s is not positive", coin)
+		}
+		if i == 0 {
+			continue
 		}
 
-		return true
+		// we compare each coin against the last denom
+		switch lowDenom := coins[i-1].Denom; {
+		case coin.Denom == lowDenom:
+			return fmt.Errorf("duplicate denomination %s", coin.Denom)
+		case coin.Denom < lowDenom:
+			return fmt.Errorf("denominations %s and %s are not sorted", lowDenom, coin.Denom)
+		}
 	}
+	return nil
 }
 
 // Plus adds two sets of coins.
@@ -334,6 +331,61 @@ func (coins Coins) IsAnyGTE(coinsB Coins) bool {
 	return false
 }
 
+// Min returns the minimum amount of each denomination of both sets of coins,
+// the amount of a denomination missing from a set being zero, so that only
+// the denominations of both sets are returned.
+//
+// e.g.
+// {2A, 3B}.Min({A, 5B, C}) = {A, 3B}
+//
+// NOTE: Min operates under the invariant that coins are sorted by
+// denominations.
+func (coins Coins) Min(coinsB Coins) Coins {
+	var res Coins
+	for _, coin := range coins {
+		amountB := coinsB.AmountOf(coin.Denom)
+		if amountB.IsZero() {
+			continue
+		}
+		res = append(res, NewCoin(coin.Denom, MinInt(coin.Amount, amountB)))
+	}
+	return res
+}
+
+// Max returns the maximum amount of each denomination of either set of coins.
+//
+// e.g.
+// {2A, 3B}.Max({A, 5B, C}) = {2A, 5B, C}
+//
+// NOTE: Max operates under the invariant that coins are sorted by
+// denominations.
+func (coins Coins) Max(coinsB Coins) Coins {
+	res := coins.Plus(coinsB)
+	for i, coin := range res {
+		res[i] = NewCoin(coin.Denom, MaxInt(coins.AmountOf(coin.Denom), coinsB.AmountOf(coin.Denom)))
+	}
+	return res
+}
+
+// Intersect returns the coins whose denominations are in the other set of
+// coins, with their amounts in the set.
+//
+// e.g.
+// {2A, 3B}.Intersect({A, 5B, C}) = {2A, 3B}
+// {2A, 3B}.Intersect({5B, C}) = {3B}
+//
+// NOTE: Intersect operates under the invariant that coins are sorted by
+// denominations.
+func (coins Coins) Intersect(coinsB Coins) Coins {
+	var res Coins
+	for _, coin := range coins {
+		if !coinsB.AmountOf(coin.Denom).IsZero() {
+			res = append(res, coin)
+		}
+	}
+	return res
+}
+
 // IsZero returns true if there are no coins or all coins are zero.
 func (coins Coins) IsZero() bool {
 	for _, coin := range coins {
@@ -369,9 +421,7 @@ func (coins Coins) Empty() bool {
 
 // Returns the amount of a denom from coins
 func (coins Coins) AmountOf(denom string) Int {
-	if strings.ToLower(denom) != denom {
-		panic(fmt.Sprintf("denom cannot contain upper case characters: %s\n", denom))
-	}
+	mustValidateDenomCase(denom)
 	switch len(coins) {
 	case 0:
 		return ZeroInt()
@@ -416,7 +466,7 @@ func (coins Coins) IsPositive() bool {
 }
 
 // IsNotNegative returns true if there is no coin amount with a negative value
-// (even no coins is true here).
+// (even no coins is true here), nor uninitialized amount.
 //
 // TODO: Remove once unsigned integers are used.
 func (coins Coins) IsNotNegative() bool {
@@ -425,7 +475,7 @@ func (coins Coins) IsNotNegative() bool {
 	}
 
 	for _, coin := range coins {
-		if coin.IsNegative() {
+		if coin.Amount.IsNil() || coin.IsNegative() {
 			return false
 		}
 	}
@@ -490,14 +540,37 @@ func (coins Coins) Sort() Coins {
 //-----------------------------------------------------------------------------
 // Parsing
 
+// DefaultDenomRegex is the regular expression of the valid denominations,
+// unless set otherwise with Config.SetDenomRegex: 3 ~ 16 lower case letters or
+// digits, starting with a letter.
+const DefaultDenomRegex = `[a-z][a-z0-9]{2,15}`
+
 var (
-	// Denominations can be 3 ~ 16 characters long.
-	reDnm  = `[[:alpha:]][[:alnum:]]{2,15}`
 	reAmt  = `[[:digit:]]+`
 	reSpc  = `[[:space:]]*`
-	reCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reAmt, reSpc, reDnm))
+	reCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s([^[:space:]]+)$`, reAmt, reSpc))
 )
 
+// mustValidateDenomCase panics if the denomination contains upper case
+// characters which the regular expression of the valid denominations of the
+// config doesn't allow.
+func mustValidateDenomCase(denom string) {
+	if strings.ToLower(denom) != denom && ValidateDenom(denom) != nil {
+		panic(fmt.Sprintf("denom cannot contain upper case characters: %s\n", denom))
+	}
+}
+
+// ValidateDenom returns an error if the denomination doesn't match the
+// regular expression of the valid denominations of the config, see
+// Config.SetDenomRegex.
+func ValidateDenom(denom string) error {
+	if !GetConfig().getDenomRegexp().MatchString(denom) {
+		return fmt.Errorf("invalid denomination %s, expected to match %s",
+			denom, GetConfig().GetDenomRegex())
+	}
+	return nil
+}
+
 // ParseCoin parses a cli input for one coin type, returning errors if invalid.
 // This returns an error on an empty string as well.
 func ParseCoin(coinStr string) (coin Coin, err error) {
@@ -515,8 +588,8 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 		return Coin{}, fmt.Errorf("failed to parse coin amount: %s", amountStr)
 	}
 
-	if denomStr != strings.ToLower(denomStr) {
-		return Coin{}, fmt.Errorf("denom cannot contain upper case characters: %s", denomStr)
+	if err := ValidateDenom(denomStr); err != nil {
+		return Coin{}, fmt.Errorf("invalid coin expression: %s: %v", coinStr, err)
 	}
 
 	return NewCoin(denomStr, amount), nil
@@ -544,8 +617,8 @@ func ParseCoins(coinsStr string) (coins Coins, err error) {
 	coins.Sort()
 
 	// Validate coins before returning.
-	if !coins.IsValid() {
-		return nil, fmt.Errorf("parseCoins invalid: %#v", coins)
+	if err := coins.Validate(); err != nil {
+		return nil, fmt.Errorf("parseCoins invalid: %v", err)
 	}
 
 	return coins, nil
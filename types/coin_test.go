@@ -206,11 +206,11 @@ func TestPlusCoins(t *testing.T) {
 		inputTwo Coins
 		expected Coins
 	}{
-		{Coins{{"a", one}, {"b", one}}, Coins{{"a", one}, {"b", one}}, Coins{{"a", two}, {"b", two}}},
-		{Coins{{"a", zero}, {"b", one}}, Coins{{"a", zero}, {"b", zero}}, Coins{{"b", one}}},
-		{Coins{{"a", two}}, Coins{{"b", zero}}, Coins{{"a", two}}},
-		{Coins{{"a", one}}, Coins{{"a", one}, {"b", two}}, Coins{{"a", two}, {"b", two}}},
-		{Coins{{"a", zero}, {"b", zero}}, Coins{{"a", zero}, {"b", zero}}, Coins(nil)},
+		{Coins{{"atom", one}, {"btc", one}}, Coins{{"atom", one}, {"btc", one}}, Coins{{"atom", two}, {"btc", two}}},
+		{Coins{{"atom", zero}, {"btc", one}}, Coins{{"atom", zero}, {"btc", zero}}, Coins{{"btc", one}}},
+		{Coins{{"atom", two}}, Coins{{"btc", zero}}, Coins{{"atom", two}}},
+		{Coins{{"atom", one}}, Coins{{"atom", one}, {"btc", two}}, Coins{{"atom", two}, {"btc", two}}},
+		{Coins{{"atom", zero}, {"btc", zero}}, Coins{{"atom", zero}, {"btc", zero}}, Coins(nil)},
 	}
 
 	for tcIndex, tc := range cases {
@@ -231,11 +231,11 @@ func TestMinusCoins(t *testing.T) {
 		expected    Coins
 		shouldPanic bool
 	}{
-		{Coins{{"a", two}}, Coins{{"a", one}, {"b", two}}, Coins{{"a", one}, {"b", two}}, true},
-		{Coins{{"a", two}}, Coins{{"b", zero}}, Coins{{"a", two}}, false},
-		{Coins{{"a", one}}, Coins{{"b", zero}}, Coins{{"a", one}}, false},
-		{Coins{{"a", one}, {"b", one}}, Coins{{"a", one}}, Coins{{"b", one}}, false},
-		{Coins{{"a", one}, {"b", one}}, Coins{{"a", two}}, Coins{}, true},
+		{Coins{{"atom", two}}, Coins{{"atom", one}, {"btc", two}}, Coins{{"atom", one}, {"btc", two}}, true},
+		{Coins{{"atom", two}}, Coins{{"btc", zero}}, Coins{{"atom", two}}, false},
+		{Coins{{"atom", one}}, Coins{{"btc", zero}}, Coins{{"atom", one}}, false},
+		{Coins{{"atom", one}, {"btc", one}}, Coins{{"atom", one}}, Coins{{"btc", one}}, false},
+		{Coins{{"atom", one}, {"btc", one}}, Coins{{"atom", two}}, Coins{}, true},
 	}
 
 	for i, tc := range testCases {
@@ -312,6 +312,64 @@ func TestCoins(t *testing.T) {
 	assert.False(t, badAmt.IsValid(), "Coins cannot include 0 amounts")
 	assert.False(t, dup.IsValid(), "Duplicate coin")
 	assert.False(t, neg.IsValid(), "Negative first-denom coin")
+	assert.False(t, Coins{{"", NewInt(1)}}.IsValid(), "Empty denom")
+}
+
+func TestCoinsValidate(t *testing.T) {
+	one := NewInt(1)
+	cases := []struct {
+		coins  Coins
+		errMsg string
+	}{
+		{Coins{}, ""},
+		{Coins{{"gas", one}, {"tree", one}}, ""},
+		{Coins{{"", one}}, "empty denomination"},
+		{Coins{{"Gas", one}}, "invalid denomination Gas"},
+		{Coins{{"ga", one}}, "invalid denomination ga"},
+		{Coins{{"gas/1", one}}, "invalid denomination gas/1"},
+		{Coins{{"gas", one}, {"tree", ZeroInt()}}, "not positive"},
+		{Coins{{"gas", NewInt(-1)}}, "not positive"},
+		{Coins{{"gas", one}, {"gas", one}}, "duplicate denomination gas"},
+		{Coins{{"tree", one}, {"gas", one}}, "tree and gas are not sorted"},
+	}
+
+	for i, tc := range cases {
+		err := tc.coins.Validate()
+		if tc.errMsg == "" {
+			require.NoError(t, err, "tc #%d", i)
+			continue
+		}
+		require.Error(t, err, "tc #%d", i)
+		require.Contains(t, err.Error(), tc.errMsg, "tc #%d", i)
+	}
+}
+
+func TestCoinsSetOperations(t *testing.T) {
+	one := NewInt(1)
+	two := NewInt(2)
+	three := NewInt(3)
+	cases := []struct {
+		coinsA, coinsB, min, max, intersect Coins
+	}{
+		{Coins{}, Coins{}, nil, Coins{}, nil},
+		{Coins{{"atom", one}}, Coins{}, nil, Coins{{"atom", one}}, nil},
+		{Coins{}, Coins{{"atom", one}}, nil, Coins{{"atom", one}}, nil},
+		{Coins{{"atom", two}, {"btc", three}}, Coins{{"atom", one}, {"btc", three}, {"cny", one}},
+			Coins{{"atom", one}, {"btc", three}}, Coins{{"atom", two}, {"btc", three}, {"cny", one}}, Coins{{"atom", two}, {"btc", three}}},
+		{Coins{{"atom", two}, {"cny", one}}, Coins{{"btc", three}, {"cny", two}},
+			Coins{{"cny", one}}, Coins{{"atom", two}, {"btc", three}, {"cny", two}}, Coins{{"cny", one}}},
+		{Coins{{"atom", one}}, Coins{{"btc", one}}, nil, Coins{{"atom", one}, {"btc", one}}, nil},
+	}
+
+	for i, tc := range cases {
+		require.True(t, tc.min.IsEqual(tc.coinsA.Min(tc.coinsB)), "tc #%d: min %v", i, tc.coinsA.Min(tc.coinsB))
+		require.True(t, tc.min.IsEqual(tc.coinsB.Min(tc.coinsA)), "tc #%d: min", i)
+		require.True(t, tc.max.IsEqual(tc.coinsA.Max(tc.coinsB)), "tc #%d: max %v", i, tc.coinsA.Max(tc.coinsB))
+		require.True(t, tc.max.IsEqual(tc.coinsB.Max(tc.coinsA)), "tc #%d: max", i)
+		require.True(t, tc.intersect.IsEqual(tc.coinsA.Intersect(tc.coinsB)), "tc #%d: intersect %v", i, tc.coinsA.Intersect(tc.coinsB))
+		require.True(t, tc.coinsA.Min(tc.coinsB).IsValid(), "tc #%d", i)
+		require.True(t, tc.coinsA.Max(tc.coinsB).IsValid(), "tc #%d", i)
+	}
 }
 
 func TestCoinsGT(t *testing.T) {
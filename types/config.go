@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+	"regexp"
 	"sync"
 )
 
@@ -11,11 +13,16 @@ type Config struct {
 	sealed              bool
 	bech32AddressPrefix map[string]string
 	txEncoder           TxEncoder
+	coinType            uint32
+	denomRegex          string
+	denomRegexp         *regexp.Regexp
 }
 
-var (
-	// Initializing an instance of Config
-	sdkConfig = &Config{
+// Initializing an instance of Config
+var sdkConfig = newDefaultConfig()
+
+func newDefaultConfig() *Config {
+	return &Config{
 		sealed: false,
 		bech32AddressPrefix: map[string]string{
 			"account_addr":   Bech32PrefixAccAddr,
@@ -25,52 +32,96 @@ var (
 			"validator_pub":  Bech32PrefixValPub,
 			"consensus_pub":  Bech32PrefixConsPub,
 		},
-		txEncoder: nil,
+		txEncoder:   nil,
+		coinType:    CoinType,
+		denomRegex:  DefaultDenomRegex,
+		denomRegexp: compileDenomRegex(DefaultDenomRegex),
 	}
-)
+}
+
+// compileDenomRegex compiles the regular expression of the denominations to
+// match whole denominations
+func compileDenomRegex(reDnm string) *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`^(?:%s)$`, reDnm))
+}
 
-// GetConfig returns the config instance for the SDK.
+// GetConfig returns the config instance for the SDK. Applications set their
+// Bech32 prefixes on it before using any address, then seal it, so that all
+// the addresses and public keys are encoded and decoded with them.
 func GetConfig() *Config {
 	return sdkConfig
 }
 
-func (config *Config) assertNotSealed() {
+// set applies the change to the config, holding its lock, and panics if the
+// config is sealed.
+func (config *Config) set(change func()) {
 	config.mtx.Lock()
 	defer config.mtx.Unlock()
 
 	if config.sealed {
 		panic("Config is sealed")
 	}
+	change()
+}
+
+func (config *Config) setBech32Prefixes(addrKey, pubKey, addressPrefix, pubKeyPrefix string) {
+	if addressPrefix == "" || pubKeyPrefix == "" {
+		panic("Bech32 prefixes must not be empty")
+	}
+	if addressPrefix == pubKeyPrefix {
+		panic(fmt.Sprintf("Bech32 address and public key prefixes must differ, got %s", addressPrefix))
+	}
+	config.set(func() {
+		config.bech32AddressPrefix[addrKey] = addressPrefix
+		config.bech32AddressPrefix[pubKey] = pubKeyPrefix
+	})
+}
+
+func (config *Config) getBech32Prefix(key string) string {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
+	return config.bech32AddressPrefix[key]
 }
 
 // SetBech32PrefixForAccount builds the Config with Bech32 addressPrefix and publKeyPrefix for accounts
 // and returns the config instance
 func (config *Config) SetBech32PrefixForAccount(addressPrefix, pubKeyPrefix string) {
-	config.assertNotSealed()
-	config.bech32AddressPrefix["account_addr"] = addressPrefix
-	config.bech32AddressPrefix["account_pub"] = pubKeyPrefix
+	config.setBech32Prefixes("account_addr", "account_pub", addressPrefix, pubKeyPrefix)
 }
 
 // SetBech32PrefixForValidator builds the Config with Bech32 addressPrefix and publKeyPrefix for validators
 //  and returns the config instance
 func (config *Config) SetBech32PrefixForValidator(addressPrefix, pubKeyPrefix string) {
-	config.assertNotSealed()
-	config.bech32AddressPrefix["validator_addr"] = addressPrefix
-	config.bech32AddressPrefix["validator_pub"] = pubKeyPrefix
+	config.setBech32Prefixes("validator_addr", "validator_pub", addressPrefix, pubKeyPrefix)
 }
 
 // SetBech32PrefixForConsensusNode builds the Config with Bech32 addressPrefix and publKeyPrefix for consensus nodes
 // and returns the config instance
 func (config *Config) SetBech32PrefixForConsensusNode(addressPrefix, pubKeyPrefix string) {
-	config.assertNotSealed()
-	config.bech32AddressPrefix["consensus_addr"] = addressPrefix
-	config.bech32AddressPrefix["consensus_pub"] = pubKeyPrefix
+	config.setBech32Prefixes("consensus_addr", "consensus_pub", addressPrefix, pubKeyPrefix)
 }
 
 // SetTxEncoder builds the Config with TxEncoder used to marshal StdTx to bytes
 func (config *Config) SetTxEncoder(encoder TxEncoder) {
-	config.assertNotSealed()
-	config.txEncoder = encoder
+	config.set(func() { config.txEncoder = encoder })
+}
+
+// SetCoinType builds the Config with the BIP44 coin type of the keys
+func (config *Config) SetCoinType(coinType uint32) {
+	config.set(func() { config.coinType = coinType })
+}
+
+// SetDenomRegex builds the Config with the regular expression of the valid
+// denominations of the coins, DefaultDenomRegex by default. The whole
+// denominations must match it, e.g. `[a-z][a-z0-9/]{2,63}` accepts the
+// prefixed denominations of IBC vouchers. It panics if it doesn't compile.
+func (config *Config) SetDenomRegex(reDnm string) {
+	re := compileDenomRegex(reDnm)
+	config.set(func() {
+		config.denomRegex = reDnm
+		config.denomRegexp = re
+	})
 }
 
 // Seal seals the config such that the config state could not be modified further
@@ -82,37 +133,90 @@ func (config *Config) Seal() *Config {
 	return config
 }
 
+// IsSealed returns whether the config is sealed
+func (config *Config) IsSealed() bool {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
+	return config.sealed
+}
+
+// GetBech32Prefixes returns the Bech32 prefixes of the addresses followed by
+// those of the public keys, for the accounts, validators and consensus nodes
+func (config *Config) GetBech32Prefixes() []string {
+	return []string{
+		config.GetBech32AccountAddrPrefix(),
+		config.GetBech32ValidatorAddrPrefix(),
+		config.GetBech32ConsensusAddrPrefix(),
+		config.GetBech32AccountPubPrefix(),
+		config.GetBech32ValidatorPubPrefix(),
+		config.GetBech32ConsensusPubPrefix(),
+	}
+}
+
 // GetBech32AccountAddrPrefix returns the Bech32 prefix for account address
 func (config *Config) GetBech32AccountAddrPrefix() string {
-	return config.bech32AddressPrefix["account_addr"]
+	return config.getBech32Prefix("account_addr")
 }
 
 // GetBech32ValidatorAddrPrefix returns the Bech32 prefix for validator address
 func (config *Config) GetBech32ValidatorAddrPrefix() string {
-	return config.bech32AddressPrefix["validator_addr"]
+	return config.getBech32Prefix("validator_addr")
 }
 
 // GetBech32ConsensusAddrPrefix returns the Bech32 prefix for consensus node address
 func (config *Config) GetBech32ConsensusAddrPrefix() string {
-	return config.bech32AddressPrefix["consensus_addr"]
+	return config.getBech32Prefix("consensus_addr")
 }
 
 // GetBech32AccountPubPrefix returns the Bech32 prefix for account public key
 func (config *Config) GetBech32AccountPubPrefix() string {
-	return config.bech32AddressPrefix["account_pub"]
+	return config.getBech32Prefix("account_pub")
 }
 
 // GetBech32ValidatorPubPrefix returns the Bech32 prefix for validator public key
 func (config *Config) GetBech32ValidatorPubPrefix() string {
-	return config.bech32AddressPrefix["validator_pub"]
+	return config.getBech32Prefix("validator_pub")
 }
 
 // GetBech32ConsensusPubPrefix returns the Bech32 prefix for consensus node public key
 func (config *Config) GetBech32ConsensusPubPrefix() string {
-	return config.bech32AddressPrefix["consensus_pub"]
+	return config.getBech32Prefix("consensus_pub")
 }
 
 // GetTxEncoder return function to encode transactions
 func (config *Config) GetTxEncoder() TxEncoder {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
 	return config.txEncoder
 }
+
+// GetCoinType returns the BIP44 coin type of the keys
+func (config *Config) GetCoinType() uint32 {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
+	return config.coinType
+}
+
+// GetDenomRegex returns the regular expression of the valid denominations
+func (config *Config) GetDenomRegex() string {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
+	return config.denomRegex
+}
+
+func (config *Config) getDenomRegexp() *regexp.Regexp {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+
+	return config.denomRegexp
+}
+
+// GetFullFundraiserPath returns the BIP44 path of the first key derived from
+// a mnemonic, i.e. 44'/coin_type'/0'/0/0
+func (config *Config) GetFullFundraiserPath() string {
+	return fmt.Sprintf("44'/%d'/0'/0/0", config.GetCoinType())
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestConfigSeal(t *testing.T) {
+	config := newDefaultConfig()
+	config.SetBech32PrefixForAccount("band", "bandpub")
+	config.SetCoinType(494)
+	require.False(t, config.IsSealed())
+
+	require.Equal(t, config, config.Seal())
+	require.True(t, config.IsSealed())
+	require.Panics(t, func() { config.SetBech32PrefixForAccount("cosmos", "cosmospub") })
+	require.Panics(t, func() { config.SetBech32PrefixForValidator("cosmosvaloper", "cosmosvaloperpub") })
+	require.Panics(t, func() { config.SetBech32PrefixForConsensusNode("cosmosvalcons", "cosmosvalconspub") })
+	require.Panics(t, func() { config.SetCoinType(CoinType) })
+	require.Panics(t, func() { config.SetTxEncoder(nil) })
+
+	require.Equal(t, "band", config.GetBech32AccountAddrPrefix())
+	require.Equal(t, "bandpub", config.GetBech32AccountPubPrefix())
+	require.Equal(t, uint32(494), config.GetCoinType())
+	require.Equal(t, "44'/494'/0'/0/0", config.GetFullFundraiserPath())
+}
+
+func TestConfigInvalidBech32Prefixes(t *testing.T) {
+	config := newDefaultConfig()
+	require.Panics(t, func() { config.SetBech32PrefixForAccount("", "bandpub") })
+	require.Panics(t, func() { config.SetBech32PrefixForValidator("bandvaloper", "") })
+	require.Panics(t, func() { config.SetBech32PrefixForConsensusNode("band", "band") })
+	require.Equal(t, Bech32PrefixAccAddr, config.GetBech32AccountAddrPrefix())
+	require.Equal(t, Bech32PrefixValPub, config.GetBech32ValidatorPubPrefix())
+}
+
+func TestConfigBech32Prefixes(t *testing.T) {
+	defer func(original *Config) { sdkConfig = original }(sdkConfig)
+	sdkConfig = newDefaultConfig()
+	sdkConfig.SetBech32PrefixForAccount("band", "bandpub")
+	sdkConfig.SetBech32PrefixForValidator("bandvaloper", "bandvaloperpub")
+	sdkConfig.SetBech32PrefixForConsensusNode("bandvalcons", "bandvalconspub")
+	sdkConfig.Seal()
+
+	pub := ed25519.GenPrivKey().PubKey()
+	accAddr := AccAddress(pub.Address())
+	valAddr := ValAddress(pub.Address())
+	consAddr := ConsAddress(pub.Address())
+
+	require.Regexp(t, "^band1", accAddr.String())
+	require.Regexp(t, "^bandvaloper1", valAddr.String())
+	require.Regexp(t, "^bandvalcons1", consAddr.String())
+
+	res, err := AccAddressFromBech32(accAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, accAddr, res)
+	resVal, err := ValAddressFromBech32(valAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, valAddr, resVal)
+	resCons, err := ConsAddressFromBech32(consAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, consAddr, resCons)
+
+	// the addresses of other prefixes are rejected
+	_, err = AccAddressFromBech32(valAddr.String())
+	require.Error(t, err)
+	var unmarshaled AccAddress
+	bz, err := json.Marshal(valAddr)
+	require.NoError(t, err)
+	require.Error(t, json.Unmarshal(bz, &unmarshaled))
+
+	bz, err = json.Marshal(accAddr)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &unmarshaled))
+	require.Equal(t, accAddr, unmarshaled)
+
+	for prefix, bech32Pub := range map[string]func(crypto.PubKey) (string, error){
+		"bandpub":        Bech32ifyAccPub,
+		"bandvaloperpub": Bech32ifyValPub,
+		"bandvalconspub": Bech32ifyConsPub,
+	} {
+		str, err := bech32Pub(pub)
+		require.NoError(t, err)
+		require.Regexp(t, "^"+prefix+"1", str)
+	}
+	resPub, err := GetAccPubKeyBech32(MustBech32ifyAccPub(pub))
+	require.NoError(t, err)
+	require.Equal(t, pub, resPub)
+	_, err = GetConsPubKeyBech32(MustBech32ifyAccPub(pub))
+	require.Error(t, err)
+
+	require.Equal(t, []string{
+		"band", "bandvaloper", "bandvalcons", "bandpub", "bandvaloperpub", "bandvalconspub",
+	}, sdkConfig.GetBech32Prefixes())
+}
+
+func TestConfigDenomRegex(t *testing.T) {
+	defer func(original *Config) { sdkConfig = original }(sdkConfig)
+	sdkConfig = newDefaultConfig()
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	require.Equal(t, DefaultDenomRegex, sdkConfig.GetDenomRegex())
+	require.NoError(t, ValidateDenom("stake"))
+	require.Error(t, ValidateDenom(ibcDenom))
+	_, err := ParseCoins("10" + ibcDenom)
+	require.Error(t, err)
+
+	require.Panics(t, func() { sdkConfig.SetDenomRegex("[a-z") })
+	sdkConfig.SetDenomRegex(`[a-z][a-z0-9]{2,15}|ibc/[0-9A-F]{64}`)
+	sdkConfig.Seal()
+	require.Panics(t, func() { sdkConfig.SetDenomRegex(DefaultDenomRegex) })
+
+	require.NoError(t, ValidateDenom("stake"))
+	require.NoError(t, ValidateDenom(ibcDenom))
+	// the whole denominations must match
+	require.Error(t, ValidateDenom("ibc/"+ibcDenom))
+	require.Error(t, ValidateDenom(ibcDenom+"0"))
+
+	coins, err := ParseCoins("10" + ibcDenom + ",5stake")
+	require.NoError(t, err)
+	require.Equal(t, Coins{NewInt64Coin(ibcDenom, 10), NewInt64Coin("stake", 5)}, coins)
+	require.NoError(t, coins.Validate())
+	require.Equal(t, NewInt(10), coins.AmountOf(ibcDenom))
+	require.Panics(t, func() { NewInt64Coin("ibc/UNKNOWN", 1) })
+}
@@ -3,6 +3,8 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -44,11 +46,13 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithChainID(header.ChainID)
 	c = c.WithIsCheckTx(isCheckTx)
 	c = c.WithTxBytes(nil)
+	c = c.withValue(contextKeyTxID, "")
 	c = c.WithLogger(logger)
 	c = c.WithVoteInfos(nil)
 	c = c.WithGasMeter(NewInfiniteGasMeter())
 	c = c.WithMinimumFees(Coins{})
 	c = c.WithConsensusParams(nil)
+	c = c.WithEventManager(NewEventManager())
 	return c
 }
 
@@ -72,6 +76,31 @@ func (c Context) Value(key interface{}) interface{} {
 	return value
 }
 
+// GetValue sets the value pointed to by ptr to the context value for the
+// provided key, returning false if there is none or if it is not assignable
+// to the type of the value pointed to. It panics if ptr is not a non-nil
+// pointer.
+//
+//	var params Params
+//	if ctx.GetValue(paramsKey, &params) { ... }
+func (c Context) GetValue(key interface{}, ptr interface{}) bool {
+	dst := reflect.ValueOf(ptr)
+	if dst.Kind() != reflect.Ptr || dst.IsNil() {
+		panic(fmt.Sprintf("expected a non-nil pointer, got %T", ptr))
+	}
+
+	value := c.Value(key)
+	if value == nil {
+		return false
+	}
+	src := reflect.ValueOf(value)
+	if !src.Type().AssignableTo(dst.Elem().Type()) {
+		return false
+	}
+	dst.Elem().Set(src)
+	return true
+}
+
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
 	return c.MultiStore().GetKVStore(key).Gas(c.GasMeter(), cachedKVGasConfig)
@@ -143,6 +172,8 @@ const (
 	contextKeyBlockGasMeter
 	contextKeyMinimumFees
 	contextKeyConsensusParams
+	contextKeyEventManager
+	contextKeyTxID
 )
 
 func (c Context) MultiStore() MultiStore {
@@ -159,6 +190,17 @@ func (c Context) TxBytes() []byte { return c.Value(contextKeyTxBytes).([]byte) }
 
 func (c Context) Logger() log.Logger { return c.Value(contextKeyLogger).(log.Logger) }
 
+// ModuleLogger returns the logger of the context with the module field set to
+// the given module, e.g. "x/staking", for the keepers to log with the context
+// of the block and tx being processed.
+func (c Context) ModuleLogger(module string) log.Logger {
+	return c.Logger().With("module", module)
+}
+
+// TxID returns the correlation ID of the tx being processed, i.e. its hash,
+// or "" outside of a tx.
+func (c Context) TxID() string { return c.Value(contextKeyTxID).(string) }
+
 func (c Context) VoteInfos() []abci.VoteInfo {
 	return c.Value(contextKeyVoteInfos).([]abci.VoteInfo)
 }
@@ -175,6 +217,10 @@ func (c Context) ConsensusParams() *abci.ConsensusParams {
 	return c.Value(contextKeyConsensusParams).(*abci.ConsensusParams)
 }
 
+func (c Context) EventManager() *EventManager {
+	return c.Value(contextKeyEventManager).(*EventManager)
+}
+
 func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c.withValue(contextKeyMultiStore, ms)
 }
@@ -208,6 +254,19 @@ func (c Context) WithTxBytes(txBytes []byte) Context { return c.withValue(contex
 
 func (c Context) WithLogger(logger log.Logger) Context { return c.withValue(contextKeyLogger, logger) }
 
+// WithLoggerFields returns the context with the given key-value pairs added to
+// the fields of its logger.
+func (c Context) WithLoggerFields(keyvals ...interface{}) Context {
+	return c.WithLogger(c.Logger().With(keyvals...))
+}
+
+// WithTxID returns the context with the given tx correlation ID, which is also
+// added to the fields of its logger as "tx", so that all the logs of the tx
+// can be correlated.
+func (c Context) WithTxID(txID string) Context {
+	return c.withValue(contextKeyTxID, txID).WithLoggerFields("tx", txID)
+}
+
 func (c Context) WithVoteInfos(VoteInfos []abci.VoteInfo) Context {
 	return c.withValue(contextKeyVoteInfos, VoteInfos)
 }
@@ -230,6 +289,10 @@ func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	return c.withValue(contextKeyConsensusParams, params)
 }
 
+func (c Context) WithEventManager(em *EventManager) Context {
+	return c.withValue(contextKeyEventManager, em)
+}
+
 // Cache the multistore and return a new cached context. The cached context is
 // written to the context when writeCache is called.
 func (c Context) CacheContext() (cc Context, writeCache func()) {
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -184,3 +185,43 @@ func TestContextWithCustom(t *testing.T) {
 	require.Equal(t, meter, ctx.GasMeter())
 	require.Equal(t, minFees, types.Coins{types.NewInt64Coin("feetoken", 1)})
 }
+
+func TestContextLoggerFields(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := types.NewContext(nil, abci.Header{}, false, log.NewTMLogger(log.NewSyncWriter(&buf)))
+	require.Equal(t, "", ctx.TxID())
+
+	ctx = ctx.WithTxID("ABCD").WithLoggerFields("height", 10)
+	require.Equal(t, "ABCD", ctx.TxID())
+	ctx.ModuleLogger("x/test").Info("logged")
+	require.Contains(t, buf.String(), "logged")
+	require.Contains(t, buf.String(), "tx=ABCD")
+	require.Contains(t, buf.String(), "height=10")
+	require.Contains(t, buf.String(), "module=x/test")
+}
+
+type testParams struct {
+	Limit int
+}
+
+func TestContextGetValue(t *testing.T) {
+	ctx := types.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	var params testParams
+	require.False(t, ctx.GetValue("params", &params))
+
+	ctx = ctx.WithValue("params", testParams{10})
+	require.True(t, ctx.GetValue("params", &params))
+	require.Equal(t, testParams{10}, params)
+
+	var limit int
+	require.False(t, ctx.GetValue("params", &limit))
+	require.Equal(t, 0, limit)
+
+	var any interface{}
+	require.True(t, ctx.GetValue("params", &any))
+	require.Equal(t, testParams{10}, any)
+
+	require.Panics(t, func() { ctx.GetValue("params", params) })
+	require.Panics(t, func() { ctx.GetValue("params", (*testParams)(nil)) })
+}
@@ -419,6 +419,75 @@ func (d Dec) TruncateDec() Dec {
 	return NewDecFromBigInt(chopPrecisionAndTruncateNonMutative(d.Int))
 }
 
+// Ceil returns the smallest integer greater than or equal to the decimal
+func (d Dec) Ceil() Dec {
+	quo, rem := new(big.Int).QuoRem(d.Int, precisionReuse, new(big.Int))
+	if rem.Sign() == 1 {
+		quo.Add(quo, oneInt)
+	}
+	return NewDecFromBigInt(quo)
+}
+
+// RoundToPrec rounds the decimal to prec decimal places using bankers
+// rounding, i.e. half to even, e.g. 0.125 and 0.135 round to 0.12 and 0.14 at
+// a precision of 2.
+// CONTRACT: 0 <= prec <= Precision
+func (d Dec) RoundToPrec(prec int64) Dec {
+	if prec < 0 {
+		panic(fmt.Sprintf("negative precision %v", prec))
+	}
+	multiplier := precisionMultiplier(prec)
+
+	abs := new(big.Int).Abs(d.Int)
+	quo, rem := abs.QuoRem(abs, multiplier, new(big.Int))
+	switch rem.Lsh(rem, 1).Cmp(multiplier) {
+	case 1:
+		quo.Add(quo, oneInt)
+	case 0:
+		// always round to an even number
+		if quo.Bit(0) == 1 {
+			quo.Add(quo, oneInt)
+		}
+	}
+
+	res := quo.Mul(quo, multiplier)
+	if d.IsNegative() {
+		res.Neg(res)
+	}
+	return Dec{res}
+}
+
+// Power returns the decimal raised to the given power, by exponentiation by
+// squaring. Each multiplication is rounded to Precision decimal places with
+// bankers rounding, as Mul is, so the result may be off by a few units of the
+// last decimal place, the error growing with the power and the magnitude of
+// the decimal. It panics on overflow.
+func (d Dec) Power(power uint64) Dec {
+	res := OneDec()
+	base := Dec{new(big.Int).Set(d.Int)}
+	for ; power > 0; power >>= 1 {
+		if power&1 == 1 {
+			res = res.Mul(base)
+		}
+		if power > 1 {
+			base = base.Mul(base)
+		}
+	}
+	return res
+}
+
+// ApproxSqrt returns the square root of the decimal, truncated to Precision
+// decimal places, so that it is lower than the exact square root by less than
+// 10^-Precision. It returns an error if the decimal is negative.
+func (d Dec) ApproxSqrt() (Dec, error) {
+	if d.IsNegative() {
+		return Dec{}, fmt.Errorf("square root of negative decimal %v", d)
+	}
+	// sqrt(d.Int / 10^Precision) * 10^Precision = sqrt(d.Int * 10^Precision)
+	res := new(big.Int).Mul(d.Int, precisionReuse)
+	return Dec{res.Sqrt(res)}, nil
+}
+
 //___________________________________________________________________________________
 
 // reuse nil values
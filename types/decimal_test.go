@@ -384,3 +384,87 @@ func TestDecMulInt(t *testing.T) {
 		require.Equal(t, tc.want, got, "Incorrect result on test case %d", i)
 	}
 }
+
+func TestDecCeil(t *testing.T) {
+	tests := []struct {
+		d1  Dec
+		exp Dec
+	}{
+		{mustNewDecFromStr(t, "0"), NewDec(0)},
+		{mustNewDecFromStr(t, "0.000000000000000001"), NewDec(1)},
+		{mustNewDecFromStr(t, "1"), NewDec(1)},
+		{mustNewDecFromStr(t, "1.5"), NewDec(2)},
+		{mustNewDecFromStr(t, "-0.5"), NewDec(0)},
+		{mustNewDecFromStr(t, "-1"), NewDec(-1)},
+		{mustNewDecFromStr(t, "-1.5"), NewDec(-1)},
+	}
+	for tcIndex, tc := range tests {
+		require.True(t, tc.exp.Equal(tc.d1.Ceil()), "tc %d: %v", tcIndex, tc.d1.Ceil())
+	}
+}
+
+func TestDecRoundToPrec(t *testing.T) {
+	tests := []struct {
+		d1   Dec
+		prec int64
+		exp  Dec
+	}{
+		{mustNewDecFromStr(t, "0.125"), 2, mustNewDecFromStr(t, "0.12")},
+		{mustNewDecFromStr(t, "0.135"), 2, mustNewDecFromStr(t, "0.14")},
+		{mustNewDecFromStr(t, "0.1251"), 2, mustNewDecFromStr(t, "0.13")},
+		{mustNewDecFromStr(t, "0.1249"), 2, mustNewDecFromStr(t, "0.12")},
+		{mustNewDecFromStr(t, "2.5"), 0, NewDec(2)},
+		{mustNewDecFromStr(t, "3.5"), 0, NewDec(4)},
+		{mustNewDecFromStr(t, "1.23"), Precision, mustNewDecFromStr(t, "1.23")},
+	}
+	for tcIndex, tc := range tests {
+		require.True(t, tc.exp.Equal(tc.d1.RoundToPrec(tc.prec)), "tc %d: %v", tcIndex, tc.d1.RoundToPrec(tc.prec))
+		require.True(t, tc.exp.Neg().Equal(tc.d1.Neg().RoundToPrec(tc.prec)), "negative tc %d", tcIndex)
+	}
+	require.Panics(t, func() { OneDec().RoundToPrec(-1) })
+	require.Panics(t, func() { OneDec().RoundToPrec(Precision + 1) })
+}
+
+func TestDecPower(t *testing.T) {
+	tests := []struct {
+		d1    Dec
+		power uint64
+		exp   Dec
+	}{
+		{NewDec(2), 0, NewDec(1)},
+		{NewDec(0), 0, NewDec(1)},
+		{NewDec(2), 1, NewDec(2)},
+		{NewDec(2), 10, NewDec(1024)},
+		{NewDec(-3), 3, NewDec(-27)},
+		{mustNewDecFromStr(t, "0.5"), 3, mustNewDecFromStr(t, "0.125")},
+		{mustNewDecFromStr(t, "1.1"), 2, mustNewDecFromStr(t, "1.21")},
+		{mustNewDecFromStr(t, "0.1"), 18, mustNewDecFromStr(t, "0.000000000000000001")},
+		{mustNewDecFromStr(t, "0.1"), 19, NewDec(0)},
+	}
+	for tcIndex, tc := range tests {
+		require.True(t, tc.exp.Equal(tc.d1.Power(tc.power)), "tc %d: %v", tcIndex, tc.d1.Power(tc.power))
+	}
+	require.Panics(t, func() { NewDec(10).Power(100) })
+}
+
+func TestDecApproxSqrt(t *testing.T) {
+	tests := []struct {
+		d1  Dec
+		exp Dec
+	}{
+		{NewDec(0), NewDec(0)},
+		{NewDec(1), NewDec(1)},
+		{NewDec(4), NewDec(2)},
+		{mustNewDecFromStr(t, "0.25"), mustNewDecFromStr(t, "0.5")},
+		{NewDec(2), mustNewDecFromStr(t, "1.414213562373095048")},
+		{mustNewDecFromStr(t, "0.000000000000000001"), mustNewDecFromStr(t, "0.000000001")},
+	}
+	for tcIndex, tc := range tests {
+		res, err := tc.d1.ApproxSqrt()
+		require.NoError(t, err, "tc %d", tcIndex)
+		require.True(t, tc.exp.Equal(res), "tc %d: %v", tcIndex, res)
+	}
+
+	_, err := NewDec(-1).ApproxSqrt()
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -46,6 +47,8 @@ const (
 	CodeTooManySignatures CodeType = 15
 	CodeGasOverflow       CodeType = 16
 	CodeNoSignatures      CodeType = 17
+	CodeOutOfBlockGas     CodeType = 18
+	CodeInvalidRequest    CodeType = 19
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -91,13 +94,63 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "insufficient fee"
 	case CodeTooManySignatures:
 		return "maximum numer of signatures exceeded"
+	case CodeGasOverflow:
+		return "gas overflow"
 	case CodeNoSignatures:
 		return "no signatures supplied"
+	case CodeOutOfBlockGas:
+		return "out of block gas"
+	case CodeInvalidRequest:
+		return "invalid request"
 	default:
 		return unknownCodeMsg(code)
 	}
 }
 
+//--------------------------------------------------------------------------------
+// Registry of the error codes, letting clients map the code and codespace of a
+// failed transaction or query to a description. The codes are registered in
+// the registry of the errors package, along with the errors created with it,
+// and those of the root codespace are registered by it.
+
+// ErrorCode describes an error code registered for a codespace
+type ErrorCode struct {
+	Codespace   CodespaceType `json:"codespace"`
+	Code        CodeType      `json:"code"`
+	Description string        `json:"description"`
+}
+
+// RegisterCode registers the description of an error code of a codespace.
+// Modules register their codes under their default codespace when their
+// package is initialized. It panics if the code is already registered for the
+// codespace.
+func RegisterCode(codespace CodespaceType, code CodeType, description string) {
+	sdkerrors.Register(string(codespace), uint32(code), description)
+}
+
+// CodeDescription returns the description registered for an error code of a
+// codespace, if any.
+func CodeDescription(codespace CodespaceType, code CodeType) (description string, found bool) {
+	err, found := sdkerrors.Lookup(string(codespace), uint32(code))
+	if !found {
+		return "", false
+	}
+	return err.Description(), true
+}
+
+// RegisteredCodes returns all the registered error codes, sorted by codespace
+// then by code.
+func RegisteredCodes() []ErrorCode {
+	errs := sdkerrors.Registered()
+	errCodes := make([]ErrorCode, len(errs))
+	for i, err := range errs {
+		errCodes[i] = ErrorCode{
+			CodespaceType(err.Codespace()), CodeType(err.ABCICode()), err.Description(),
+		}
+	}
+	return errCodes
+}
+
 //--------------------------------------------------------------------------------
 // All errors are created via constructors so as to enable us to hijack them
 // and inject stack traces if we really want to.
@@ -154,6 +207,33 @@ func ErrNoSignatures(msg string) Error {
 func ErrGasOverflow(msg string) Error {
 	return newErrorWithRootCodespace(CodeGasOverflow, msg)
 }
+func ErrOutOfBlockGas(msg string) Error {
+	return newErrorWithRootCodespace(CodeOutOfBlockGas, msg)
+}
+func ErrInvalidRequest(msg string) Error {
+	return newErrorWithRootCodespace(CodeInvalidRequest, msg)
+}
+
+// ConvertError returns the given error as an Error, for the errors created
+// with the errors package to be returned where an Error is expected, e.g. by
+// the handlers and queriers. The errors without codespace and code are
+// internal errors.
+func ConvertError(err error) Error {
+	if err == nil {
+		return nil
+	}
+	if sdkErr, ok := err.(Error); ok {
+		return sdkErr
+	}
+	codespace, code, _ := sdkerrors.ABCIInfo(err, true)
+	return newError(CodespaceType(codespace), CodeType(code), "%s", err.Error())
+}
+
+// ResultFromError returns the result of the failure of a message with the
+// given error. See ConvertError.
+func ResultFromError(err error) Result {
+	return ConvertError(err).Result()
+}
 
 //----------------------------------------
 // Error & sdkError
@@ -247,6 +327,18 @@ func (err *sdkError) Code() CodeType {
 	return err.code
 }
 
+// ABCICode returns the code of the error, for the errors package to identify
+// the error by its codespace and code.
+func (err *sdkError) ABCICode() uint32 {
+	return uint32(err.code)
+}
+
+// ABCICodespace returns the codespace of the error, for the errors package to
+// identify the error by its codespace and code.
+func (err *sdkError) ABCICodespace() string {
+	return string(err.codespace)
+}
+
 // Implements ABCIError.
 func (err *sdkError) ABCILog() string {
 	cdc := codec.New()
@@ -0,0 +1,54 @@
+package errors
+
+import (
+	"encoding/json"
+)
+
+// coder is implemented by the errors identified by a codespace and a code,
+// as the registered errors and the sdk.Error errors.
+type coder interface {
+	ABCICode() uint32
+	ABCICodespace() string
+}
+
+// abciLog is the log of the ABCI responses of the failures
+type abciLog struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	Message   string `json:"message"`
+}
+
+// ABCIInfo returns the codespace, code and log of the ABCI response of the
+// given error, which are those of the outermost error with a codespace and a
+// code it wraps. The log is the JSON of the codespace, code and message of
+// the error, as parsed by the clients.
+//
+// Other errors are internal errors. As their message may contain
+// nondeterministic details, e.g. memory addresses, which would break the
+// consensus on the results of the transactions, it is only logged if debug is
+// true.
+func ABCIInfo(err error, debug bool) (codespace string, code uint32, log string) {
+	if err == nil {
+		return "", 0, ""
+	}
+
+	msg := err.Error()
+	for e := err; e != nil; e = unwrap(e) {
+		if c, ok := e.(coder); ok {
+			codespace, code = c.ABCICodespace(), c.ABCICode()
+			break
+		}
+	}
+	if code == 0 {
+		codespace, code = ErrInternal.codespace, ErrInternal.code
+		if !debug {
+			msg = ErrInternal.description
+		}
+	}
+
+	bz, er := json.Marshal(abciLog{Codespace: codespace, Code: code, Message: msg})
+	if er != nil {
+		panic(er)
+	}
+	return codespace, code, string(bz)
+}
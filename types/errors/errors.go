@@ -0,0 +1,157 @@
+// Package errors implements the errors of the SDK, identified by their
+// codespace and code, as returned in the ABCI responses.
+//
+// The modules register their errors once, when their package is initialized:
+//
+//	var ErrUnknownProposal = errors.Register("gov", 1, "unknown proposal")
+//
+// and wrap them with the context of the failure, instead of formatting a new
+// error:
+//
+//	return errors.Wrapf(ErrUnknownProposal, "proposal %d", proposalID)
+//
+// The wrapped errors keep the identity of the registered error, which Is
+// checks, and ABCIInfo returns the codespace, code and log of the ABCI
+// response of any error.
+package errors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// RootCodespace is the codespace of the errors of the SDK itself, shared by
+// all the modules.
+const RootCodespace = "sdk"
+
+// Errors of the root codespace. Their codes and descriptions are those of the
+// sdk.Code constants.
+var (
+	ErrInternal          = Register(RootCodespace, 1, "internal error")
+	ErrTxDecode          = Register(RootCodespace, 2, "tx parse error")
+	ErrInvalidSequence   = Register(RootCodespace, 3, "invalid sequence")
+	ErrUnauthorized      = Register(RootCodespace, 4, "unauthorized")
+	ErrInsufficientFunds = Register(RootCodespace, 5, "insufficient funds")
+	ErrUnknownRequest    = Register(RootCodespace, 6, "unknown request")
+	ErrInvalidAddress    = Register(RootCodespace, 7, "invalid address")
+	ErrInvalidPubKey     = Register(RootCodespace, 8, "invalid pubkey")
+	ErrUnknownAddress    = Register(RootCodespace, 9, "unknown address")
+	ErrInsufficientCoins = Register(RootCodespace, 10, "insufficient coins")
+	ErrInvalidCoins      = Register(RootCodespace, 11, "invalid coins")
+	ErrOutOfGas          = Register(RootCodespace, 12, "out of gas")
+	ErrMemoTooLarge      = Register(RootCodespace, 13, "memo too large")
+	ErrInsufficientFee   = Register(RootCodespace, 14, "insufficient fee")
+	ErrTooManySignatures = Register(RootCodespace, 15, "maximum numer of signatures exceeded")
+	ErrGasOverflow       = Register(RootCodespace, 16, "gas overflow")
+	ErrNoSignatures      = Register(RootCodespace, 17, "no signatures supplied")
+	ErrOutOfBlockGas     = Register(RootCodespace, 18, "out of block gas")
+	ErrInvalidRequest    = Register(RootCodespace, 19, "invalid request")
+)
+
+// Error is a registered error, identified by its codespace and code.
+type Error struct {
+	codespace   string
+	code        uint32
+	description string
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.description
+}
+
+// Codespace returns the codespace of the error.
+func (e *Error) Codespace() string {
+	return e.codespace
+}
+
+// ABCICode returns the code of the error, as returned in ABCI responses.
+func (e *Error) ABCICode() uint32 {
+	return e.code
+}
+
+// ABCICodespace returns the codespace of the error, as returned in ABCI
+// responses.
+func (e *Error) ABCICodespace() string {
+	return e.codespace
+}
+
+// Description returns the description of the error.
+func (e *Error) Description() string {
+	return e.description
+}
+
+// Wrap wraps the error with the given description. See Wrap.
+func (e *Error) Wrap(description string) error {
+	return Wrap(e, description)
+}
+
+// Wrapf wraps the error with the given formatted description. See Wrapf.
+func (e *Error) Wrapf(format string, args ...interface{}) error {
+	return Wrapf(e, format, args...)
+}
+
+//----------------------------------------
+// Registry
+
+type registryKey struct {
+	codespace string
+	code      uint32
+}
+
+var (
+	registryMtx sync.RWMutex
+	registry    = make(map[registryKey]*Error)
+)
+
+// Register registers the error of the given code in the given codespace and
+// returns it. The code 0 is reserved to the success of the ABCI responses. It
+// panics if the code is 0 or already registered in the codespace, so that two
+// modules sharing a codespace cannot return the same code for different
+// errors.
+func Register(codespace string, code uint32, description string) *Error {
+	if code == 0 {
+		panic("error code 0 is reserved to success")
+	}
+
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	key := registryKey{codespace, code}
+	if _, ok := registry[key]; ok {
+		panic(fmt.Sprintf("error code %d has already been registered for codespace %s", code, codespace))
+	}
+	err := &Error{codespace: codespace, code: code, description: description}
+	registry[key] = err
+	return err
+}
+
+// Lookup returns the error registered with the given code in the given
+// codespace, if any.
+func Lookup(codespace string, code uint32) (*Error, bool) {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	err, ok := registry[registryKey{codespace, code}]
+	return err, ok
+}
+
+// Registered returns all the registered errors, sorted by codespace then by
+// code.
+func Registered() []*Error {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	errs := make([]*Error, 0, len(registry))
+	for _, err := range registry {
+		errs = append(errs, err)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		if errs[i].codespace != errs[j].codespace {
+			return errs[i].codespace < errs[j].codespace
+		}
+		return errs[i].code < errs[j].code
+	})
+	return errs
+}
@@ -0,0 +1,122 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	errSecond := Register("testregister", 2, "second")
+	errFirst := Register("testregister", 1, "first")
+	require.Panics(t, func() { Register("testregister", 1, "again") })
+	require.Panics(t, func() { Register("testregister", 0, "success") })
+
+	require.Equal(t, "testregister", errFirst.Codespace())
+	require.Equal(t, uint32(1), errFirst.ABCICode())
+	require.Equal(t, "first", errFirst.Error())
+
+	err, found := Lookup("testregister", 2)
+	require.True(t, found)
+	require.Equal(t, errSecond, err)
+	_, found = Lookup("testregister", 3)
+	require.False(t, found)
+
+	// the errors are sorted by codespace then by code
+	errs := Registered()
+	require.Equal(t, ErrInternal, errs[0])
+	var registered []*Error
+	for _, err := range errs {
+		if err.Codespace() == "testregister" {
+			registered = append(registered, err)
+		}
+	}
+	require.Equal(t, []*Error{errFirst, errSecond}, registered)
+}
+
+func TestWrap(t *testing.T) {
+	require.Nil(t, Wrap(nil, "nothing"))
+	require.Nil(t, Wrapf(nil, "nothing %d", 1))
+
+	err := Wrapf(ErrInsufficientFunds, "%s < %s", "10stake", "20stake")
+	require.Equal(t, "10stake < 20stake: insufficient funds", err.Error())
+	err = Wrap(err, "sending coins")
+	require.Equal(t, "sending coins: 10stake < 20stake: insufficient funds", err.Error())
+	require.Equal(t, ErrInsufficientFunds, Cause(err))
+	require.Equal(t, ErrInsufficientFunds, pkgerrors.Cause(err))
+
+	require.Equal(t, "invalid request: unauthorized", ErrUnauthorized.Wrap("invalid request").Error())
+	require.Equal(t, "key 1: unauthorized", ErrUnauthorized.Wrapf("key %d", 1).Error())
+}
+
+type codeError struct {
+	codespace string
+	code      uint32
+}
+
+func (e codeError) Error() string         { return fmt.Sprintf("%s %d", e.codespace, e.code) }
+func (e codeError) ABCICode() uint32      { return e.code }
+func (e codeError) ABCICodespace() string { return e.codespace }
+
+func TestIs(t *testing.T) {
+	std := stderrors.New("standard")
+
+	cases := []struct {
+		err, target error
+		is          bool
+	}{
+		{nil, nil, true},
+		{ErrInternal, nil, false},
+		{nil, ErrInternal, false},
+		{ErrUnauthorized, ErrUnauthorized, true},
+		{ErrUnauthorized, ErrInternal, false},
+		{Wrap(ErrUnauthorized, "wrapped"), ErrUnauthorized, true},
+		{Wrap(Wrap(ErrUnauthorized, "wrapped"), "twice"), ErrUnauthorized, true},
+		{pkgerrors.Wrap(Wrap(ErrUnauthorized, "wrapped"), "by pkg/errors"), ErrUnauthorized, true},
+		{Wrap(ErrUnauthorized, "wrapped"), ErrInternal, false},
+		{codeError{RootCodespace, 4}, ErrUnauthorized, true},
+		{Wrap(codeError{RootCodespace, 4}, "wrapped"), ErrUnauthorized, true},
+		{codeError{"other", 4}, ErrUnauthorized, false},
+		{Wrap(std, "wrapped"), std, true},
+		{std, stderrors.New("standard"), false},
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, tc.is, Is(tc.err, tc.target), "tc #%d: %v", i, tc.err)
+	}
+
+	require.True(t, stderrors.Is(Wrap(ErrUnauthorized, "wrapped"), ErrUnauthorized))
+}
+
+func TestABCIInfo(t *testing.T) {
+	cases := []struct {
+		err       error
+		debug     bool
+		codespace string
+		code      uint32
+		log       string
+	}{
+		{nil, false, "", 0, ""},
+		{ErrUnauthorized, false, "sdk", 4, `{"codespace":"sdk","code":4,"message":"unauthorized"}`},
+		{
+			Wrap(ErrInvalidCoins, "1Stake"), false, "sdk", 11,
+			`{"codespace":"sdk","code":11,"message":"1Stake: invalid coins"}`,
+		},
+		{
+			Wrap(codeError{"gov", 3}, "proposal"), false, "gov", 3,
+			`{"codespace":"gov","code":3,"message":"proposal: gov 3"}`,
+		},
+		{stderrors.New("at 0xc000123"), false, "sdk", 1, `{"codespace":"sdk","code":1,"message":"internal error"}`},
+		{stderrors.New("at 0xc000123"), true, "sdk", 1, `{"codespace":"sdk","code":1,"message":"at 0xc000123"}`},
+	}
+
+	for i, tc := range cases {
+		codespace, code, log := ABCIInfo(tc.err, tc.debug)
+		require.Equal(t, tc.codespace, codespace, "tc #%d", i)
+		require.Equal(t, tc.code, code, "tc #%d", i)
+		require.Equal(t, tc.log, log, "tc #%d", i)
+	}
+}
@@ -0,0 +1,88 @@
+package errors
+
+import (
+	"fmt"
+)
+
+// Wrap returns an error describing the failure of the given error, as
+// "description: error", which keeps its codespace and code. It returns nil if
+// the error is nil.
+func Wrap(err error, description string) error {
+	if err == nil {
+		return nil
+	}
+	return &wrappedError{msg: description, parent: err}
+}
+
+// Wrapf returns an error describing the failure of the given error with the
+// formatted description. See Wrap.
+func Wrapf(err error, format string, args ...interface{}) error {
+	return Wrap(err, fmt.Sprintf(format, args...))
+}
+
+type wrappedError struct {
+	msg    string
+	parent error
+}
+
+func (e *wrappedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.parent.Error())
+}
+
+// Cause returns the wrapped error, as expected by github.com/pkg/errors.
+func (e *wrappedError) Cause() error {
+	return e.parent
+}
+
+// Unwrap returns the wrapped error, as expected by the errors package of the
+// standard library.
+func (e *wrappedError) Unwrap() error {
+	return e.parent
+}
+
+// Cause returns the innermost error wrapped by the given error, with Wrap or
+// github.com/pkg/errors.
+func Cause(err error) error {
+	for {
+		parent := unwrap(err)
+		if parent == nil {
+			return err
+		}
+		err = parent
+	}
+}
+
+// Is returns whether the given error, or one of the errors it wraps, is the
+// target error. Errors with a codespace and a code, as registered errors, are
+// identified by them, so that an error restored e.g. from an ABCI response is
+// the registered error of its code.
+func Is(err, target error) bool {
+	if target == nil {
+		return err == nil
+	}
+	targetCode, targetHasCode := target.(coder)
+
+	for err != nil {
+		if err == target {
+			return true
+		}
+		if c, ok := err.(coder); ok && targetHasCode &&
+			c.ABCICodespace() == targetCode.ABCICodespace() && c.ABCICode() == targetCode.ABCICode() {
+			return true
+		}
+		err = unwrap(err)
+	}
+	return false
+}
+
+// unwrap returns the error wrapped by the given error, if any.
+func unwrap(err error) error {
+	switch e := err.(type) {
+	case interface{ Cause() error }:
+		return e.Cause()
+	case interface{ Unwrap() error }:
+		return e.Unwrap()
+	default:
+		return nil
+	}
+}
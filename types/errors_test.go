@@ -1,10 +1,13 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var codeTypes = []CodeType{
@@ -21,6 +24,12 @@ var codeTypes = []CodeType{
 	CodeInvalidCoins,
 	CodeOutOfGas,
 	CodeMemoTooLarge,
+	CodeInsufficientFee,
+	CodeTooManySignatures,
+	CodeGasOverflow,
+	CodeNoSignatures,
+	CodeOutOfBlockGas,
+	CodeInvalidRequest,
 }
 
 type errFn func(msg string) Error
@@ -39,6 +48,12 @@ var errFns = []errFn{
 	ErrInvalidCoins,
 	ErrOutOfGas,
 	ErrMemoTooLarge,
+	ErrInsufficientFee,
+	ErrTooManySignatures,
+	ErrGasOverflow,
+	ErrNoSignatures,
+	ErrOutOfBlockGas,
+	ErrInvalidRequest,
 }
 
 func TestCodeType(t *testing.T) {
@@ -88,3 +103,62 @@ func TestAppendMsgToErr(t *testing.T) {
 			fmt.Sprintf("Should have formatted the error message of ABCI Log. tc #%d", i))
 	}
 }
+
+func TestRegisteredCodes(t *testing.T) {
+	// the root codes are registered with their default message
+	for _, code := range codeTypes {
+		description, found := CodeDescription(CodespaceRoot, code)
+		require.True(t, found)
+		require.Equal(t, CodeToDefaultMsg(code), description)
+	}
+	_, found := CodeDescription(CodespaceRoot, CodeType(1000))
+	require.False(t, found)
+
+	var testCodespace CodespaceType = "test"
+	RegisterCode(testCodespace, CodeType(2), "second")
+	RegisterCode(testCodespace, CodeType(1), "first")
+	require.Panics(t, func() { RegisterCode(testCodespace, CodeType(1), "again") })
+
+	description, found := CodeDescription(testCodespace, CodeType(1))
+	require.True(t, found)
+	require.Equal(t, "first", description)
+
+	// the codes are sorted by codespace then code
+	codes := RegisteredCodes()
+	require.Equal(t, ErrorCode{CodespaceRoot, CodeInternal, "internal error"}, codes[0])
+	require.Equal(t, []ErrorCode{
+		{testCodespace, CodeType(1), "first"},
+		{testCodespace, CodeType(2), "second"},
+	}, codes[len(codes)-2:])
+}
+
+func TestConvertError(t *testing.T) {
+	require.Nil(t, ConvertError(nil))
+
+	sdkErr := ErrUnauthorized("signature verification failed")
+	require.Equal(t, sdkErr, ConvertError(sdkErr))
+	require.True(t, sdkerrors.Is(sdkErr, sdkerrors.ErrUnauthorized))
+
+	err := ConvertError(sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "10stake, 20stake required"))
+	require.Equal(t, CodespaceRoot, err.Codespace())
+	require.Equal(t, CodeInsufficientFunds, err.Code())
+	require.Equal(t,
+		`{"codespace":"sdk","code":5,"message":"10stake, 20stake required: insufficient funds"}`,
+		err.ABCILog())
+
+	err = ConvertError(errors.New("unexpected"))
+	require.Equal(t, CodeInternal, err.Code())
+	require.Contains(t, err.ABCILog(), "unexpected")
+
+	res := ResultFromError(sdkerrors.ErrMemoTooLarge)
+	require.Equal(t, CodeMemoTooLarge, res.Code)
+	require.Equal(t, CodespaceRoot, res.Codespace)
+}
+
+func TestRootCodesMatchErrorsPackage(t *testing.T) {
+	for _, code := range codeTypes {
+		err, found := sdkerrors.Lookup(sdkerrors.RootCodespace, uint32(code))
+		require.True(t, found)
+		require.Equal(t, CodeToDefaultMsg(code), err.Description())
+	}
+}
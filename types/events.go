@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Event is a typed event emitted during the execution of a message, or during
+// BeginBlock and EndBlock. Its attributes are namespaced by its type.
+type Event struct {
+	Type       string      `json:"type"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is a key/value pair of an event.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewEvent creates a new event of the given type with the given attributes.
+func NewEvent(ty string, attrs ...Attribute) Event {
+	return Event{Type: ty, Attributes: attrs}
+}
+
+// NewAttribute creates a new event attribute.
+func NewAttribute(k, v string) Attribute {
+	return Attribute{Key: k, Value: v}
+}
+
+// AppendAttributes returns the event with the given attributes appended.
+func (e Event) AppendAttributes(attrs ...Attribute) Event {
+	e.Attributes = append(e.Attributes, attrs...)
+	return e
+}
+
+// String implements fmt.Stringer.
+func (a Attribute) String() string {
+	return fmt.Sprintf("%s: %s", a.Key, a.Value)
+}
+
+// Events is a list of events.
+type Events []Event
+
+// EmptyEvents returns an empty list of events.
+func EmptyEvents() Events {
+	return make(Events, 0)
+}
+
+// AppendEvent returns the events with the given event appended.
+func (e Events) AppendEvent(event Event) Events {
+	return append(e, event)
+}
+
+// AppendEvents returns the events with the given events appended.
+func (e Events) AppendEvents(events Events) Events {
+	return append(e, events...)
+}
+
+// ToTags converts the events to tags, each attribute giving a tag whose key is
+// namespaced by the event type, as in "type.key". Tendermint indexes and
+// publishes them as the events of the block or transaction.
+func (e Events) ToTags() Tags {
+	tags := EmptyTags()
+	for _, event := range e {
+		for _, attr := range event.Attributes {
+			tags = tags.AppendTag(fmt.Sprintf("%s.%s", event.Type, attr.Key), []byte(attr.Value))
+		}
+	}
+	return tags
+}
+
+// EventManager collects the events emitted by handlers and keepers. A new
+// event manager is set on the context of each message, and of BeginBlock and
+// EndBlock.
+type EventManager struct {
+	events Events
+}
+
+// NewEventManager returns a new, empty, event manager.
+func NewEventManager() *EventManager {
+	return &EventManager{EmptyEvents()}
+}
+
+// Events returns the events emitted so far.
+func (em *EventManager) Events() Events {
+	return em.events
+}
+
+// EmitEvent stores a single event.
+func (em *EventManager) EmitEvent(event Event) {
+	em.events = em.events.AppendEvent(event)
+}
+
+// EmitEvents stores a list of events.
+func (em *EventManager) EmitEvents(events Events) {
+	em.events = em.events.AppendEvents(events)
+}
+
+//__________________________________________________
+
+// common events and attributes
+var (
+	EventTypeMessage = "message"
+
+	AttributeKeyAction = "action"
+	AttributeKeyModule = "module"
+	AttributeKeySender = "sender"
+)
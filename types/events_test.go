@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendEvents(t *testing.T) {
+	e1 := NewEvent("transfer", NewAttribute("sender", "foo"))
+	e2 := NewEvent("transfer", NewAttribute("recipient", "bar"))
+	a := Events{e1}
+	b := Events{e2}
+	c := a.AppendEvents(b)
+	require.Equal(t, c, Events{e1, e2})
+	require.Equal(t, c, Events{e1}.AppendEvent(e2))
+
+	e := e1.AppendAttributes(NewAttribute("amount", "10atom"))
+	require.Equal(t, e, NewEvent("transfer", NewAttribute("sender", "foo"), NewAttribute("amount", "10atom")))
+}
+
+func TestEventsToTags(t *testing.T) {
+	events := Events{
+		NewEvent("transfer", NewAttribute("sender", "foo"), NewAttribute("recipient", "bar")),
+		NewEvent("message", NewAttribute("action", "send")),
+	}
+	require.Equal(t, Tags{
+		MakeTag("transfer.sender", []byte("foo")),
+		MakeTag("transfer.recipient", []byte("bar")),
+		MakeTag("message.action", []byte("send")),
+	}, events.ToTags())
+	require.Equal(t, Tags{}, EmptyEvents().ToTags())
+}
+
+func TestEventManager(t *testing.T) {
+	em := NewEventManager()
+	event := NewEvent("reward", NewAttribute("x", "y"))
+	events := Events{NewEvent("transfer", NewAttribute("sender", "foo"))}
+
+	em.EmitEvents(events)
+	em.EmitEvent(event)
+
+	require.Len(t, em.Events(), 2)
+	require.Equal(t, em.Events(), events.AppendEvent(event))
+}
@@ -0,0 +1,18 @@
+package types
+
+//_______________________________________________________________________________
+// Event Hooks
+// These can be utilized to communicate between a governance keeper and another
+// keeper which must take particular actions when proposals change state. The
+// second keeper must implement this interface, which then the governance
+// keeper can call.
+
+// event hooks for governance proposals
+type GovHooks interface {
+	AfterProposalSubmission(ctx Context, proposalID uint64)                        // Must be called when a proposal is submitted
+	AfterProposalDeposit(ctx Context, proposalID uint64, depositorAddr AccAddress) // Must be called when a deposit is added to a proposal
+	AfterProposalVote(ctx Context, proposalID uint64, voterAddr AccAddress)        // Must be called when a vote is cast on a proposal
+
+	AfterProposalFailed(ctx Context, proposalID uint64) // Called when a proposal is dropped, rejected or cancelled
+	AfterProposalPassed(ctx Context, proposalID uint64) // Called at EndBlock when a proposal passes, after its content is executed
+}
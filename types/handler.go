@@ -6,3 +6,18 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// PostHandler runs after the messages of a transaction in DeliverTx, whether
+// they succeeded or not, given their result. Its state changes are persisted
+// unless it aborts, and it never changes the result of the transaction.
+type PostHandler func(ctx Context, tx Tx, result Result) (abort bool)
+
+// MsgDispatcher executes messages on behalf of a module, routing them to the
+// handler of the module they belong to. It lets a module execute the messages
+// of another module without importing it.
+type MsgDispatcher interface {
+	// DispatchMsg executes a message, which must only be signed by the
+	// account of the dispatching module. The state changes of a failed
+	// message are discarded.
+	DispatchMsg(ctx Context, msg Msg) Result
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"testing"
 
@@ -157,6 +158,11 @@ func (i Int) IsInt64() bool {
 	return i.i.IsInt64()
 }
 
+// IsNil returns true if Int is uninitialized
+func (i Int) IsNil() bool {
+	return i.i == nil
+}
+
 // IsZero returns true if Int is zero
 func (i Int) IsZero() bool {
 	return i.i.Sign() == 0
@@ -325,7 +331,8 @@ func (i *Int) UnmarshalJSON(bz []byte) error {
 	return unmarshalJSON(i.i, bz)
 }
 
-// Int wraps integer with 256 bit range bound
+// Uint wraps unsigned integer with 256 bit range bound, for the quantities
+// which must never be negative, e.g. supplies and sequences.
 // Checks overflow, underflow and division by zero
 // Exists in range from 0 to 2^256-1
 type Uint struct {
@@ -430,6 +437,16 @@ func (i Uint) LT(i2 Uint) bool {
 	return lt(i.i, i2.i)
 }
 
+// GTE returns true if first Uint is greater than or equal to second
+func (i Uint) GTE(i2 Uint) bool {
+	return !i.LT(i2)
+}
+
+// LTE returns true if first Uint is lesser than or equal to second
+func (i Uint) LTE(i2 Uint) bool {
+	return !i.GT(i2)
+}
+
 // Add adds Uint from another
 func (i Uint) Add(i2 Uint) (res Uint) {
 	res = Uint{add(i.i, i2.i)}
@@ -439,6 +456,13 @@ func (i Uint) Add(i2 Uint) (res Uint) {
 	return
 }
 
+// SafeAdd attempts to add one Uint to another. A boolean is also returned
+// indicating if the result contains integer overflow.
+func (i Uint) SafeAdd(i2 Uint) (Uint, bool) {
+	res := Uint{add(i.i, i2.i)}
+	return res, UintOverflow(res)
+}
+
 // AddRaw adds uint64 to Uint
 func (i Uint) AddRaw(i2 uint64) Uint {
 	return i.Add(NewUint(i2))
@@ -448,7 +472,7 @@ func (i Uint) AddRaw(i2 uint64) Uint {
 func (i Uint) Sub(i2 Uint) (res Uint) {
 	res = Uint{sub(i.i, i2.i)}
 	if UintOverflow(res) {
-		panic("Uint overflow")
+		panic("Uint underflow")
 	}
 	return
 }
@@ -483,6 +507,13 @@ func (i Uint) Mul(i2 Uint) (res Uint) {
 	return
 }
 
+// SafeMul attempts to multiply two Uints. A boolean is also returned
+// indicating if the result contains integer overflow.
+func (i Uint) SafeMul(i2 Uint) (Uint, bool) {
+	res := Uint{mul(i.i, i2.i)}
+	return res, UintOverflow(res)
+}
+
 // MulRaw multipies Uint and uint64
 func (i Uint) MulRaw(i2 uint64) Uint {
 	return i.Mul(NewUint(i2))
@@ -548,7 +579,10 @@ func (i *Uint) UnmarshalAmino(text string) error {
 	if i.i == nil { // Necessary since default Uint initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalAmino(i.i, text)
+	if err := unmarshalAmino(i.i, text); err != nil {
+		return err
+	}
+	return checkUintRange(*i)
 }
 
 // MarshalJSON defines custom encoding scheme
@@ -564,7 +598,20 @@ func (i *Uint) UnmarshalJSON(bz []byte) error {
 	if i.i == nil { // Necessary since default Uint initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalJSON(i.i, bz)
+	if err := unmarshalJSON(i.i, bz); err != nil {
+		return err
+	}
+	return checkUintRange(*i)
+}
+
+// checkUintRange returns an error if the decoded Uint is negative or
+// overflows, so that such values are rejected when decoded rather than
+// when used.
+func checkUintRange(i Uint) error {
+	if UintOverflow(i) {
+		return fmt.Errorf("Uint %s is out of range [0, 2^256-1]", i)
+	}
+	return nil
 }
 
 //__________________________________________________________________________
@@ -656,3 +656,44 @@ func TestAddUint64Overflow(t *testing.T) {
 		)
 	}
 }
+
+func TestSafeAddMulUint(t *testing.T) {
+	uintmax := NewUintFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil), big.NewInt(1)))
+	half := NewUintFromBigInt(new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil))
+
+	res, overflow := NewUint(2).SafeAdd(NewUint(3))
+	require.False(t, overflow)
+	require.Equal(t, uint64(5), res.Uint64())
+	_, overflow = uintmax.SafeAdd(ZeroUint())
+	require.False(t, overflow)
+	_, overflow = uintmax.SafeAdd(OneUint())
+	require.True(t, overflow)
+
+	res, overflow = NewUint(2).SafeMul(NewUint(3))
+	require.False(t, overflow)
+	require.Equal(t, uint64(6), res.Uint64())
+	_, overflow = half.SafeMul(half.SubRaw(1))
+	require.False(t, overflow)
+	_, overflow = half.SafeMul(half)
+	require.True(t, overflow)
+
+	require.True(t, NewUint(2).GTE(NewUint(2)))
+	require.True(t, NewUint(3).GTE(NewUint(2)))
+	require.False(t, NewUint(1).GTE(NewUint(2)))
+	require.True(t, NewUint(2).LTE(NewUint(2)))
+	require.False(t, NewUint(3).LTE(NewUint(2)))
+}
+
+func TestUintUnmarshalRange(t *testing.T) {
+	var u Uint
+	require.NoError(t, u.UnmarshalJSON([]byte(`"1000"`)))
+	require.Equal(t, uint64(1000), u.Uint64())
+	require.Error(t, u.UnmarshalJSON([]byte(`"-1"`)))
+
+	tooLarge := new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil).String()
+	require.Error(t, u.UnmarshalJSON([]byte(`"`+tooLarge+`"`)))
+	require.Error(t, u.UnmarshalAmino(tooLarge))
+	require.Error(t, u.UnmarshalAmino("-5"))
+	require.NoError(t, u.UnmarshalAmino("5"))
+	require.Equal(t, uint64(5), u.Uint64())
+}
@@ -0,0 +1,11 @@
+package types
+
+// An Invariant is a function which tests a particular invariant.
+// If the invariant has been broken, it should return an error
+// containing a descriptive message about what happened.
+type Invariant func(ctx Context) error
+
+// InvariantRouter registers the invariants of the modules under a route.
+type InvariantRouter interface {
+	RegisterRoute(moduleName, route string, invar Invariant)
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// GenesisMigration migrates the genesis state of a module from the schema of
+// the previous release to the current one, with the codec of the application.
+// The state is nil if the module did not exist in the previous release.
+type GenesisMigration func(cdc *codec.Codec, state json.RawMessage) (json.RawMessage, error)
+
+// GenesisMigrator migrates the genesis state of an application from the
+// schema of the previous release, module by module, with the migrations
+// registered by the modules whose genesis state changed.
+type GenesisMigrator struct {
+	migrations map[string]GenesisMigration
+
+	// current names of the modules renamed since the previous release, keyed
+	// by their previous name
+	renames map[string]string
+}
+
+// NewGenesisMigrator creates a GenesisMigrator without migrations.
+func NewGenesisMigrator() *GenesisMigrator {
+	return &GenesisMigrator{
+		migrations: make(map[string]GenesisMigration),
+		renames:    make(map[string]string),
+	}
+}
+
+// RegisterMigration registers the migration of the genesis state of a module.
+// It panics if a migration is already registered for the module.
+func (m *GenesisMigrator) RegisterMigration(module string, migration GenesisMigration) {
+	if _, ok := m.migrations[module]; ok {
+		panic(fmt.Sprintf("genesis migration of module %s has already been registered", module))
+	}
+	m.migrations[module] = migration
+}
+
+// RenameModule registers that the genesis state of a module was keyed by
+// another name in the previous release. It panics if the previous name is
+// already renamed.
+func (m *GenesisMigrator) RenameModule(previous, current string) {
+	if _, ok := m.renames[previous]; ok {
+		panic(fmt.Sprintf("module %s has already been renamed", previous))
+	}
+	m.renames[previous] = current
+}
+
+// Migrate migrates the genesis state of an application, keyed by module name.
+// The renamed modules are moved to their current name first, then the
+// migration of each module is run on its state, in the order of the module
+// names. The state of the modules without migration is kept unchanged.
+func (m *GenesisMigrator) Migrate(cdc *codec.Codec, appState map[string]json.RawMessage) (
+	map[string]json.RawMessage, error) {
+
+	migrated := make(map[string]json.RawMessage, len(appState))
+	for name, state := range appState {
+		migrated[name] = state
+	}
+
+	for previous, current := range m.renames {
+		state, ok := migrated[previous]
+		if !ok {
+			continue
+		}
+		if _, ok := migrated[current]; ok {
+			return nil, fmt.Errorf("genesis state has both module %s and its previous name %s", current, previous)
+		}
+		migrated[current] = state
+		delete(migrated, previous)
+	}
+
+	names := make([]string, 0, len(m.migrations))
+	for name := range m.migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		state, err := m.migrations[name](cdc, migrated[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate the genesis state of module %s: %v", name, err)
+		}
+		migrated[name] = state
+	}
+	return migrated, nil
+}
+
+// DefaultsGenesisMigration returns the genesis migration filling the fields a
+// genesis state lacks from the given default genesis state, or setting the
+// genesis state of a new module to it.
+func DefaultsGenesisMigration(defaultState interface{}) GenesisMigration {
+	return func(cdc *codec.Codec, state json.RawMessage) (json.RawMessage, error) {
+		defaults, err := cdc.MarshalJSON(defaultState)
+		if err != nil {
+			return nil, err
+		}
+		return FillJSONDefaults(state, defaults)
+	}
+}
+
+// FillJSONDefaults returns the JSON object with the fields of the defaults
+// object it lacks, recursively into the objects both have. It fills the
+// fields added to a genesis state since the previous release. The other
+// values, including the arrays, are kept unchanged.
+func FillJSONDefaults(state, defaults json.RawMessage) (json.RawMessage, error) {
+	if isJSONNull(state) {
+		return defaults, nil
+	}
+
+	var stateFields, defaultFields map[string]json.RawMessage
+	if err := json.Unmarshal(state, &stateFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(defaults, &defaultFields); err != nil {
+		return nil, err
+	}
+
+	for name, value := range defaultFields {
+		field, ok := stateFields[name]
+		if !ok {
+			stateFields[name] = value
+			continue
+		}
+		if !isJSONObject(field) || !isJSONObject(value) {
+			continue
+		}
+		filled, err := FillJSONDefaults(field, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		stateFields[name] = filled
+	}
+	return json.Marshal(stateFields)
+}
+
+// MergeJSON returns the JSON object with the fields of the patch object set,
+// recursively into the objects both have. The other values of the patch,
+// including the arrays, replace the ones of the object. It applies a partial
+// update to a genesis state.
+func MergeJSON(state, patch json.RawMessage) (json.RawMessage, error) {
+	if !isJSONObject(state) || !isJSONObject(patch) {
+		return patch, nil
+	}
+
+	var stateFields, patchFields map[string]json.RawMessage
+	if err := json.Unmarshal(state, &stateFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &patchFields); err != nil {
+		return nil, err
+	}
+
+	for name, value := range patchFields {
+		merged, err := MergeJSON(stateFields[name], value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		stateFields[name] = merged
+	}
+	return json.Marshal(stateFields)
+}
+
+func isJSONNull(bz json.RawMessage) bool {
+	bz = bytes.TrimSpace(bz)
+	return len(bz) == 0 || bytes.Equal(bz, []byte("null"))
+}
+
+func isJSONObject(bz json.RawMessage) bool {
+	bz = bytes.TrimSpace(bz)
+	return len(bz) > 0 && bz[0] == '{'
+}
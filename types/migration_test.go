@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func TestFillJSONDefaults(t *testing.T) {
+	cases := []struct {
+		state    string
+		defaults string
+		want     string
+		wantErr  bool
+	}{
+		{`null`, `{"a":1}`, `{"a":1}`, false},
+		{``, `{"a":1}`, `{"a":1}`, false},
+		{`{"a":2}`, `{"a":1,"b":"1"}`, `{"a":2,"b":"1"}`, false},
+		{`{"a":{"c":2}}`, `{"a":{"c":1,"d":[1]}}`, `{"a":{"c":2,"d":[1]}}`, false},
+		{`{"a":[{"c":2}]}`, `{"a":[{"c":1,"d":1}]}`, `{"a":[{"c":2}]}`, false},
+		{`{"a":null}`, `{"a":{"c":1}}`, `{"a":null}`, false},
+		{`{"a":123456789012345678901234567890}`, `{"a":1}`, `{"a":123456789012345678901234567890}`, false},
+		{`[1]`, `{"a":1}`, ``, true},
+		{`{"a":{"c":1}}`, `{"a":{"c":1},"b":`, ``, true},
+	}
+
+	for i, tc := range cases {
+		got, err := FillJSONDefaults(json.RawMessage(tc.state), json.RawMessage(tc.defaults))
+		if tc.wantErr {
+			require.Error(t, err, "tc #%d", i)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", i)
+		require.JSONEq(t, tc.want, string(got), "tc #%d", i)
+	}
+}
+
+func TestMergeJSON(t *testing.T) {
+	cases := []struct {
+		state   string
+		patch   string
+		want    string
+		wantErr bool
+	}{
+		{`{"a":1,"b":"1"}`, `{"a":2}`, `{"a":2,"b":"1"}`, false},
+		{`{"a":{"c":1,"d":[1]}}`, `{"a":{"d":[2,3]}}`, `{"a":{"c":1,"d":[2,3]}}`, false},
+		{`{"a":[{"c":1,"d":1}]}`, `{"a":[{"c":2}]}`, `{"a":[{"c":2}]}`, false},
+		{`{"a":null}`, `{"a":{"c":1}}`, `{"a":{"c":1}}`, false},
+		{`{"a":{"c":1}}`, `{"a":null}`, `{"a":null}`, false},
+		{`{"a":1}`, `{"b":{"c":1}}`, `{"a":1,"b":{"c":1}}`, false},
+		{`[1]`, `{"a":1}`, `{"a":1}`, false},
+		{`{"a":1}`, `{"a":1,"b":`, ``, true},
+	}
+
+	for i, tc := range cases {
+		got, err := MergeJSON(json.RawMessage(tc.state), json.RawMessage(tc.patch))
+		if tc.wantErr {
+			require.Error(t, err, "tc #%d", i)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", i)
+		require.JSONEq(t, tc.want, string(got), "tc #%d", i)
+	}
+}
+
+func TestGenesisMigrator(t *testing.T) {
+	cdc := codec.New()
+	m := NewGenesisMigrator()
+	m.RenameModule("old", "renamed")
+	m.RegisterMigration("renamed", DefaultsGenesisMigration(map[string]string{"a": "1", "b": "2"}))
+	m.RegisterMigration("new", DefaultsGenesisMigration(map[string]string{"c": "3"}))
+	require.Panics(t, func() { m.RenameModule("old", "other") })
+	require.Panics(t, func() { m.RegisterMigration("new", DefaultsGenesisMigration(nil)) })
+
+	appState := map[string]json.RawMessage{
+		"old":       json.RawMessage(`{"a":0}`),
+		"unchanged": json.RawMessage(`{"d":4}`),
+	}
+	migrated, err := m.Migrate(cdc, appState)
+	require.NoError(t, err)
+	require.Len(t, migrated, 3)
+	require.JSONEq(t, `{"a":0,"b":"2"}`, string(migrated["renamed"]))
+	require.JSONEq(t, `{"c":"3"}`, string(migrated["new"]))
+	require.JSONEq(t, `{"d":4}`, string(migrated["unchanged"]))
+	// the given genesis state is left unchanged
+	require.Len(t, appState, 2)
+
+	appState["renamed"] = json.RawMessage(`{}`)
+	_, err = m.Migrate(cdc, appState)
+	require.Error(t, err)
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// AppModule is the interface an application module implements to have its
+// lifecycle driven by a ModuleManager.
+type AppModule interface {
+	// name of the module, also the key of its genesis state
+	Name() string
+
+	// register the invariants of the module
+	RegisterInvariants(InvariantRouter)
+
+	// initialize the module from its genesis state, returning the initial
+	// validator set updates if any
+	InitGenesis(ctx Context, data json.RawMessage) []abci.ValidatorUpdate
+
+	// export the state of the module as a genesis state
+	ExportGenesis(ctx Context) json.RawMessage
+
+	// run code before the transactions in a block
+	BeginBlock(ctx Context, req abci.RequestBeginBlock) Tags
+
+	// run code after the transactions in a block, returning the validator
+	// set updates if any
+	EndBlock(ctx Context, req abci.RequestEndBlock) ([]abci.ValidatorUpdate, Tags)
+}
+
+// ModuleManager calls the lifecycle methods of the modules of an application
+// in explicit orders, which default to the order of registration.
+type ModuleManager struct {
+	Modules            map[string]AppModule
+	OrderInitGenesis   []string
+	OrderExportGenesis []string
+	OrderBeginBlockers []string
+	OrderEndBlockers   []string
+
+	// names of the modules in the order of registration
+	moduleNames []string
+}
+
+// NewModuleManager creates a ModuleManager for the given modules.
+// It panics if two modules have the same name.
+func NewModuleManager(modules ...AppModule) *ModuleManager {
+	moduleMap := make(map[string]AppModule, len(modules))
+	var names []string
+	for _, module := range modules {
+		name := module.Name()
+		if _, ok := moduleMap[name]; ok {
+			panic(fmt.Sprintf("module %s has already been registered", name))
+		}
+		moduleMap[name] = module
+		names = append(names, name)
+	}
+
+	return &ModuleManager{
+		Modules:            moduleMap,
+		OrderInitGenesis:   names,
+		OrderExportGenesis: names,
+		OrderBeginBlockers: names,
+		OrderEndBlockers:   names,
+		moduleNames:        names,
+	}
+}
+
+// SetOrderInitGenesis sets the order in which the genesis of the modules is
+// initialized. Modules left out are not initialized.
+func (mm *ModuleManager) SetOrderInitGenesis(moduleNames ...string) {
+	mm.assertRegistered(moduleNames)
+	mm.OrderInitGenesis = moduleNames
+}
+
+// SetOrderExportGenesis sets the order in which the genesis of the modules is
+// exported. Modules left out are not exported.
+func (mm *ModuleManager) SetOrderExportGenesis(moduleNames ...string) {
+	mm.assertRegistered(moduleNames)
+	mm.OrderExportGenesis = moduleNames
+}
+
+// SetOrderBeginBlockers sets the order in which the modules begin a block.
+// Modules left out are not called.
+func (mm *ModuleManager) SetOrderBeginBlockers(moduleNames ...string) {
+	mm.assertRegistered(moduleNames)
+	mm.OrderBeginBlockers = moduleNames
+}
+
+// SetOrderEndBlockers sets the order in which the modules end a block.
+// Modules left out are not called.
+func (mm *ModuleManager) SetOrderEndBlockers(moduleNames ...string) {
+	mm.assertRegistered(moduleNames)
+	mm.OrderEndBlockers = moduleNames
+}
+
+func (mm *ModuleManager) assertRegistered(moduleNames []string) {
+	for _, name := range moduleNames {
+		if _, ok := mm.Modules[name]; !ok {
+			panic(fmt.Sprintf("module %s is not registered", name))
+		}
+	}
+}
+
+// RegisterInvariants registers the invariants of all the modules, in the order
+// of registration.
+func (mm *ModuleManager) RegisterInvariants(invarRouter InvariantRouter) {
+	for _, name := range mm.moduleNames {
+		mm.Modules[name].RegisterInvariants(invarRouter)
+	}
+}
+
+// InitGenesis initializes the modules from the genesis state of each, keyed
+// by module name. It panics if more than one module returns validator updates.
+func (mm *ModuleManager) InitGenesis(ctx Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, name := range mm.OrderInitGenesis {
+		moduleValUpdates := mm.Modules[name].InitGenesis(ctx, genesisData[name])
+		if len(moduleValUpdates) == 0 {
+			continue
+		}
+		if len(validatorUpdates) > 0 {
+			panic("validator InitGenesis updates already set by a previous module")
+		}
+		validatorUpdates = moduleValUpdates
+	}
+
+	return abci.ResponseInitChain{
+		Validators: validatorUpdates,
+	}
+}
+
+// ExportGenesis exports the genesis state of each module, keyed by module name.
+func (mm *ModuleManager) ExportGenesis(ctx Context) map[string]json.RawMessage {
+	genesisData := make(map[string]json.RawMessage, len(mm.OrderExportGenesis))
+	for _, name := range mm.OrderExportGenesis {
+		genesisData[name] = mm.Modules[name].ExportGenesis(ctx)
+	}
+	return genesisData
+}
+
+// BeginBlock calls the begin blocker of the modules, merging their tags.
+func (mm *ModuleManager) BeginBlock(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	tags := EmptyTags()
+	for _, name := range mm.OrderBeginBlockers {
+		tags = tags.AppendTags(mm.Modules[name].BeginBlock(ctx, req))
+	}
+
+	return abci.ResponseBeginBlock{
+		Tags: tags.ToKVPairs(),
+	}
+}
+
+// EndBlock calls the end blocker of the modules, merging their tags. It panics
+// if more than one module returns validator updates.
+func (mm *ModuleManager) EndBlock(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	var validatorUpdates []abci.ValidatorUpdate
+	tags := EmptyTags()
+	for _, name := range mm.OrderEndBlockers {
+		moduleValUpdates, moduleTags := mm.Modules[name].EndBlock(ctx, req)
+		tags = tags.AppendTags(moduleTags)
+
+		if len(moduleValUpdates) == 0 {
+			continue
+		}
+		if len(validatorUpdates) > 0 {
+			panic("validator EndBlock updates already set by a previous module")
+		}
+		validatorUpdates = moduleValUpdates
+	}
+
+	return abci.ResponseEndBlock{
+		ValidatorUpdates: validatorUpdates,
+		Tags:             tags,
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// testModule records the calls to its lifecycle methods
+type testModule struct {
+	name       string
+	calls      *[]string
+	valUpdates []abci.ValidatorUpdate
+}
+
+func (tm testModule) Name() string { return tm.name }
+
+func (tm testModule) RegisterInvariants(ir InvariantRouter) {
+	ir.RegisterRoute(tm.name, "invariant", func(_ Context) error { return nil })
+}
+
+func (tm testModule) InitGenesis(_ Context, data json.RawMessage) []abci.ValidatorUpdate {
+	*tm.calls = append(*tm.calls, "init:"+tm.name+":"+string(data))
+	return tm.valUpdates
+}
+
+func (tm testModule) ExportGenesis(_ Context) json.RawMessage {
+	return json.RawMessage(`"` + tm.name + `"`)
+}
+
+func (tm testModule) BeginBlock(_ Context, _ abci.RequestBeginBlock) Tags {
+	*tm.calls = append(*tm.calls, "begin:"+tm.name)
+	return NewTags("module", []byte(tm.name))
+}
+
+func (tm testModule) EndBlock(_ Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, Tags) {
+	*tm.calls = append(*tm.calls, "end:"+tm.name)
+	return tm.valUpdates, NewTags("module", []byte(tm.name))
+}
+
+type testInvariantRouter []string
+
+func (ir *testInvariantRouter) RegisterRoute(moduleName, route string, _ Invariant) {
+	*ir = append(*ir, moduleName+"/"+route)
+}
+
+func TestModuleManagerOrders(t *testing.T) {
+	var calls []string
+	valUpdates := []abci.ValidatorUpdate{{Power: 10}}
+	mm := NewModuleManager(
+		testModule{name: "a", calls: &calls},
+		testModule{name: "b", calls: &calls, valUpdates: valUpdates},
+		testModule{name: "c", calls: &calls},
+	)
+	mm.SetOrderInitGenesis("c", "b")
+	mm.SetOrderBeginBlockers("b", "a")
+	mm.SetOrderEndBlockers("c", "b", "a")
+	mm.SetOrderExportGenesis("a", "c")
+
+	var ir testInvariantRouter
+	mm.RegisterInvariants(&ir)
+	require.Equal(t, testInvariantRouter{"a/invariant", "b/invariant", "c/invariant"}, ir)
+
+	ctx := Context{}
+	res := mm.InitGenesis(ctx, map[string]json.RawMessage{"a": []byte("1"), "b": []byte("2"), "c": []byte("3")})
+	require.Equal(t, valUpdates, res.Validators)
+	require.Equal(t, []string{"init:c:3", "init:b:2"}, calls)
+
+	calls = nil
+	beginRes := mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, []string{"begin:b", "begin:a"}, calls)
+	require.Equal(t, NewTags("module", []byte("b"), "module", []byte("a")).ToKVPairs(), beginRes.Tags)
+
+	calls = nil
+	endRes := mm.EndBlock(ctx, abci.RequestEndBlock{})
+	require.Equal(t, []string{"end:c", "end:b", "end:a"}, calls)
+	require.Equal(t, valUpdates, endRes.ValidatorUpdates)
+	require.Equal(t, NewTags("module", []byte("c"), "module", []byte("b"), "module", []byte("a")).ToKVPairs(), endRes.Tags)
+
+	genesisData := mm.ExportGenesis(ctx)
+	require.Equal(t, map[string]json.RawMessage{"a": []byte(`"a"`), "c": []byte(`"c"`)}, genesisData)
+}
+
+func TestModuleManagerPanics(t *testing.T) {
+	var calls []string
+	valUpdates := []abci.ValidatorUpdate{{Power: 10}}
+
+	// duplicate module names
+	require.Panics(t, func() {
+		NewModuleManager(testModule{name: "a", calls: &calls}, testModule{name: "a", calls: &calls})
+	})
+
+	// unregistered module in an order
+	mm := NewModuleManager(testModule{name: "a", calls: &calls})
+	require.Panics(t, func() { mm.SetOrderBeginBlockers("a", "b") })
+
+	// validator updates returned by more than one module
+	mm = NewModuleManager(
+		testModule{name: "a", calls: &calls, valUpdates: valUpdates},
+		testModule{name: "b", calls: &calls, valUpdates: valUpdates},
+	)
+	require.Panics(t, func() { mm.InitGenesis(Context{}, map[string]json.RawMessage{}) })
+	require.Panics(t, func() { mm.EndBlock(Context{}, abci.RequestEndBlock{}) })
+}
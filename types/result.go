@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/json"
+)
+
 // Result is the union of ResponseDeliverTx and ResponseCheckTx.
 type Result struct {
 
@@ -13,6 +17,7 @@ type Result struct {
 	Data []byte
 
 	// Log is just debug information. NOTE: nondeterministic.
+	// The log of a transaction is the JSON encoding of its ABCIMessageLogs.
 	Log string
 
 	// GasWanted is the maximum units of work we allow this tx to perform.
@@ -27,9 +32,43 @@ type Result struct {
 
 	// Tags are used for transaction indexing and pubsub.
 	Tags Tags
+
+	// Events are the typed events emitted by the messages, namespaced by
+	// their type when converted to tags.
+	Events Events
 }
 
 // TODO: In the future, more codes may be OK.
 func (res Result) IsOK() bool {
 	return res.Code.IsOK()
 }
+
+// ABCIMessageLog is the result of a message of a transaction
+type ABCIMessageLog struct {
+	MsgIndex int    `json:"msg_index"`
+	Success  bool   `json:"success"`
+	Log      string `json:"log"`
+	Data     []byte `json:"data,omitempty"`
+	Events   Events `json:"events,omitempty"`
+}
+
+// ABCIMessageLogs are the results of the messages of a transaction, up to the
+// first failed one
+type ABCIMessageLogs []ABCIMessageLog
+
+// String returns the JSON encoding of the logs, used as the log of the
+// transaction.
+func (logs ABCIMessageLogs) String() string {
+	bz, err := json.Marshal(logs)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// ParseABCILogs parses the log of a transaction into the results of its
+// messages
+func ParseABCILogs(log string) (logs ABCIMessageLogs, err error) {
+	err = json.Unmarshal([]byte(log), &logs)
+	return logs, err
+}
@@ -16,3 +16,16 @@ func TestResult(t *testing.T) {
 	res.Code = CodeType(1)
 	require.False(t, res.IsOK())
 }
+
+func TestParseABCILogs(t *testing.T) {
+	logs := ABCIMessageLogs{
+		{MsgIndex: 0, Success: true, Log: "log", Data: []byte("data"), Events: Events{NewEvent("transfer", NewAttribute("sender", "foo"))}},
+		{MsgIndex: 1, Success: false, Log: "failed"},
+	}
+	res, err := ParseABCILogs(logs.String())
+	require.NoError(t, err)
+	require.Equal(t, logs, res)
+
+	_, err = ParseABCILogs("Msg 0: log")
+	require.Error(t, err)
+}
@@ -13,17 +13,21 @@ const (
 	Unbonded  BondStatus = 0x00
 	Unbonding BondStatus = 0x01
 	Bonded    BondStatus = 0x02
+
+	BondStatusUnbonded  = "Unbonded"
+	BondStatusUnbonding = "Unbonding"
+	BondStatusBonded    = "Bonded"
 )
 
 //BondStatusToString for pretty prints of Bond Status
 func BondStatusToString(b BondStatus) string {
 	switch b {
 	case 0x00:
-		return "Unbonded"
+		return BondStatusUnbonded
 	case 0x01:
-		return "Unbonding"
+		return BondStatusUnbonding
 	case 0x02:
-		return "Bonded"
+		return BondStatusBonded
 	default:
 		panic("improper use of BondStatusToString")
 	}
@@ -124,9 +128,14 @@ type StakingHooks interface {
 	AfterValidatorBeginUnbonding(ctx Context, consAddr ConsAddress, valAddr ValAddress) // Must be called when a validator begins unbonding
 	AfterValidatorPowerDidChange(ctx Context, consAddr ConsAddress, valAddr ValAddress) // Called at EndBlock when a validator's power did change
 
+	// Called at EndBlock with the change in consensus power of a validator
+	// entering, leaving or staying in the bonded set
+	AfterValidatorBondedDelta(ctx Context, consAddr ConsAddress, valAddr ValAddress, delta int64)
+
 	BeforeDelegationCreated(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is created
 	BeforeDelegationSharesModified(ctx Context, delAddr AccAddress, valAddr ValAddress) // Must be called when a delegation's shares are modified
 	BeforeDelegationRemoved(ctx Context, delAddr AccAddress, valAddr ValAddress)        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx Context, delAddr AccAddress, valAddr ValAddress)
-	BeforeValidatorSlashed(ctx Context, valAddr ValAddress, fraction Dec)
+	AfterUnbondingInitiated(ctx Context, delAddr AccAddress, valAddr ValAddress, balance Coin) // Called when a delegator begins unbonding tokens from a validator
+	BeforeValidatorSlashed(ctx Context, valAddr ValAddress, fraction Dec)                      // Called before a validator is slashed by the given fraction of its tokens
 }
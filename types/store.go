@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -124,6 +125,54 @@ type CommitMultiStore interface {
 	// the next commit after loading must be idempotent (return the
 	// same commit id).  Otherwise the behavior is undefined.
 	LoadVersion(ver int64) error
+
+	// Load the latest persisted version, applying the given store upgrades.
+	// Called once after all calls to Mount*Store() are complete, instead of
+	// LoadLatestVersion.
+	LoadLatestVersionAndUpgrade(upgrades *StoreUpgrades) error
+
+	// Cache wrap the stores at a committed version, e.g. to query a previous
+	// state. The stores are read-only, and an error is returned if the
+	// version isn't stored.
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error)
+
+	// SetStoreObserver sets the observer of the operations on the KVStores
+	// of the MultiStore and of its cache wraps, disabled if nil.
+	SetStoreObserver(observer StoreObserver)
+}
+
+// StoreUpgrades are the stores added and deleted by an upgrade of the
+// application, identified by the names of their keys. Added stores are loaded
+// empty, while the data of deleted stores is removed.
+type StoreUpgrades struct {
+	Added   []string `json:"added"`
+	Deleted []string `json:"deleted"`
+}
+
+// IsAdded returns true if the named store is added by the upgrades
+func (s *StoreUpgrades) IsAdded(name string) bool {
+	if s == nil {
+		return false
+	}
+	for _, added := range s.Added {
+		if name == added {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeleted returns true if the named store is deleted by the upgrades
+func (s *StoreUpgrades) IsDeleted(name string) bool {
+	if s == nil {
+		return false
+	}
+	for _, deleted := range s.Deleted {
+		if name == deleted {
+			return true
+		}
+	}
+	return false
 }
 
 //---------subsp-------------------------------
@@ -396,3 +445,25 @@ type KVPair cmn.KVPair
 // TraceContext contains TraceKVStore context data. It will be written with
 // every trace operation.
 type TraceContext map[string]interface{}
+
+//----------------------------------------
+
+// StoreOperation is the kind of an operation on a KVStore.
+type StoreOperation string
+
+// The operations on a KVStore: the reads are the calls to Get and Has, the
+// writes the calls to Set and Delete, and the iterations the creations of the
+// iterators.
+const (
+	StoreOperationRead    StoreOperation = "read"
+	StoreOperationWrite   StoreOperation = "write"
+	StoreOperationIterate StoreOperation = "iterate"
+)
+
+// StoreObserver observes the operations on the KVStores of a MultiStore, e.g.
+// to collect metrics of the accesses to the stores.
+type StoreObserver interface {
+	// ObserveStoreOperation is called after each operation on the KVStore
+	// of the given store key name, with the duration of the operation.
+	ObserveStoreOperation(storeName string, op StoreOperation, duration time.Duration)
+}
@@ -2,26 +2,17 @@ package types
 
 import (
 	"encoding/binary"
-	"encoding/json"
 	"time"
 )
 
 // SortedJSON takes any JSON and returns it sorted by keys. Also, all white-spaces
 // are removed.
 // This method can be used to canonicalize JSON to be returned by GetSignBytes,
-// e.g. for the ledger integration.
+// e.g. for the ledger integration. It returns the canonical form of the JSON
+// specified by CanonicalizeJSON.
 // If the passed JSON isn't valid it will return an error.
 func SortJSON(toSortJSON []byte) ([]byte, error) {
-	var c interface{}
-	err := json.Unmarshal(toSortJSON, &c)
-	if err != nil {
-		return nil, err
-	}
-	js, err := json.Marshal(c)
-	if err != nil {
-		return nil, err
-	}
-	return js, nil
+	return CanonicalizeJSON(toSortJSON)
 }
 
 // MustSortJSON is like SortJSON but panic if an error occurs, e.g., if
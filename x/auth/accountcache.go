@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"bytes"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultAccountCacheSize is the maximum number of accounts cached by an
+// AccountKeeper.
+const defaultAccountCacheSize = 10000
+
+// accountCache caches the decoded accounts by address, with their encoding.
+// The encoding identifies the version of an account, so an account read with
+// the same encoding as the cached one, e.g. a hot account read by the ante
+// handlers of the successive transactions of a block, is copied from the cache
+// rather than decoded. The cached accounts are shared with neither the readers
+// nor the writers of the accounts, and the accounts which cannot be cloned are
+// not cached, e.g. the application accounts embedding a BaseAccount.
+type accountCache struct {
+	mtx sync.Mutex
+
+	size    int
+	entries map[string]accountCacheEntry
+}
+
+type accountCacheEntry struct {
+	bz  []byte
+	acc Account
+}
+
+func newAccountCache(size int) *accountCache {
+	return &accountCache{
+		size:    size,
+		entries: make(map[string]accountCacheEntry),
+	}
+}
+
+// get returns a copy of the account of the given address and encoding, or nil
+// if it is not cached
+func (c *accountCache) get(addr sdk.AccAddress, bz []byte) Account {
+	if c == nil {
+		return nil
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	entry, ok := c.entries[string(addr)]
+	if !ok || !bytes.Equal(entry.bz, bz) {
+		return nil
+	}
+	return cloneAccount(entry.acc)
+}
+
+// set caches a copy of the account of the given address and encoding, if it
+// can be cloned, evicting another account if the cache is full
+func (c *accountCache) set(addr sdk.AccAddress, bz []byte, acc Account) {
+	if c == nil {
+		return
+	}
+	clone := cloneAccount(acc)
+	if clone == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := string(addr)
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		for evicted := range c.entries {
+			delete(c.entries, evicted)
+			break
+		}
+	}
+	c.entries[key] = accountCacheEntry{bz: bz, acc: clone}
+}
+
+// remove removes the account of the given address from the cache
+func (c *accountCache) remove(addr sdk.AccAddress) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.entries, string(addr))
+}
+
+// cloneAccount returns a copy of an account of the auth module sharing no
+// mutable state with it, or nil for the other accounts
+func cloneAccount(acc Account) Account {
+	switch acc := acc.(type) {
+	case *BaseAccount:
+		return cloneBaseAccount(acc)
+	case *ContinuousVestingAccount:
+		clone := *acc
+		clone.BaseVestingAccount = cloneBaseVestingAccount(acc.BaseVestingAccount)
+		return &clone
+	case *DelayedVestingAccount:
+		return &DelayedVestingAccount{cloneBaseVestingAccount(acc.BaseVestingAccount)}
+	default:
+		return nil
+	}
+}
+
+func cloneBaseAccount(acc *BaseAccount) *BaseAccount {
+	clone := *acc
+	clone.Coins = cloneCoins(acc.Coins)
+	return &clone
+}
+
+func cloneBaseVestingAccount(bva *BaseVestingAccount) *BaseVestingAccount {
+	clone := *bva
+	clone.BaseAccount = cloneBaseAccount(bva.BaseAccount)
+	clone.OriginalVesting = cloneCoins(bva.OriginalVesting)
+	clone.DelegatedFree = cloneCoins(bva.DelegatedFree)
+	clone.DelegatedVesting = cloneCoins(bva.DelegatedVesting)
+	return &clone
+}
+
+// cloneCoins returns a copy of coins, nil if there are none as when they are
+// decoded
+func cloneCoins(coins sdk.Coins) sdk.Coins {
+	if len(coins) == 0 {
+		return nil
+	}
+	return append(sdk.Coins(nil), coins...)
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -82,11 +85,11 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		// stdSigs contains the sequence number, account number, and signatures.
 		// When simulating, this would just be a 0-length slice.
 		signerAddrs := stdTx.GetSigners()
-		signerAccs := make([]Account, len(signerAddrs))
+		var signerAccs []Account
 		isGenesis := ctx.BlockHeight() == 0
 
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
+		// fetch all the signers in one pass, the first one paying the fees
+		signerAccs, res = GetSignerAccs(newCtx, ak, signerAddrs)
 		if !res.IsOK() {
 			return newCtx, res, true
 		}
@@ -105,12 +108,9 @@ func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		stdSigs := stdTx.GetSignatures()
 
 		for i := 0; i < len(stdSigs); i++ {
-			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
-				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
-				if !res.IsOK() {
-					return newCtx, res, true
-				}
+			// the signers other than the fee payer may not exist
+			if signerAccs[i] == nil {
+				return newCtx, sdk.ErrUnknownAddress(signerAddrs[i].String()).Result(), true
 			}
 
 			// check signature, return account with incremented nonce
@@ -137,6 +137,20 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (Accou
 	return nil, sdk.ErrUnknownAddress(addr.String()).Result()
 }
 
+// GetSignerAccs returns the accounts of the signers of a transaction, read in
+// one pass, nil for the ones which do not exist. The first signer, who pays
+// the fees, must exist.
+func GetSignerAccs(ctx sdk.Context, ak AccountKeeper, addrs []sdk.AccAddress) ([]Account, sdk.Result) {
+	if len(addrs) == 0 {
+		return nil, sdk.ErrNoSignatures("no signers").Result()
+	}
+	accs := ak.GetAccounts(ctx, addrs)
+	if accs[0] == nil {
+		return nil, sdk.ErrUnknownAddress(addrs[0].String()).Result()
+	}
+	return accs, sdk.Result{}
+}
+
 // ValidateMemo validates the memo and if successful consumes gas for
 // verification.
 func ValidateMemo(gasMeter sdk.GasMeter, stdTx StdTx, params Params) sdk.Result {
@@ -171,7 +185,7 @@ func processSig(
 	}
 
 	consumeSignatureVerificationGas(ctx.GasMeter(), sig.Signature, pubKey, params)
-	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
+	if !simulate && !verifySignature(pubKey, signBytes, sig.Signature) {
 		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
 	}
 
@@ -184,6 +198,97 @@ func processSig(
 	return acc, res
 }
 
+// maxMultisigVerifyWorkers is the maximum number of the signatures of a
+// multisignature verified concurrently.
+var maxMultisigVerifyWorkers = runtime.NumCPU()
+
+// verifySignature verifies the signature of msg by pubKey. The signatures of
+// a threshold multisignature are independent, so they are verified
+// concurrently, with the same outcome as PubKeyMultisigThreshold.VerifyBytes.
+func verifySignature(pubKey crypto.PubKey, msg []byte, sig []byte) bool {
+	multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return pubKey.VerifyBytes(msg, sig)
+	}
+
+	var multisignature multisig.Multisignature
+	if err := codec.Cdc.UnmarshalBinaryBare(sig, &multisignature); err != nil {
+		return false
+	}
+	size := multisignature.BitArray.Size()
+	if len(multisigPubKey.PubKeys) != size {
+		return false
+	}
+	if len(multisignature.Sigs) < int(multisigPubKey.K) || len(multisignature.Sigs) > size {
+		return false
+	}
+	numSigs := multisignature.BitArray.NumTrueBitsBefore(size)
+	if numSigs < int(multisigPubKey.K) || numSigs > len(multisignature.Sigs) {
+		return false
+	}
+
+	// the keys of the signatures, in the order of the signatures
+	pubKeys := make([]crypto.PubKey, 0, numSigs)
+	for i := 0; i < size; i++ {
+		if multisignature.BitArray.GetIndex(i) {
+			pubKeys = append(pubKeys, multisigPubKey.PubKeys[i])
+		}
+	}
+
+	return verifySignaturesConcurrently(pubKeys, msg, multisignature.Sigs[:numSigs])
+}
+
+// verifySignaturesConcurrently verifies the signatures of msg by the keys of
+// the same index with a bounded pool of workers, stopping at the first
+// invalid signature.
+func verifySignaturesConcurrently(pubKeys []crypto.PubKey, msg []byte, sigs [][]byte) bool {
+	workers := maxMultisigVerifyWorkers
+	if workers > len(sigs) {
+		workers = len(sigs)
+	}
+	if workers <= 1 {
+		for i, sig := range sigs {
+			if !verifySignature(pubKeys[i], msg, sig) {
+				return false
+			}
+		}
+		return true
+	}
+
+	indexes := make(chan int, len(sigs))
+	for i := range sigs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var invalid int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			// a panic of a worker would not be recovered by the transaction
+			defer func() {
+				if r := recover(); r != nil {
+					atomic.StoreInt32(&invalid, 1)
+				}
+			}()
+
+			for i := range indexes {
+				if atomic.LoadInt32(&invalid) != 0 {
+					return
+				}
+				if !verifySignature(pubKeys[i], msg, sigs[i]) {
+					atomic.StoreInt32(&invalid, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return atomic.LoadInt32(&invalid) == 0
+}
+
 var dummySecp256k1Pubkey secp256k1.PubKeySecp256k1
 
 func init() {
@@ -236,6 +341,8 @@ func consumeSignatureVerificationGas(meter sdk.GasMeter, sig []byte, pubkey cryp
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
 	case strings.Contains(pubkeyType, "secp256k1"):
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+	case strings.Contains(pubkeyType, "secp256r1"):
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
 	case strings.Contains(pubkeyType, "multisigthreshold"):
 
 		var multisignature multisig.Multisignature
@@ -283,8 +390,8 @@ func DeductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Resu
 	coins := acc.GetCoins()
 	feeAmount := fee.Amount
 
-	if !feeAmount.IsValid() {
-		return nil, sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", feeAmount)).Result()
+	if err := feeAmount.Validate(); err != nil {
+		return nil, sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount %s: %v", feeAmount, err)).Result()
 	}
 
 	// get the resulting coins deducting the fees
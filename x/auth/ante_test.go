@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -589,6 +590,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -604,6 +606,67 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func TestVerifyMultisignature(t *testing.T) {
+	// verify the signatures concurrently even on a single CPU
+	defer func(workers int) { maxMultisigVerifyWorkers = workers }(maxMultisigVerifyWorkers)
+	maxMultisigVerifyWorkers = 3
+
+	msg := []byte{1, 2, 3, 4}
+	pkSet, sigSet := generatePubKeysAndSignatures(7, msg, false)
+	multisigKey := multisig.NewPubKeyMultisigThreshold(4, pkSet).(multisig.PubKeyMultisigThreshold)
+	newMultisignature := func(indexes ...int) *multisig.Multisignature {
+		multisignature := multisig.NewMultisig(len(pkSet))
+		for _, i := range indexes {
+			multisignature.AddSignatureFromPubKey(sigSet[i], pkSet[i], pkSet)
+		}
+		return multisignature
+	}
+
+	invalidSig := newMultisignature(0, 1, 2, 3, 4)
+	invalidSig.Sigs[2] = sigSet[3]
+	tooManySigs := newMultisignature(0, 1, 2, 3)
+	tooManySigs.Sigs = append(tooManySigs.Sigs, sigSet[4])
+	wrongSize := newMultisignature(0, 1, 2, 3)
+	wrongSize.BitArray = multisig.NewMultisig(len(pkSet) + 1).BitArray
+
+	// a nested multisignature is verified concurrently too
+	subKeys, subSigs := generatePubKeysAndSignatures(3, msg, false)
+	subMultisigKey := multisig.NewPubKeyMultisigThreshold(2, subKeys)
+	subMultisignature := multisig.NewMultisig(len(subKeys))
+	for i := range subKeys {
+		subMultisignature.AddSignatureFromPubKey(subSigs[i], subKeys[i], subKeys)
+	}
+	nestedKeys := []crypto.PubKey{subMultisigKey, pkSet[0], pkSet[1]}
+	nestedMultisigKey := multisig.NewPubKeyMultisigThreshold(2, nestedKeys)
+	nestedMultisignature := multisig.NewMultisig(len(nestedKeys))
+	nestedMultisignature.AddSignatureFromPubKey(subMultisignature.Marshal(), subMultisigKey, nestedKeys)
+	nestedMultisignature.AddSignatureFromPubKey(sigSet[1], pkSet[1], nestedKeys)
+
+	tests := []struct {
+		name   string
+		pubKey crypto.PubKey
+		sig    []byte
+		valid  bool
+	}{
+		{"all signatures", multisigKey, newMultisignature(0, 1, 2, 3, 4, 5, 6).Marshal(), true},
+		{"threshold signatures", multisigKey, newMultisignature(1, 3, 5, 6).Marshal(), true},
+		{"below threshold", multisigKey, newMultisignature(1, 3, 5).Marshal(), false},
+		{"invalid signature", multisigKey, invalidSig.Marshal(), false},
+		{"more signatures than keys signing", multisigKey, tooManySigs.Marshal(), true},
+		{"wrong bit array size", multisigKey, wrongSize.Marshal(), false},
+		{"invalid encoding", multisigKey, []byte{1, 2, 3}, false},
+		{"nested multisignature", nestedMultisigKey, nestedMultisignature.Marshal(), true},
+		{"single key", pkSet[0], sigSet[0], true},
+		{"single key invalid signature", pkSet[0], sigSet[1], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.valid, verifySignature(tt.pubKey, msg, tt.sig))
+			require.Equal(t, tt.pubKey.VerifyBytes(msg, tt.sig), verifySignature(tt.pubKey, msg, tt.sig))
+		})
+	}
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte, keyTypeed25519 bool) (pubkeys []crypto.PubKey, signatures [][]byte) {
 	pubkeys = make([]crypto.PubKey, n)
 	signatures = make([][]byte, n)
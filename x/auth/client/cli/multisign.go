@@ -31,6 +31,11 @@ multisig key <name>, and attach it to the transaction read from <file>. Example:
 
    gaiacli multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 
+The multisig key may have multisig keys among its keys, e.g. one per organization. Their
+signatures are either generated by this command with --signature-only (and --offline, with the
+account number and sequence of the account of <name>), or are assembled from the signatures of
+their own keys passed directly as <signature> files.
+
 If the flag --signature-only flag is on, it outputs a JSON representation
 of the generated signature only.
 
@@ -72,7 +77,6 @@ func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
 		cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 		txBldr := authtxb.NewTxBuilderFromCLI()
 
@@ -92,25 +96,19 @@ func makeMultiSignCmd(cdc *amino.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		// read each signature and add it to the multisig if valid
+		var stdSigs []auth.StdSignature
 		for i := 2; i < len(args); i++ {
 			stdSig, err := readAndUnmarshalStdSignature(cdc, args[i])
 			if err != nil {
 				return err
 			}
-
-			// Validate each signature
-			sigBytes := auth.StdSignBytes(
-				txBldr.GetChainID(), txBldr.GetAccountNumber(), txBldr.GetSequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
-			if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
-				return fmt.Errorf("couldn't verify signature")
-			}
-			multisigSig.AddSignatureFromPubKey(stdSig.Signature, stdSig.PubKey, multisigPub.PubKeys)
+			stdSigs = append(stdSigs, stdSig)
 		}
 
-		newStdSig := auth.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
-		newTx := auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{newStdSig}, stdTx.GetMemo())
+		newTx, err := txBldr.MultisigSignStdTx(stdTx, multisigPub, stdSigs)
+		if err != nil {
+			return err
+		}
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/keyerror"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+)
+
+// MultisigGenerateBody defines the properties of the body of a request
+// generating an unsigned tx of messages signed by a multisig key.
+type MultisigGenerateBody struct {
+	Msgs           []sdk.Msg     `json:"msgs"`
+	MultisigPubKey crypto.PubKey `json:"multisig_pub_key"`
+	ChainID        string        `json:"chain_id"`
+	Memo           string        `json:"memo"`
+	Fees           sdk.Coins     `json:"fees"`
+	Gas            uint64        `json:"gas"`
+}
+
+// MultisigSignBody defines the properties of the body of a request signing a
+// tx with one of the keys of a multisig key. The account number and the
+// sequence of the base request are those of the multisig account, and are
+// queried if they are zero.
+type MultisigSignBody struct {
+	Tx              auth.StdTx    `json:"tx"`
+	MultisigAddress string        `json:"multisig_address"`
+	BaseReq         utils.BaseReq `json:"base_req"`
+}
+
+// MultisigCombineBody defines the properties of the body of a request
+// combining the signatures of the keys of a multisig key into the signature of
+// a tx. The account number and the sequence are those of the multisig account,
+// and are queried if they are zero.
+type MultisigCombineBody struct {
+	Tx             auth.StdTx          `json:"tx"`
+	MultisigPubKey crypto.PubKey       `json:"multisig_pub_key"`
+	Signatures     []auth.StdSignature `json:"signatures"`
+	ChainID        string              `json:"chain_id"`
+	AccountNumber  uint64              `json:"account_number"`
+	Sequence       uint64              `json:"sequence"`
+}
+
+// nolint: unparam
+// MultisigGenerateRequestHandlerFn returns the handler generating the unsigned
+// tx of the messages of a MultisigGenerateBody, which must be signed by the
+// multisig key only.
+func MultisigGenerateRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m MultisigGenerateBody
+		if err := utils.ReadRESTReq(w, r, cdc, &m); err != nil {
+			return
+		}
+
+		multisigPub, err := toMultisigPubKey(m.MultisigPubKey)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(m.Msgs) == 0 {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "no messages to sign")
+			return
+		}
+		for _, msg := range m.Msgs {
+			if err := msg.ValidateBasic(); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		stdTx := auth.NewStdTx(m.Msgs, auth.NewStdFee(m.Gas, m.Fees), nil, m.Memo)
+		if err := checkMultisigSigner(stdTx, sdk.AccAddress(multisigPub.Address())); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBldr := authtxb.NewTxBuilder(
+			utils.GetTxEncoder(cdc), 0, 0, m.Gas, 1.0, false, m.ChainID, m.Memo, m.Fees,
+		)
+		utils.WriteGenerateStdTxResponse(w, cdc, txBldr, m.Msgs)
+	}
+}
+
+// MultisigSignRequestHandlerFn returns the handler signing a tx with a key of
+// a multisig key, on behalf of the multisig account. It replies with the
+// signature of the key only, to be combined with the others.
+func MultisigSignRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m MultisigSignBody
+		if err := utils.ReadRESTReq(w, r, cdc, &m); err != nil {
+			return
+		}
+
+		if !m.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		multisigAddr, err := sdk.AccAddressFromBech32(m.MultisigAddress)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := checkMultisigSigner(m.Tx, multisigAddr); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBldr, err := multisigTxBuilder(cdc, cliCtx, m.Tx, multisigAddr,
+			m.BaseReq.ChainID, m.BaseReq.AccountNumber, m.BaseReq.Sequence)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		signedTx, err := txBldr.SignStdTx(m.BaseReq.Name, m.BaseReq.Password, m.Tx, false)
+		if keyerror.IsErrKeyNotFound(err) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		} else if keyerror.IsErrWrongPassword(err) {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		} else if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, signedTx.Signatures[0], cliCtx.Indent)
+	}
+}
+
+// MultisigCombineRequestHandlerFn returns the handler combining the signatures
+// of the keys of a multisig key into the signature of a tx, as done by
+// gaiacli multisign. It replies with the signed tx, ready to be broadcast.
+func MultisigCombineRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m MultisigCombineBody
+		if err := utils.ReadRESTReq(w, r, cdc, &m); err != nil {
+			return
+		}
+
+		if len(m.ChainID) == 0 {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, "chain-id required but not specified")
+			return
+		}
+
+		multisigPub, err := toMultisigPubKey(m.MultisigPubKey)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		multisigAddr := sdk.AccAddress(multisigPub.Address())
+		if err := checkMultisigSigner(m.Tx, multisigAddr); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBldr, err := multisigTxBuilder(cdc, cliCtx, m.Tx, multisigAddr,
+			m.ChainID, m.AccountNumber, m.Sequence)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		signedTx, err := txBldr.MultisigSignStdTx(m.Tx, multisigPub, m.Signatures)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, signedTx, cliCtx.Indent)
+	}
+}
+
+// toMultisigPubKey checks that the public key is a multisig public key. The
+// multisig public keys are given in JSON, as their bech32 encoding exceeds the
+// maximum length of bech32 strings.
+func toMultisigPubKey(pubKey crypto.PubKey) (multisig.PubKeyMultisigThreshold, error) {
+	multisigPub, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return multisig.PubKeyMultisigThreshold{}, errors.New("a multisig public key is required")
+	}
+	return multisigPub, nil
+}
+
+// checkMultisigSigner checks that the multisig account is the only signer of
+// the tx, since its signature replaces all those of the tx.
+func checkMultisigSigner(stdTx auth.StdTx, multisigAddr sdk.AccAddress) error {
+	signers := stdTx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(multisigAddr) {
+		return fmt.Errorf("the multisig account %s must be the only signer of the transaction", multisigAddr)
+	}
+	return nil
+}
+
+// multisigTxBuilder returns the builder signing the tx on behalf of the
+// multisig account, querying its account number and sequence if they are
+// zero.
+func multisigTxBuilder(cdc *codec.Codec, cliCtx context.CLIContext, stdTx auth.StdTx,
+	multisigAddr sdk.AccAddress, chainID string, accnum, seq uint64) (authtxb.TxBuilder, error) {
+
+	var err error
+	if accnum == 0 {
+		if accnum, err = cliCtx.GetAccountNumber(multisigAddr); err != nil {
+			return authtxb.TxBuilder{}, err
+		}
+	}
+	if seq == 0 {
+		if seq, err = cliCtx.GetAccountSequence(multisigAddr); err != nil {
+			return authtxb.TxBuilder{}, err
+		}
+	}
+
+	return authtxb.NewTxBuilder(
+		utils.GetTxEncoder(cdc),
+		accnum,
+		seq,
+		stdTx.Fee.Gas,
+		1.0,
+		false,
+		chainID,
+		stdTx.GetMemo(),
+		stdTx.Fee.Amount), nil
+}
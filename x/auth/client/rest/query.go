@@ -26,6 +26,18 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec,
 		"/tx/sign",
 		SignTxRequestHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/tx/multisig/generate",
+		MultisigGenerateRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/tx/multisig/sign",
+		MultisigSignRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/tx/multisig/combine",
+		MultisigCombineRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 // query accountREST Handler
@@ -34,6 +46,11 @@ func QueryAccountRequestHandlerFn(
 	decoder auth.AccountDecoder, cliCtx context.CLIContext,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32addr := vars["address"]
 
@@ -43,11 +60,12 @@ func QueryAccountRequestHandlerFn(
 			return
 		}
 
-		res, err := cliCtx.QueryStore(auth.AddressStoreKey(addr), storeName)
+		res, height, err := cliCtx.QueryStoreWithHeight(auth.AddressStoreKey(addr), storeName)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		cliCtx = cliCtx.WithHeight(height)
 
 		// the query will return empty if there is no data for this account
 		if len(res) == 0 {
@@ -62,7 +80,7 @@ func QueryAccountRequestHandlerFn(
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, account, cliCtx.Indent)
+		utils.PostProcessQueryResponse(w, cliCtx, account)
 	}
 }
 
@@ -73,6 +91,11 @@ func QueryBalancesRequestHandlerFn(
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32addr := vars["address"]
 
@@ -82,11 +105,12 @@ func QueryBalancesRequestHandlerFn(
 			return
 		}
 
-		res, err := cliCtx.QueryStore(auth.AddressStoreKey(addr), storeName)
+		res, height, err := cliCtx.QueryStoreWithHeight(auth.AddressStoreKey(addr), storeName)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		cliCtx = cliCtx.WithHeight(height)
 
 		// the query will return empty if there is no data for this account
 		if len(res) == 0 {
@@ -101,6 +125,6 @@ func QueryBalancesRequestHandlerFn(
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, account.GetCoins(), cliCtx.Indent)
+		utils.PostProcessQueryResponse(w, cliCtx, account.GetCoins())
 	}
 }
@@ -0,0 +1,100 @@
+package context
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// MultisigSignStdTx replaces the signatures attached to a StdTx with a single
+// signature of the multisig key, assembled from the given signatures, and
+// returns a copy of it.
+//
+// A signature is either of one of the keys of the multisig key, or of a key of
+// a multisig key nested in it, at any depth. The signatures of the keys of a
+// nested multisig key are assembled into its own multisignature, which is
+// attached once it reaches the threshold of the nested key. It returns an
+// error if a signature doesn't verify or is of a key that isn't part of the
+// multisig key.
+func (bldr TxBuilder) MultisigSignStdTx(stdTx auth.StdTx, multisigPub multisig.PubKeyMultisigThreshold,
+	sigs []auth.StdSignature) (signedStdTx auth.StdTx, err error) {
+
+	signBytes := bldr.StdSignBytes(stdTx)
+
+	multisigSig := newNestedMultisig(multisigPub)
+	for _, sig := range sigs {
+		if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+			return signedStdTx, fmt.Errorf("couldn't verify signature of key %X", sig.PubKey.Address())
+		}
+		if !multisigSig.addSignature(sig.Signature, sig.PubKey) {
+			return signedStdTx, fmt.Errorf("key %X isn't part of the multisig key", sig.PubKey.Address())
+		}
+	}
+
+	stdSig := auth.StdSignature{PubKey: multisigPub, Signature: multisigSig.marshal()}
+	signedStdTx = auth.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []auth.StdSignature{stdSig}, stdTx.GetMemo())
+	return
+}
+
+// nestedMultisig assembles the multisignature of a multisig key, along with the
+// multisignatures of the multisig keys nested in it, by index.
+type nestedMultisig struct {
+	pubKey multisig.PubKeyMultisigThreshold
+	sig    *multisig.Multisignature
+	nested map[int]*nestedMultisig
+}
+
+func newNestedMultisig(pubKey multisig.PubKeyMultisigThreshold) *nestedMultisig {
+	return &nestedMultisig{
+		pubKey: pubKey,
+		sig:    multisig.NewMultisig(len(pubKey.PubKeys)),
+		nested: make(map[int]*nestedMultisig),
+	}
+}
+
+// addSignature adds the signature of the given key, looking it up first among
+// the keys of the multisig key and then among those of its nested multisig
+// keys. It returns false if the key isn't part of the multisig key.
+func (m *nestedMultisig) addSignature(sig []byte, pubKey crypto.PubKey) bool {
+	for i, key := range m.pubKey.PubKeys {
+		if key.Equals(pubKey) {
+			m.sig.AddSignature(sig, i)
+			return true
+		}
+	}
+
+	for i, key := range m.pubKey.PubKeys {
+		nestedPub, ok := key.(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			continue
+		}
+		nested, ok := m.nested[i]
+		if !ok {
+			nested = newNestedMultisig(nestedPub)
+		}
+		if nested.addSignature(sig, pubKey) {
+			m.nested[i] = nested
+			return true
+		}
+	}
+	return false
+}
+
+// marshal returns the amino encoded multisignature, including the
+// multisignatures of the nested multisig keys which reach their threshold.
+// A nested multisignature given directly as a signature takes precedence.
+func (m *nestedMultisig) marshal() []byte {
+	for i, nested := range m.nested {
+		if m.sig.BitArray.GetIndex(i) {
+			continue
+		}
+		nestedSig := nested.marshal()
+		if len(nested.sig.Sigs) >= int(nested.pubKey.K) {
+			m.sig.AddSignature(nestedSig, i)
+		}
+	}
+	return m.sig.Marshal()
+}
@@ -0,0 +1,69 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestMultisigSignStdTxNested(t *testing.T) {
+	privs := make([]crypto.PrivKey, 5)
+	pubs := make([]crypto.PubKey, 5)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKey()
+		pubs[i] = privs[i].PubKey()
+	}
+
+	// 2 of (key 0, 2 of 3 of keys 1-3, key 4)
+	nestedPub := multisig.NewPubKeyMultisigThreshold(2, pubs[1:4]).(multisig.PubKeyMultisigThreshold)
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{pubs[0], nestedPub, pubs[4]}).(multisig.PubKeyMultisigThreshold)
+
+	bldr := NewTxBuilder(nil, 1, 2, 0, 0, false, "test-chain", "memo", nil)
+	stdTx := auth.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, auth.NewStdFee(10000, nil), nil, "memo")
+	signBytes := auth.StdSignBytes("test-chain", 1, 2, stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo())
+	sign := func(i int) auth.StdSignature {
+		sig, err := privs[i].Sign(signBytes)
+		require.NoError(t, err)
+		return auth.StdSignature{PubKey: pubs[i], Signature: sig}
+	}
+
+	// the signatures of the nested keys are assembled into a nested multisignature
+	signedTx, err := bldr.MultisigSignStdTx(stdTx, multisigPub, []auth.StdSignature{sign(3), sign(0), sign(1)})
+	require.NoError(t, err)
+	require.Len(t, signedTx.Signatures, 1)
+	require.Equal(t, multisigPub, signedTx.Signatures[0].PubKey)
+	require.True(t, multisigPub.VerifyBytes(signBytes, signedTx.Signatures[0].Signature))
+
+	// a nested multisignature can be given directly
+	nestedTx, err := bldr.MultisigSignStdTx(stdTx, nestedPub, []auth.StdSignature{sign(1), sign(2)})
+	require.NoError(t, err)
+	signedTx, err = bldr.MultisigSignStdTx(stdTx, multisigPub, []auth.StdSignature{sign(4), nestedTx.Signatures[0]})
+	require.NoError(t, err)
+	require.True(t, multisigPub.VerifyBytes(signBytes, signedTx.Signatures[0].Signature))
+
+	// a nested multisignature below its threshold is left out
+	signedTx, err = bldr.MultisigSignStdTx(stdTx, multisigPub, []auth.StdSignature{sign(0), sign(2)})
+	require.NoError(t, err)
+	var multisignature multisig.Multisignature
+	require.NoError(t, codec.Cdc.UnmarshalBinaryBare(signedTx.Signatures[0].Signature, &multisignature))
+	require.Len(t, multisignature.Sigs, 1)
+	require.False(t, multisigPub.VerifyBytes(signBytes, signedTx.Signatures[0].Signature))
+
+	// signatures which don't verify or of other keys are rejected
+	badSig := sign(0)
+	badSig.Signature = sign(1).Signature
+	_, err = bldr.MultisigSignStdTx(stdTx, multisigPub, []auth.StdSignature{badSig})
+	require.Error(t, err)
+	otherPriv := secp256k1.GenPrivKey()
+	otherSig, err := otherPriv.Sign(signBytes)
+	require.NoError(t, err)
+	_, err = bldr.MultisigSignStdTx(stdTx, multisigPub, []auth.StdSignature{{PubKey: otherPriv.PubKey(), Signature: otherSig}})
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 
@@ -23,6 +24,7 @@ type TxBuilder struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	signer             crkeys.Signer
 }
 
 // NewTxBuilder returns a new initialized TxBuilder
@@ -83,6 +85,9 @@ func (bldr TxBuilder) GetMemo() string { return bldr.memo }
 // GetFees returns the fees for the transaction
 func (bldr TxBuilder) GetFees() sdk.Coins { return bldr.fees }
 
+// GetSigner returns the signer of the transactions, nil for the keybase
+func (bldr TxBuilder) GetSigner() crkeys.Signer { return bldr.signer }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -123,6 +128,13 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	return bldr
 }
 
+// WithSigner returns a copy of the context with a signer, which signs the
+// transactions in place of the keybase.
+func (bldr TxBuilder) WithSigner(signer crkeys.Signer) TxBuilder {
+	bldr.signer = signer
+	return bldr
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	bldr.accountNumber = accnum
@@ -147,10 +159,29 @@ func (bldr TxBuilder) Build(msgs []sdk.Msg) (StdSignMsg, error) {
 	}, nil
 }
 
+// StdSignBytes returns the canonical bytes signed by the signers of stdTx,
+// with the chain ID, account number and sequence of the builder, e.g. to be
+// signed by an external signer.
+func (bldr TxBuilder) StdSignBytes(stdTx auth.StdTx) []byte {
+	return bldr.stdSignMsg(stdTx).Bytes()
+}
+
+// stdSignMsg returns the message signed by the signers of stdTx
+func (bldr TxBuilder) stdSignMsg(stdTx auth.StdTx) StdSignMsg {
+	return StdSignMsg{
+		ChainID:       bldr.chainID,
+		AccountNumber: bldr.accountNumber,
+		Sequence:      bldr.sequence,
+		Fee:           stdTx.Fee,
+		Msgs:          stdTx.GetMsgs(),
+		Memo:          stdTx.GetMemo(),
+	}
+}
+
 // Sign signs a transaction given a name, passphrase, and a single message to
 // signed. An error is returned if signing fails.
 func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, error) {
-	sig, err := MakeSignature(name, passphrase, msg)
+	sig, err := bldr.makeSignature(name, passphrase, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -200,14 +231,7 @@ func (bldr TxBuilder) BuildWithPubKey(name string, msgs []sdk.Msg) ([]byte, erro
 // SignStdTx appends a signature to a StdTx and returns a copy of a it. If append
 // is false, it replaces the signatures already attached with the new signature.
 func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appendSig bool) (signedStdTx auth.StdTx, err error) {
-	stdSignature, err := MakeSignature(name, passphrase, StdSignMsg{
-		ChainID:       bldr.chainID,
-		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
-		Fee:           stdTx.Fee,
-		Msgs:          stdTx.GetMsgs(),
-		Memo:          stdTx.GetMemo(),
-	})
+	stdSignature, err := bldr.makeSignature(name, passphrase, bldr.stdSignMsg(stdTx))
 	if err != nil {
 		return
 	}
@@ -222,13 +246,33 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx auth.StdTx, appen
 	return
 }
 
+// makeSignature builds a StdSignature with the signer of the builder, or with
+// the keybase if it has none.
+func (bldr TxBuilder) makeSignature(name, passphrase string, msg StdSignMsg) (auth.StdSignature, error) {
+	signer := bldr.signer
+	if signer == nil {
+		keybase, err := keys.GetKeyBase()
+		if err != nil {
+			return auth.StdSignature{}, err
+		}
+		signer = keybase
+	}
+	return MakeSignatureWithSigner(signer, name, passphrase, msg)
+}
+
 // MakeSignature builds a StdSignature given key name, passphrase, and a StdSignMsg.
 func MakeSignature(name, passphrase string, msg StdSignMsg) (sig auth.StdSignature, err error) {
 	keybase, err := keys.GetKeyBase()
 	if err != nil {
 		return
 	}
-	sigBytes, pubkey, err := keybase.Sign(name, passphrase, msg.Bytes())
+	return MakeSignatureWithSigner(keybase, name, passphrase, msg)
+}
+
+// MakeSignatureWithSigner builds a StdSignature given a signer, key name,
+// passphrase, and a StdSignMsg.
+func MakeSignatureWithSigner(signer crkeys.Signer, name, passphrase string, msg StdSignMsg) (sig auth.StdSignature, err error) {
+	sigBytes, pubkey, err := signer.Sign(name, passphrase, msg.Bytes())
 	if err != nil {
 		return
 	}
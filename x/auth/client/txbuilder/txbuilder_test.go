@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -71,3 +72,47 @@ func TestTxBuilderBuild(t *testing.T) {
 		}
 	}
 }
+
+// testSigner signs with a single private key
+type testSigner struct{ priv crypto.PrivKey }
+
+func (s testSigner) Sign(_, _ string, msg []byte) ([]byte, crypto.PubKey, error) {
+	sig, err := s.priv.Sign(msg)
+	return sig, s.priv.PubKey(), err
+}
+
+func TestTxBuilderWithSigner(t *testing.T) {
+	var tx auth.StdTx
+	txEncoder := func(signedTx sdk.Tx) ([]byte, error) {
+		tx = signedTx.(auth.StdTx)
+		return nil, nil
+	}
+
+	bldr := NewTxBuilder(txEncoder, 1, 2, 200000, 1.1, false, "test-chain", "memo", nil).
+		WithSigner(testSigner{priv})
+	_, err := bldr.BuildAndSign("remote", "", []sdk.Msg{sdk.NewTestMsg(addr)})
+	require.NoError(t, err)
+
+	require.Len(t, tx.Signatures, 1)
+	require.Equal(t, priv.PubKey(), tx.Signatures[0].PubKey)
+	signBytes := auth.StdSignBytes("test-chain", 1, 2, tx.Fee, tx.GetMsgs(), tx.GetMemo())
+	require.True(t, priv.PubKey().VerifyBytes(signBytes, tx.Signatures[0].Signature))
+}
+
+func TestTxBuilderStdSignBytes(t *testing.T) {
+	bldr := NewTxBuilder(nil, 1, 2, 0, 0, false, "test-chain", "memo", nil)
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	stdTx := auth.NewStdTx(msgs, auth.NewStdFee(10000, sdk.Coins{sdk.NewInt64Coin("atom", 1)}), nil, "memo")
+	require.Equal(t,
+		auth.StdSignBytes("test-chain", 1, 2, stdTx.Fee, msgs, stdTx.GetMemo()),
+		bldr.StdSignBytes(stdTx))
+
+	// the sign bytes follow the builder and the transaction, even edited in place
+	require.Equal(t,
+		auth.StdSignBytes("test-chain", 1, 3, stdTx.Fee, msgs, stdTx.GetMemo()),
+		bldr.WithSequence(3).StdSignBytes(stdTx))
+	msgs[0] = sdk.NewTestMsg(addr, addr)
+	require.Equal(t,
+		auth.StdSignBytes("test-chain", 1, 2, stdTx.Fee, msgs, stdTx.GetMemo()),
+		bldr.StdSignBytes(stdTx))
+}
@@ -1,8 +1,6 @@
 package auth
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -42,21 +40,5 @@ func ExportGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper) G
 // ValidateGenesis performs basic validation of auth genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	if data.Params.TxSigLimit == 0 {
-		return fmt.Errorf("invalid tx signature limit: %d", data.Params.TxSigLimit)
-	}
-	if data.Params.SigVerifyCostED25519 == 0 {
-		return fmt.Errorf("invalid ED25519 signature verification cost: %d", data.Params.SigVerifyCostED25519)
-	}
-	if data.Params.SigVerifyCostSecp256k1 == 0 {
-		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", data.Params.SigVerifyCostSecp256k1)
-	}
-	if data.Params.MaxMemoCharacters == 0 {
-		return fmt.Errorf("invalid max memo characters: %d", data.Params.MaxMemoCharacters)
-	}
-	if data.Params.MemoCostPerByte == 0 {
-		return fmt.Errorf("invalid memo cost per byte: %d", data.Params.MemoCostPerByte)
-	}
-
-	return nil
+	return data.Params.Validate()
 }
@@ -1,6 +1,11 @@
 package auth
 
 import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"sync"
+
 	"github.com/tendermint/tendermint/crypto"
 
 	codec "github.com/cosmos/cosmos-sdk/codec"
@@ -34,6 +39,9 @@ type AccountKeeper struct {
 	cdc *codec.Codec
 
 	paramSubspace params.Subspace
+
+	// The cache of the decoded accounts.
+	cache *accountCache
 }
 
 // NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
@@ -48,6 +56,7 @@ func NewAccountKeeper(
 		proto:         proto,
 		cdc:           cdc,
 		paramSubspace: paramstore.WithTypeTable(ParamTypeTable()),
+		cache:         newAccountCache(defaultAccountCacheSize),
 	}
 }
 
@@ -89,16 +98,92 @@ func (ak AccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) Account
 	if bz == nil {
 		return nil
 	}
+	if acc := ak.cache.get(addr, bz); acc != nil {
+		return acc
+	}
 	acc := ak.decodeAccount(bz)
+	ak.cache.set(addr, bz, acc)
 	return acc
 }
 
+// maxAccountDecodeWorkers is the maximum number of accounts decoded
+// concurrently by GetAccounts.
+var maxAccountDecodeWorkers = runtime.NumCPU()
+
+// GetAccounts returns the accounts of the given addresses in one pass, nil
+// for the ones which do not exist. The accounts are read from the store in
+// order, so as to consume the gas deterministically, and the ones which are
+// not cached are decoded concurrently.
+func (ak AccountKeeper) GetAccounts(ctx sdk.Context, addrs []sdk.AccAddress) []Account {
+	store := ctx.KVStore(ak.key)
+	accs := make([]Account, len(addrs))
+	bzs := make([][]byte, len(addrs))
+	var undecoded []int
+	for i, addr := range addrs {
+		bzs[i] = store.Get(AddressStoreKey(addr))
+		if bzs[i] == nil {
+			continue
+		}
+		if accs[i] = ak.cache.get(addr, bzs[i]); accs[i] == nil {
+			undecoded = append(undecoded, i)
+		}
+	}
+
+	workers := maxAccountDecodeWorkers
+	if workers > len(undecoded) {
+		workers = len(undecoded)
+	}
+	if workers <= 1 {
+		for _, i := range undecoded {
+			accs[i] = ak.decodeAccount(bzs[i])
+			ak.cache.set(addrs[i], bzs[i], accs[i])
+		}
+		return accs
+	}
+
+	indexes := make(chan int, len(undecoded))
+	for _, i := range undecoded {
+		indexes <- i
+	}
+	close(indexes)
+
+	// a panic of a worker is raised again by the caller, e.g. to fail the
+	// transaction
+	panics := make(chan interface{}, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					panics <- r
+				}
+			}()
+
+			for i := range indexes {
+				accs[i] = ak.decodeAccount(bzs[i])
+				ak.cache.set(addrs[i], bzs[i], accs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case r := <-panics:
+		panic(fmt.Sprintf("failed to decode account: %v", r))
+	default:
+	}
+	return accs
+}
+
 // Implements sdk.AccountKeeper.
 func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	bz := ak.encodeAccount(acc)
 	store.Set(AddressStoreKey(addr), bz)
+	ak.cache.set(addr, bz, acc)
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -106,6 +191,7 @@ func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	store.Delete(AddressStoreKey(addr))
+	ak.cache.remove(addr)
 }
 
 // Implements sdk.AccountKeeper.
@@ -186,9 +272,18 @@ func (ak AccountKeeper) SetParams(ctx sdk.Context, params Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. The chains started before the
+// secp256r1 signatures were supported lack the cost of their verification,
+// which is then the default one.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.KeyValuePairs() {
+		if bytes.Equal(pair.Key, KeySigVerifyCostSecp256r1) {
+			params.SigVerifyCostSecp256r1 = DefaultSigVerifyCostSecp256r1
+			ak.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		ak.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return
 }
 
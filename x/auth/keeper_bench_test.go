@@ -87,3 +87,26 @@ func BenchmarkAccountMapperSetAccountWithCoins(b *testing.B) {
 		input.ak.SetAccount(input.ctx, acc)
 	}
 }
+
+func BenchmarkAccountMapperGetAccountHot(b *testing.B) {
+	input := setupTestInput()
+	coins := sdk.Coins{
+		sdk.NewCoin("bch", sdk.NewInt(1000)),
+		sdk.NewCoin("btc", sdk.NewInt(1000)),
+		sdk.NewCoin("eth", sdk.NewInt(1000)),
+	}
+
+	// a few accounts read repeatedly, e.g. by the ante handler
+	addrs := make([]sdk.AccAddress, 10)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress([]byte{byte(i)})
+		acc := input.ak.NewAccountWithAddress(input.ctx, addrs[i])
+		acc.SetCoins(coins)
+		input.ak.SetAccount(input.ctx, acc)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input.ak.GetAccount(input.ctx, addrs[i%len(addrs)])
+	}
+}
@@ -1,7 +1,9 @@
 package auth
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -87,4 +89,112 @@ func TestGetParams(t *testing.T) {
 
 	newParams := input.ak.GetParams(input.ctx)
 	require.Equal(t, params, newParams)
+
+	// the params of the chains started without the secp256r1 verification
+	// cost default it
+	ak := NewAccountKeeper(input.cdc, sdk.NewKVStoreKey("authCapKey"), input.pk.Subspace("legacy"), ProtoBaseAccount)
+	params.TxSigLimit = 3
+	for _, pair := range params.KeyValuePairs() {
+		if !bytes.Equal(pair.Key, KeySigVerifyCostSecp256r1) {
+			ak.paramSubspace.Set(input.ctx, pair.Key, pair.Value)
+		}
+	}
+	require.Equal(t, params, ak.GetParams(input.ctx))
+}
+
+func TestAccountMapperCache(t *testing.T) {
+	input := setupTestInput()
+	_, pubKey, addr := keyPubAddr()
+	store := input.ctx.KVStore(input.ak.key)
+
+	acc := input.ak.NewAccountWithAddress(input.ctx, addr)
+	acc.SetCoins(sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})
+	acc.SetPubKey(pubKey)
+	input.ak.SetAccount(input.ctx, acc)
+
+	// the cached account is a copy of the decoded account
+	bz := store.Get(AddressStoreKey(addr))
+	require.Equal(t, input.ak.decodeAccount(bz), input.ak.GetAccount(input.ctx, addr))
+
+	// the cached account is shared neither with the writer nor with the readers
+	acc.SetSequence(5)
+	cached := input.ak.GetAccount(input.ctx, addr)
+	require.Equal(t, uint64(0), cached.GetSequence())
+	cached.SetCoins(sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}, input.ak.GetAccount(input.ctx, addr).GetCoins())
+
+	// an account of another encoding than the cached one is decoded
+	acc.SetSequence(6)
+	store.Set(AddressStoreKey(addr), input.ak.encodeAccount(acc))
+	require.Equal(t, uint64(6), input.ak.GetAccount(input.ctx, addr).GetSequence())
+
+	// the copies of the vesting accounts share no coins either
+	_, _, vestingAddr := keyPubAddr()
+	baseAcc := NewBaseAccountWithAddress(vestingAddr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})
+	vestingAcc := NewContinuousVestingAccount(&baseAcc, 0, 10)
+	input.ak.SetAccount(input.ctx, vestingAcc)
+
+	cachedVesting := input.ak.GetAccount(input.ctx, vestingAddr).(*ContinuousVestingAccount)
+	require.Equal(t, input.ak.decodeAccount(store.Get(AddressStoreKey(vestingAddr))), cachedVesting)
+	cachedVesting.TrackDelegation(time.Unix(5, 0), sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	cachedVesting.SetSequence(1)
+	vestingAcc = input.ak.GetAccount(input.ctx, vestingAddr).(*ContinuousVestingAccount)
+	require.Equal(t, uint64(0), vestingAcc.GetSequence())
+	require.Nil(t, vestingAcc.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}, vestingAcc.GetCoins())
+
+	// the removed accounts are not cached
+	input.ak.RemoveAccount(input.ctx, acc)
+	require.Nil(t, input.ak.GetAccount(input.ctx, addr))
+	require.Nil(t, input.ak.cache.get(addr, bz))
+}
+
+func TestAccountCacheSize(t *testing.T) {
+	cache := newAccountCache(2)
+	addrs := []sdk.AccAddress{[]byte("addr1"), []byte("addr2"), []byte("addr3")}
+	for i, addr := range addrs {
+		acc := NewBaseAccountWithAddress(addr)
+		cache.set(addr, []byte{byte(i)}, &acc)
+	}
+	require.Len(t, cache.entries, 2)
+	require.NotNil(t, cache.get(addrs[2], []byte{2}))
+	require.Nil(t, cache.get(addrs[2], []byte{3}))
+}
+
+func TestAccountMapperGetAccounts(t *testing.T) {
+	// decode the accounts concurrently even on a single CPU
+	defer func(workers int) { maxAccountDecodeWorkers = workers }(maxAccountDecodeWorkers)
+	maxAccountDecodeWorkers = 3
+
+	input := setupTestInput()
+	addrs := make([]sdk.AccAddress, 6)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress([]byte{byte(i)})
+		// the even accounts exist
+		if i%2 == 0 {
+			acc := input.ak.NewAccountWithAddress(input.ctx, addrs[i])
+			acc.SetSequence(uint64(i))
+			input.ak.SetAccount(input.ctx, acc)
+		}
+	}
+
+	// the cached and the decoded accounts are returned in order
+	input.ak.cache = newAccountCache(defaultAccountCacheSize)
+	input.ak.GetAccount(input.ctx, addrs[2])
+	accs := input.ak.GetAccounts(input.ctx, addrs)
+	require.Len(t, accs, len(addrs))
+	for i, acc := range accs {
+		if i%2 != 0 {
+			require.Nil(t, acc)
+			continue
+		}
+		require.Equal(t, input.ak.GetAccount(input.ctx, addrs[i]), acc)
+		require.Equal(t, uint64(i), acc.GetSequence())
+	}
+
+	// the failures to decode are raised by the caller
+	input.ctx.KVStore(input.ak.key).Set(AddressStoreKey(addrs[1]), []byte{1, 2, 3})
+	input.ctx.KVStore(input.ak.key).Set(AddressStoreKey(addrs[3]), []byte{1, 2, 3})
+	require.Panics(t, func() { input.ak.GetAccounts(input.ctx, addrs) })
 }
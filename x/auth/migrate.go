@@ -0,0 +1,11 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGenesisMigration registers the migration of the auth genesis state
+// of the previous release, which lacks the SigVerifyCostSecp256r1 param.
+func RegisterGenesisMigration(m *sdk.GenesisMigrator) {
+	m.RegisterMigration(ModuleName, sdk.DefaultsGenesisMigration(DefaultGenesisState()))
+}
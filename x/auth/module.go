@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleName is the name of the auth module
+const ModuleName = "auth"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the auth module
+type AppModule struct {
+	cdc                 *codec.Codec
+	accountKeeper       AccountKeeper
+	feeCollectionKeeper FeeCollectionKeeper
+}
+
+// NewAppModule creates a new AppModule object, using the application codec
+// for the genesis state
+func NewAppModule(cdc *codec.Codec, accountKeeper AccountKeeper, feeCollectionKeeper FeeCollectionKeeper) AppModule {
+	return AppModule{
+		cdc:                 cdc,
+		accountKeeper:       accountKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants is a no-op, the module having no invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// InitGenesis initializes the auth state from its genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	am.cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.accountKeeper, am.feeCollectionKeeper, genesisState)
+	return nil
+}
+
+// ExportGenesis exports the auth state as a genesis state
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.accountKeeper, am.feeCollectionKeeper)
+	return am.cdc.MustMarshalJSON(genesisState)
+}
+
+// BeginBlock is a no-op for the auth module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock is a no-op for the auth module
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return nil, sdk.EmptyTags()
+}
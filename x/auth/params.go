@@ -20,6 +20,7 @@ const (
 	DefaultTxSigLimit             uint64  = 7
 	DefaultSigVerifyCostED25519   uint64  = 590
 	DefaultSigVerifyCostSecp256k1 uint64  = 1000
+	DefaultSigVerifyCostSecp256r1 uint64  = 1000
 )
 
 // Parameter keys
@@ -29,6 +30,7 @@ var (
 	KeyTxSigLimit             = []byte("TxSigLimit")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeySigVerifyCostSecp256r1 = []byte("SigVerifyCostSecp256r1")
 )
 
 var _ params.ParamSet = &Params{}
@@ -40,11 +42,18 @@ type Params struct {
 	TxSigLimit             uint64 // max total number of signatures per tx
 	SigVerifyCostED25519   uint64
 	SigVerifyCostSecp256k1 uint64
+	SigVerifyCostSecp256r1 uint64
 }
 
 // ParamTable for staking module
 func ParamTypeTable() params.TypeTable {
-	return params.NewTypeTable().RegisterParamSet(&Params{})
+	return params.NewTypeTable().RegisterParamSet(&Params{}).
+		RegisterValidator(KeyMemoCostPerByte, validateMemoCostPerByte).
+		RegisterValidator(KeyMaxMemoCharacters, validateMaxMemoCharacters).
+		RegisterValidator(KeyTxSigLimit, validateTxSigLimit).
+		RegisterValidator(KeySigVerifyCostED25519, validateSigVerifyCostED25519).
+		RegisterValidator(KeySigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1).
+		RegisterValidator(KeySigVerifyCostSecp256r1, validateSigVerifyCostSecp256r1)
 }
 
 // KeyValuePairs implements the ParamSet interface and returns all the key/value
@@ -57,6 +66,7 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyTxSigLimit, &p.TxSigLimit},
 		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
 		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		{KeySigVerifyCostSecp256r1, &p.SigVerifyCostSecp256r1},
 	}
 }
 
@@ -75,9 +85,73 @@ func DefaultParams() Params {
 		TxSigLimit:             DefaultTxSigLimit,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
 	}
 }
 
+// Validate checks the auth parameters against the same constraints as
+// parameter change proposals.
+func (p Params) Validate() error {
+	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostED25519(p.SigVerifyCostED25519); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostSecp256r1(p.SigVerifyCostSecp256r1); err != nil {
+		return err
+	}
+	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
+		return err
+	}
+	return validateMemoCostPerByte(p.MemoCostPerByte)
+}
+
+func validateTxSigLimit(i interface{}) error {
+	if v := i.(uint64); v == 0 {
+		return fmt.Errorf("invalid tx signature limit: %d", v)
+	}
+	return nil
+}
+
+func validateSigVerifyCostED25519(i interface{}) error {
+	if v := i.(uint64); v == 0 {
+		return fmt.Errorf("invalid ED25519 signature verification cost: %d", v)
+	}
+	return nil
+}
+
+func validateSigVerifyCostSecp256k1(i interface{}) error {
+	if v := i.(uint64); v == 0 {
+		return fmt.Errorf("invalid SECK256k1 signature verification cost: %d", v)
+	}
+	return nil
+}
+
+func validateSigVerifyCostSecp256r1(i interface{}) error {
+	if v := i.(uint64); v == 0 {
+		return fmt.Errorf("invalid SECP256r1 signature verification cost: %d", v)
+	}
+	return nil
+}
+
+func validateMaxMemoCharacters(i interface{}) error {
+	if v := i.(uint64); v == 0 {
+		return fmt.Errorf("invalid max memo characters: %d", v)
+	}
+	return nil
+}
+
+func validateMemoCostPerByte(i interface{}) error {
+	if v := i.(sdk.Gas); v == 0 {
+		return fmt.Errorf("invalid memo cost per byte: %d", v)
+	}
+	return nil
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	var sb strings.Builder
@@ -88,6 +162,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSigLimit: %d\n", p.TxSigLimit))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256r1: %d\n", p.SigVerifyCostSecp256r1))
 
 	return sb.String()
 }
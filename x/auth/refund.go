@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGasRefundHandler returns a sdk.PostHandler refunding to the fee payer of
+// a StdTx the given ratio of the fees paid for the gas it did not use, out of
+// the collected fees. It aborts, discarding the refund, if the fee payer does
+// not exist anymore.
+//
+// The handler is opt-in: an application refunds the unused gas by setting it
+// with BaseApp.SetPostHandler.
+func NewGasRefundHandler(ak AccountKeeper, fck FeeCollectionKeeper, refundRatio sdk.Dec) sdk.PostHandler {
+	if refundRatio.IsNegative() || refundRatio.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("gas refund ratio must be between 0 and 1, got %s", refundRatio))
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) bool {
+		stdTx, ok := tx.(StdTx)
+		if !ok {
+			return false
+		}
+
+		refund := GasRefund(stdTx.Fee, result.GasUsed, refundRatio)
+		if refund.IsZero() {
+			return false
+		}
+
+		// the first signer paid the fees
+		payerAddr := stdTx.GetSigners()[0]
+		payer := ak.GetAccount(ctx, payerAddr)
+		if payer == nil {
+			return true
+		}
+		if err := payer.SetCoins(payer.GetCoins().Plus(refund)); err != nil {
+			return true
+		}
+		ak.SetAccount(ctx, payer)
+		fck.setCollectedFees(ctx, fck.GetCollectedFees(ctx).Minus(refund))
+
+		return false
+	}
+}
+
+// GasRefund returns the refund of the fees paid for the unused gas of a
+// transaction, i.e. fee * refundRatio * (gasWanted - gasUsed) / gasWanted,
+// rounded down.
+func GasRefund(fee StdFee, gasUsed uint64, refundRatio sdk.Dec) sdk.Coins {
+	if fee.Gas == 0 || gasUsed >= fee.Gas {
+		return sdk.Coins{}
+	}
+
+	gasWanted := sdk.NewIntFromBigInt(new(big.Int).SetUint64(fee.Gas))
+	unusedGas := sdk.NewIntFromBigInt(new(big.Int).SetUint64(fee.Gas - gasUsed))
+
+	refund := sdk.Coins{}
+	for _, coin := range fee.Amount {
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(refundRatio).MulInt(unusedGas).QuoInt(gasWanted).TruncateInt()
+		if amount.IsPositive() {
+			refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return refund
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasRefund(t *testing.T) {
+	fee := NewStdFee(100000, sdk.Coins{sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("photon", 3)})
+
+	testCases := []struct {
+		gasUsed  uint64
+		ratio    sdk.Dec
+		expected sdk.Coins
+	}{
+		{40000, sdk.OneDec(), sdk.Coins{sdk.NewInt64Coin("atom", 90), sdk.NewInt64Coin("photon", 1)}},
+		{40000, sdk.NewDecWithPrec(5, 1), sdk.Coins{sdk.NewInt64Coin("atom", 45)}},
+		{40000, sdk.ZeroDec(), sdk.Coins{}},
+		{100000, sdk.OneDec(), sdk.Coins{}},
+		{150000, sdk.OneDec(), sdk.Coins{}},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expected, GasRefund(fee, tc.gasUsed, tc.ratio), "test case %d", i)
+	}
+
+	require.Equal(t, sdk.Coins{}, GasRefund(NewStdFee(0, fee.Amount), 0, sdk.OneDec()))
+}
+
+func TestGasRefundHandler(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	require.Panics(t, func() { NewGasRefundHandler(input.ak, input.fck, sdk.NewDec(2)) })
+	require.Panics(t, func() { NewGasRefundHandler(input.ak, input.fck, sdk.NewDec(-1)) })
+
+	priv1, _, addr1 := keyPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc1)
+
+	// the fees were deducted by the ante handler
+	fee := NewStdFee(100000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	input.fck.AddCollectedFees(ctx, fee.Amount)
+
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	tx := newTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+
+	handler := NewGasRefundHandler(input.ak, input.fck, sdk.NewDecWithPrec(5, 1))
+	result := sdk.Result{GasWanted: 100000, GasUsed: 40000}
+	require.False(t, handler(ctx, tx, result))
+
+	refund := sdk.Coins{sdk.NewInt64Coin("atom", 45)}
+	require.Equal(t, refund, input.ak.GetAccount(ctx, addr1).GetCoins())
+	require.Equal(t, fee.Amount.Minus(refund), input.fck.GetCollectedFees(ctx))
+
+	// the handler aborts if the fee payer does not exist anymore
+	input.ak.RemoveAccount(ctx, input.ak.GetAccount(ctx, addr1))
+	require.True(t, handler(ctx, tx, result))
+}
@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// RandomizedParams returns random parameters of the auth module
+func RandomizedParams(r *rand.Rand) auth.Params {
+	return auth.Params{
+		MemoCostPerByte:        uint64(r.Intn(10) + 1),
+		MaxMemoCharacters:      uint64(r.Intn(200-100) + 100),
+		TxSigLimit:             uint64(r.Intn(7) + 1),
+		SigVerifyCostED25519:   uint64(r.Intn(1000-500) + 500),
+		SigVerifyCostSecp256k1: uint64(r.Intn(1000-500) + 500),
+		SigVerifyCostSecp256r1: uint64(r.Intn(1000-500) + 500),
+	}
+}
+
+// RandomizedGenState generates a random genesis state of the auth module
+func RandomizedGenState(simState *simulation.GenesisState) {
+	authGenesis := auth.GenesisState{
+		Params: RandomizedParams(simState.Rand),
+	}
+	fmt.Printf("Selected randomly generated auth parameters:\n\t%+v\n", authGenesis)
+
+	simState.SetGenesis(auth.ModuleName, authGenesis)
+}
@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// auth module
+type AppModule struct {
+	accountKeeper       auth.AccountKeeper
+	feeCollectionKeeper auth.FeeCollectionKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper,
+	feeCollectionKeeper auth.FeeCollectionKeeper) AppModule {
+
+	return AppModule{
+		accountKeeper:       accountKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return auth.ModuleName
+}
+
+// randomized genesis state
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 5, Op: SimulateDeductFee(am.accountKeeper, am.feeCollectionKeeper)},
+	}
+}
@@ -19,6 +19,7 @@ type testInput struct {
 	ctx sdk.Context
 	ak  AccountKeeper
 	fck FeeCollectionKeeper
+	pk  params.Keeper
 }
 
 func setupTestInput() testInput {
@@ -46,7 +47,7 @@ func setupTestInput() testInput {
 
 	ak.SetParams(ctx, DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, fck: fck}
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, fck: fck, pk: pk}
 }
 
 func newTestMsg(addrs ...sdk.AccAddress) *sdk.TestMsg {
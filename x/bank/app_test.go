@@ -6,12 +6,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/mock/testkeys"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 type (
@@ -32,13 +32,13 @@ type (
 )
 
 var (
-	priv1 = ed25519.GenPrivKey()
-	addr1 = sdk.AccAddress(priv1.PubKey().Address())
-	priv2 = ed25519.GenPrivKey()
-	addr2 = sdk.AccAddress(priv2.PubKey().Address())
-	addr3 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
-	priv4 = ed25519.GenPrivKey()
-	addr4 = sdk.AccAddress(priv4.PubKey().Address())
+	priv1 = testkeys.Named("bank1").PrivKey
+	addr1 = testkeys.Named("bank1").Address
+	priv2 = testkeys.Named("bank2").PrivKey
+	addr2 = testkeys.Named("bank2").Address
+	addr3 = testkeys.Named("bank3").Address
+	priv4 = testkeys.Named("bank4").PrivKey
+	addr4 = testkeys.Named("bank4").Address
 
 	coins     = sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
 	halfCoins = sdk.Coins{sdk.NewInt64Coin("foocoin", 5)}
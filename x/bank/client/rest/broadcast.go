@@ -5,16 +5,20 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 type broadcastBody struct {
-	Tx auth.StdTx `json:"tx"`
+	Tx   auth.StdTx `json:"tx"`
+	Mode string     `json:"mode"`
 }
 
-// BroadcastTxRequestHandlerFn returns the broadcast tx REST handler
+// BroadcastTxRequestHandlerFn returns the broadcast tx REST handler. The tx is
+// broadcast in the mode of the body if any, as on POST /txs, or else in the
+// mode of the context.
 func BroadcastTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var m broadcastBody
@@ -28,7 +32,16 @@ func BroadcastTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) ht
 			return
 		}
 
-		res, err := cliCtx.BroadcastTx(txBytes)
+		var res interface{}
+		if m.Mode == "" {
+			res, err = cliCtx.BroadcastTx(txBytes)
+		} else {
+			if err := tx.ValidateBroadcastMode(m.Mode); err != nil {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			res, err = tx.BroadcastTxWithMode(cliCtx, txBytes, m.Mode)
+		}
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -13,6 +13,11 @@ const (
 	CodeInvalidOutput sdk.CodeType = 102
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidInput, codeToDefaultMsg(CodeInvalidInput))
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidOutput, codeToDefaultMsg(CodeInvalidOutput))
+}
+
 // NOTE: Don't stringer this, we'll put better messages in later.
 func codeToDefaultMsg(code sdk.CodeType) string {
 	switch code {
@@ -0,0 +1,26 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
+func NonnegativeBalanceInvariant(ak auth.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var err error
+		ak.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+			coins := acc.GetCoins()
+			if !coins.IsNotNegative() {
+				err = fmt.Errorf("%s has a negative denomination of %s",
+					acc.GetAddress().String(),
+					coins.String())
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
@@ -0,0 +1,58 @@
+package bank
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// ModuleName is the name of the bank module
+const ModuleName = "bank"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the bank module, which
+// has no genesis state nor block hooks and only registers invariants
+type AppModule struct {
+	accountKeeper auth.AccountKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper) AppModule {
+	return AppModule{
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants registers the bank invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	ir.RegisterRoute(RouterKey, "nonnegative-balance", NonnegativeBalanceInvariant(am.accountKeeper))
+}
+
+// InitGenesis is a no-op, the module having no genesis state
+func (AppModule) InitGenesis(_ sdk.Context, _ json.RawMessage) []abci.ValidatorUpdate {
+	return nil
+}
+
+// ExportGenesis returns nil, the module having no genesis state
+func (AppModule) ExportGenesis(_ sdk.Context) json.RawMessage {
+	return nil
+}
+
+// BeginBlock is a no-op for the bank module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock is a no-op for the bank module
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return nil, sdk.EmptyTags()
+}
@@ -95,8 +95,8 @@ func (in Input) ValidateBasic() sdk.Error {
 	if len(in.Address) == 0 {
 		return sdk.ErrInvalidAddress(in.Address.String())
 	}
-	if !in.Coins.IsValid() {
-		return sdk.ErrInvalidCoins(in.Coins.String())
+	if err := in.Coins.Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
 	}
 	if !in.Coins.IsPositive() {
 		return sdk.ErrInvalidCoins(in.Coins.String())
@@ -136,8 +136,8 @@ func (out Output) ValidateBasic() sdk.Error {
 	if len(out.Address) == 0 {
 		return sdk.ErrInvalidAddress(out.Address.String())
 	}
-	if !out.Coins.IsValid() {
-		return sdk.ErrInvalidCoins(out.Coins.String())
+	if err := out.Coins.Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
 	}
 	if !out.Coins.IsPositive() {
 		return sdk.ErrInvalidCoins(out.Coins.String())
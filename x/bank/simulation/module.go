@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// bank module
+type AppModule struct {
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper) AppModule {
+	return AppModule{
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return bank.ModuleName
+}
+
+// randomized genesis state, a no-op the module having no genesis state
+func (AppModule) RandomizedGenesis(_ *simulation.GenesisState) {}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 100, Op: SingleInputSendMsg(am.accountKeeper, am.bankKeeper)},
+	}
+}
@@ -0,0 +1,20 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker halts the chain if an invariant has been found broken by a
+// MsgVerifyInvariant during the block.
+// NOTE: panicking here, rather than in the handler, ensures the panic is not
+// recovered as a failed transaction.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	broken, found := k.GetBrokenInvariant(ctx)
+	if !found {
+		return
+	}
+	panic(invariantBrokenMsg(ctx, broken.Route,
+		fmt.Sprintf("%s\nreported by %s", broken.Reason, broken.Sender)))
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	cdc *amino.Codec
+}
+
+func NewModuleClient(cdc *amino.Codec) ModuleClient {
+	return ModuleClient{cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	return &cobra.Command{Hidden: true}
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	crisisTxCmd := &cobra.Command{
+		Use:   "crisis",
+		Short: "Crisis transactions subcommands",
+	}
+
+	crisisTxCmd.AddCommand(client.PostCommands(
+		cli.GetCmdInvariantBroken(mc.cdc),
+	)...)
+
+	return crisisTxCmd
+}
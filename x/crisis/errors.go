@@ -0,0 +1,30 @@
+//nolint
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default crisis codespace
+	DefaultCodespace sdk.CodespaceType = "CRISIS"
+
+	CodeInvalidSender    CodeType = 101
+	CodeUnknownInvariant CodeType = 102
+)
+
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidSender, "invalid sender")
+	sdk.RegisterCode(DefaultCodespace, CodeUnknownInvariant, "unknown invariant")
+}
+
+func ErrNilSender(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSender, "sender address is nil")
+}
+
+func ErrUnknownInvariant(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownInvariant, "unknown invariant")
+}
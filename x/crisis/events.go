@@ -0,0 +1,9 @@
+package crisis
+
+// Crisis events
+var (
+	EventTypeInvariant = "invariant"
+
+	AttributeKeyRoute  = "route"
+	AttributeKeyBroken = "broken"
+)
@@ -0,0 +1,15 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// expected bank keeper
+type BankKeeper interface {
+	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error)
+}
+
+// expected fee collection keeper
+type FeeCollectionKeeper interface {
+	AddCollectedFees(sdk.Context, sdk.Coins) sdk.Coins
+}
@@ -0,0 +1,41 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GenesisState - crisis genesis state
+type GenesisState struct {
+	ConstantFee sdk.Coin `json:"constant_fee"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(constantFee sdk.Coin) GenesisState {
+	return GenesisState{
+		ConstantFee: constantFee,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ConstantFee: sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 1000),
+	}
+}
+
+// InitGenesis sets the crisis parameters from the genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetConstantFee(ctx, data.ConstantFee)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetConstantFee(ctx))
+}
+
+// ValidateGenesis performs basic validation of crisis genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return validateConstantFee(data.ConstantFee)
+}
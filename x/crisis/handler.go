@@ -0,0 +1,61 @@
+package crisis
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgVerifyInvariant:
+			return handleMsgVerifyInvariant(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in crisis module").Result()
+		}
+	}
+}
+
+// Anyone can verify a registered invariant by paying the constant fee. If the
+// invariant is found broken, it is recorded so that the chain halts at the end
+// of the block.
+func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper) sdk.Result {
+	route, found := k.getRoute(msg.FullInvariantRoute())
+	if !found {
+		return ErrUnknownInvariant(k.codespace).Result()
+	}
+
+	// remove the constant fee
+	constantFee := sdk.Coins{k.GetConstantFee(ctx)}
+	_, _, err := k.bankKeeper.SubtractCoins(ctx, msg.Sender, constantFee)
+	if err != nil {
+		return err.Result()
+	}
+	k.feeCollectionKeeper.AddCollectedFees(ctx, constantFee)
+
+	// use a cached context so that asserting the invariant neither modifies
+	// the state nor consumes the gas of the sender
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+	))
+	event := sdk.NewEvent(EventTypeInvariant, sdk.NewAttribute(AttributeKeyRoute, route.FullRoute()))
+
+	if invarErr := route.Invar(cacheCtx); invarErr != nil {
+		k.setBrokenInvariant(ctx, BrokenInvariant{
+			Sender: msg.Sender,
+			Route:  route.FullRoute(),
+			Reason: invarErr.Error(),
+		})
+		ctx.ModuleLogger("x/crisis").Error(
+			"invariant broken", "invariant", route.FullRoute(), "sender", msg.Sender, "reason", invarErr.Error(),
+		)
+		ctx.EventManager().EmitEvent(event.AppendAttributes(sdk.NewAttribute(AttributeKeyBroken, "true")))
+		return sdk.Result{}
+	}
+
+	ctx.EventManager().EmitEvent(event.AppendAttributes(sdk.NewAttribute(AttributeKeyBroken, "false")))
+	return sdk.Result{}
+}
@@ -0,0 +1,128 @@
+package crisis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	senderAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	constantFee  = DefaultGenesisState().ConstantFee
+	errInvariant = errors.New("invariant broken")
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, auth.AccountKeeper, auth.FeeCollectionKeeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyFees := sdk.NewKVStoreKey(auth.FeeStoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	tkeyCrisis := sdk.NewTransientStoreKey(TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyFees, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(tkeyCrisis, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	feeKeeper := auth.NewFeeCollectionKeeper(cdc, keyFees)
+	keeper := NewKeeper(cdc, tkeyCrisis, paramsKeeper.Subspace(DefaultParamspace),
+		bank.NewBaseKeeper(accountKeeper), feeKeeper, DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+
+	acc := accountKeeper.NewAccountWithAddress(ctx, senderAddr)
+	require.NoError(t, acc.SetCoins(sdk.Coins{constantFee}))
+	accountKeeper.SetAccount(ctx, acc)
+
+	keeper.RegisterRoute("module", "pass", func(sdk.Context) error { return nil })
+	keeper.RegisterRoute("module", "fail", func(sdk.Context) error { return errInvariant })
+	return ctx, keeper, accountKeeper, feeKeeper
+}
+
+func TestRegisterRoute(t *testing.T) {
+	_, keeper, _, _ := createTestInput(t)
+	require.Len(t, keeper.Routes(), 2)
+	require.Equal(t, "module/pass", keeper.Routes()[0].FullRoute())
+
+	// the same route may be registered by another module only
+	require.NotPanics(t, func() { keeper.RegisterRoute("other", "pass", func(sdk.Context) error { return nil }) })
+	require.Panics(t, func() { keeper.RegisterRoute("module", "pass", func(sdk.Context) error { return nil }) })
+}
+
+func TestAssertInvariants(t *testing.T) {
+	ctx, keeper, _, _ := createTestInput(t)
+	require.Panics(t, func() { keeper.AssertInvariants(ctx) })
+
+	keeper.routes = keeper.routes[:1]
+	require.NotPanics(t, func() { keeper.AssertInvariants(ctx) })
+}
+
+func TestHandleMsgVerifyInvariant(t *testing.T) {
+	ctx, keeper, accountKeeper, feeKeeper := createTestInput(t)
+	handler := NewHandler(keeper)
+
+	// unknown invariant
+	res := handler(ctx, NewMsgVerifyInvariant(senderAddr, "module", "unknown"))
+	require.Equal(t, CodeUnknownInvariant, res.Code)
+
+	// invariant holding, the constant fee is collected
+	res = handler(ctx, NewMsgVerifyInvariant(senderAddr, "module", "pass"))
+	require.True(t, res.IsOK(), "%v", res)
+	require.True(t, accountKeeper.GetAccount(ctx, senderAddr).GetCoins().IsZero())
+	require.Equal(t, sdk.Coins{constantFee}, feeKeeper.GetCollectedFees(ctx))
+
+	_, found := keeper.GetBrokenInvariant(ctx)
+	require.False(t, found)
+	require.NotPanics(t, func() { EndBlocker(ctx, keeper) })
+
+	// the sender cannot pay the constant fee anymore
+	res = handler(ctx, NewMsgVerifyInvariant(senderAddr, "module", "fail"))
+	require.False(t, res.IsOK())
+
+	// broken invariant, the chain halts at the end of the block
+	acc := accountKeeper.GetAccount(ctx, senderAddr)
+	require.NoError(t, acc.SetCoins(sdk.Coins{constantFee}))
+	accountKeeper.SetAccount(ctx, acc)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = handler(ctx, NewMsgVerifyInvariant(senderAddr, "module", "fail"))
+	require.True(t, res.IsOK(), "%v", res)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(EventTypeInvariant,
+		sdk.NewAttribute(AttributeKeyRoute, "module/fail"),
+		sdk.NewAttribute(AttributeKeyBroken, "true"),
+	))
+
+	broken, found := keeper.GetBrokenInvariant(ctx)
+	require.True(t, found)
+	require.Equal(t, BrokenInvariant{senderAddr, "module/fail", errInvariant.Error()}, broken)
+	require.Panics(t, func() { EndBlocker(ctx, keeper) })
+}
+
+func TestMsgVerifyInvariantValidateBasic(t *testing.T) {
+	require.Nil(t, NewMsgVerifyInvariant(senderAddr, "module", "route").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(nil, "module", "route").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(senderAddr, "", "route").ValidateBasic())
+	require.NotNil(t, NewMsgVerifyInvariant(senderAddr, "module", "").ValidateBasic())
+}
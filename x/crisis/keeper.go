@@ -0,0 +1,124 @@
+package crisis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// RouterKey is the message route for crisis
+	RouterKey = "crisis"
+
+	// TStoreKey is the string key for the crisis transient store
+	TStoreKey = "transient_crisis"
+
+	// default paramspace for params keeper
+	DefaultParamspace = "crisis"
+)
+
+// key of the broken invariant recorded for the current block
+var brokenInvariantKey = []byte{0x00}
+
+// Keeper - crisis keeper
+type Keeper struct {
+	routes     []InvarRoute
+	tkey       sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdk.CodespaceType
+
+	bankKeeper          BankKeeper
+	feeCollectionKeeper FeeCollectionKeeper
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc *codec.Codec, tkey sdk.StoreKey, paramSpace params.Subspace,
+	bankKeeper BankKeeper, feeCollectionKeeper FeeCollectionKeeper,
+	codespace sdk.CodespaceType) Keeper {
+
+	return Keeper{
+		routes:              []InvarRoute{},
+		tkey:                tkey,
+		cdc:                 cdc,
+		paramSpace:          paramSpace.WithTypeTable(ParamTypeTable()),
+		codespace:           codespace,
+		bankKeeper:          bankKeeper,
+		feeCollectionKeeper: feeCollectionKeeper,
+	}
+}
+
+// RegisterRoute registers an invariant under the given module name and route.
+// It panics if the route is already registered for the module.
+func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	invarRoute := NewInvarRoute(moduleName, route, invar)
+	if _, found := k.getRoute(invarRoute.FullRoute()); found {
+		panic(fmt.Sprintf("invariant route %s has already been registered", invarRoute.FullRoute()))
+	}
+	k.routes = append(k.routes, invarRoute)
+}
+
+// Routes returns the registered invariant routes
+func (k Keeper) Routes() []InvarRoute {
+	return k.routes
+}
+
+// Invariants returns all the registered invariants
+func (k Keeper) Invariants() []sdk.Invariant {
+	invars := make([]sdk.Invariant, len(k.routes))
+	for i, route := range k.routes {
+		invars[i] = route.Invar
+	}
+	return invars
+}
+
+func (k Keeper) getRoute(fullRoute string) (InvarRoute, bool) {
+	for _, route := range k.routes {
+		if route.FullRoute() == fullRoute {
+			return route, true
+		}
+	}
+	return InvarRoute{}, false
+}
+
+// AssertInvariants asserts all the registered invariants against the state of
+// the given context, and panics halting the chain if any of them is broken.
+func (k Keeper) AssertInvariants(ctx sdk.Context) {
+	start := time.Now()
+	for _, route := range k.routes {
+		if err := route.Invar(ctx); err != nil {
+			panic(invariantBrokenMsg(ctx, route.FullRoute(), err.Error()))
+		}
+	}
+	diff := time.Now().Sub(start)
+	ctx.ModuleLogger("x/crisis").Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+}
+
+// GetBrokenInvariant returns the invariant found broken by a
+// MsgVerifyInvariant in the current block, if any.
+func (k Keeper) GetBrokenInvariant(ctx sdk.Context) (broken BrokenInvariant, found bool) {
+	store := ctx.TransientStore(k.tkey)
+	bz := store.Get(brokenInvariantKey)
+	if bz == nil {
+		return broken, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &broken)
+	return broken, true
+}
+
+// records an invariant found broken, so that the chain is halted at the end
+// of the block
+func (k Keeper) setBrokenInvariant(ctx sdk.Context, broken BrokenInvariant) {
+	store := ctx.TransientStore(k.tkey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(broken)
+	store.Set(brokenInvariantKey, bz)
+}
+
+// detailed message the chain halts with when an invariant is broken
+func invariantBrokenMsg(ctx sdk.Context, fullRoute, reason string) string {
+	return fmt.Sprintf("invariant %s broken at height %d, halting the chain:\n%s",
+		fullRoute, ctx.BlockHeight(), reason)
+}
@@ -0,0 +1,64 @@
+package crisis
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleName is the name of the crisis module
+const ModuleName = "crisis"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the crisis module
+type AppModule struct {
+	cdc    *codec.Codec
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object, using the application codec
+// for the genesis state
+func NewAppModule(cdc *codec.Codec, keeper Keeper) AppModule {
+	return AppModule{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants is a no-op, the module having no invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// InitGenesis initializes the crisis state from its genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	am.cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return nil
+}
+
+// ExportGenesis exports the crisis state as a genesis state
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.keeper)
+	return am.cdc.MustMarshalJSON(genesisState)
+}
+
+// BeginBlock is a no-op for the crisis module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock halts the chain if an invariant has been found broken during the
+// block
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	EndBlocker(ctx, am.keeper)
+	return nil, sdk.EmptyTags()
+}
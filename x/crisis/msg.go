@@ -0,0 +1,62 @@
+package crisis
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var cdc = codec.New()
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgVerifyInvariant{}
+
+// MsgVerifyInvariant - message to verify a particular invariant, paying a
+// constant fee. The chain is halted if the invariant is broken.
+type MsgVerifyInvariant struct {
+	Sender              sdk.AccAddress `json:"sender"`
+	InvariantModuleName string         `json:"invariant_module_name"`
+	InvariantRoute      string         `json:"invariant_route"`
+}
+
+func NewMsgVerifyInvariant(sender sdk.AccAddress, invariantModuleName,
+	invariantRoute string) MsgVerifyInvariant {
+
+	return MsgVerifyInvariant{
+		Sender:              sender,
+		InvariantModuleName: invariantModuleName,
+		InvariantRoute:      invariantRoute,
+	}
+}
+
+//nolint
+func (msg MsgVerifyInvariant) Route() string { return RouterKey }
+func (msg MsgVerifyInvariant) Type() string  { return "verify_invariant" }
+func (msg MsgVerifyInvariant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgVerifyInvariant) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgVerifyInvariant) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrNilSender(DefaultCodespace)
+	}
+	if msg.InvariantModuleName == "" || msg.InvariantRoute == "" {
+		return ErrUnknownInvariant(DefaultCodespace)
+	}
+	return nil
+}
+
+// FullInvariantRoute returns the route of the invariant to verify, prefixed
+// by its module name
+func (msg MsgVerifyInvariant) FullInvariantRoute() string {
+	return NewInvarRoute(msg.InvariantModuleName, msg.InvariantRoute, nil).FullRoute()
+}
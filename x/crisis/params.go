@@ -0,0 +1,43 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store key
+var (
+	ParamStoreKeyConstantFee = []byte("ConstantFee")
+)
+
+// ParamTypeTable for crisis module
+func ParamTypeTable() params.TypeTable {
+	return params.NewTypeTable(
+		ParamStoreKeyConstantFee, sdk.Coin{},
+	).RegisterValidator(ParamStoreKeyConstantFee, func(value interface{}) error {
+		return validateConstantFee(value.(sdk.Coin))
+	})
+}
+
+// GetConstantFee returns the fee paid to verify an invariant
+func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
+	k.paramSpace.Get(ctx, ParamStoreKeyConstantFee, &constantFee)
+	return
+}
+
+// SetConstantFee sets the fee paid to verify an invariant
+func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
+	k.paramSpace.Set(ctx, ParamStoreKeyConstantFee, constantFee)
+}
+
+func validateConstantFee(constantFee sdk.Coin) error {
+	if constantFee.Denom == "" {
+		return fmt.Errorf("crisis constant fee denom should not be empty")
+	}
+	if !constantFee.IsPositive() {
+		return fmt.Errorf("crisis constant fee should be positive, is %s", constantFee)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package crisis
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvarRoute is an invariant registered by a module under a route
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// NewInvarRoute creates a new InvarRoute instance
+func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
+	return InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	}
+}
+
+// FullRoute returns the route prefixed by the module name
+func (i InvarRoute) FullRoute() string {
+	return fmt.Sprintf("%s/%s", i.ModuleName, i.Route)
+}
+
+// BrokenInvariant is an invariant found broken by a MsgVerifyInvariant
+type BrokenInvariant struct {
+	Sender sdk.AccAddress `json:"sender"`
+	Route  string         `json:"route"`
+	Reason string         `json:"reason"`
+}
@@ -47,6 +47,8 @@ var (
 	NewKeeper         = keeper.NewKeeper
 	DefaultParamspace = keeper.DefaultParamspace
 
+	NonNegativeOutstandingInvariant = keeper.NonNegativeOutstandingInvariant
+
 	RegisterCodec       = types.RegisterCodec
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
@@ -54,6 +54,10 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 }
 func (h Hooks) AfterValidatorPowerDidChange(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
 }
+func (h Hooks) AfterValidatorBondedDelta(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress, _ int64) {
+}
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coin) {
+}
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
 	// record the slash event
 	h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
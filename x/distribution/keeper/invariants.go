@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NonNegativeOutstandingInvariant checks that outstanding unwithdrawn fees are never negative
+func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		outstanding := k.GetOutstandingRewards(ctx)
+		if outstanding.HasNegative() {
+			return fmt.Errorf("Negative outstanding coins: %v", outstanding)
+		}
+		return nil
+	}
+}
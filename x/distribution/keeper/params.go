@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -12,7 +14,45 @@ func ParamTypeTable() params.TypeTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+	).
+		RegisterValidator(ParamStoreKeyCommunityTax, validateCommunityTax).
+		RegisterValidator(ParamStoreKeyBaseProposerReward, validateBaseProposerReward).
+		RegisterValidator(ParamStoreKeyBonusProposerReward, validateBonusProposerReward).
+		RegisterValidator(ParamStoreKeyWithdrawAddrEnabled, validateWithdrawAddrEnabled)
+}
+
+func validateCommunityTax(i interface{}) error {
+	tax := i.(sdk.Dec)
+	if tax.IsNegative() || tax.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter CommunityTax should be non-negative and "+
+			"less than one, is %s", tax.String())
+	}
+	return nil
+}
+
+func validateBaseProposerReward(i interface{}) error {
+	reward := i.(sdk.Dec)
+	if reward.IsNegative() || reward.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter BaseProposerReward should be non-negative and "+
+			"less than one, is %s", reward.String())
+	}
+	return nil
+}
+
+func validateBonusProposerReward(i interface{}) error {
+	reward := i.(sdk.Dec)
+	if reward.IsNegative() || reward.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameter BonusProposerReward should be non-negative and "+
+			"less than one, is %s", reward.String())
+	}
+	return nil
+}
+
+func validateWithdrawAddrEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("distribution parameter WithdrawAddrEnabled should be a boolean, is %T", i)
+	}
+	return nil
 }
 
 // returns the current CommunityTax rate from the global param store
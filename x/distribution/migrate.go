@@ -0,0 +1,11 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGenesisMigration registers the migration of the distribution genesis
+// state of the previous release, which lacks the withdraw_addr_enabled param.
+func RegisterGenesisMigration(m *sdk.GenesisMigrator) {
+	m.RegisterMigration(ModuleName, sdk.DefaultsGenesisMigration(DefaultGenesisState()))
+}
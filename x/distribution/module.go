@@ -0,0 +1,65 @@
+package distribution
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleName is the name of the distribution module
+const ModuleName = "distr"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the distribution module
+type AppModule struct {
+	cdc    *codec.Codec
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object, using the application codec
+// for the genesis state
+func NewAppModule(cdc *codec.Codec, keeper Keeper) AppModule {
+	return AppModule{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants registers the distribution invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	ir.RegisterRoute(RouterKey, "nonnegative-outstanding", NonNegativeOutstandingInvariant(am.keeper))
+}
+
+// InitGenesis initializes the distribution state from its genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	am.cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return nil
+}
+
+// ExportGenesis exports the distribution state as a genesis state
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.keeper)
+	return am.cdc.MustMarshalJSON(genesisState)
+}
+
+// BeginBlock distributes the rewards of the previous block
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, req, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// EndBlock is a no-op for the distribution module
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return nil, sdk.EmptyTags()
+}
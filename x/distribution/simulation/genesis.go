@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// RandomizedGenState generates a random genesis state of the distribution
+// module
+func RandomizedGenState(simState *simulation.GenesisState) {
+	r := simState.Rand
+
+	distrGenesis := distr.GenesisState{
+		FeePool:             distr.InitialFeePool(),
+		CommunityTax:        sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
+		BaseProposerReward:  sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
+		BonusProposerReward: sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2)),
+	}
+	fmt.Printf("Selected randomly generated distribution parameters:\n\t%+v\n", distrGenesis)
+
+	simState.SetGenesis(distr.ModuleName, distrGenesis)
+}
@@ -26,13 +26,7 @@ func AllInvariants(d distr.Keeper, stk staking.Keeper) simulation.Invariant {
 
 // NonNegativeOutstandingInvariant checks that outstanding unwithdrawn fees are never negative
 func NonNegativeOutstandingInvariant(k distr.Keeper) simulation.Invariant {
-	return func(ctx sdk.Context) error {
-		outstanding := k.GetOutstandingRewards(ctx)
-		if outstanding.HasNegative() {
-			return fmt.Errorf("Negative outstanding coins: %v", outstanding)
-		}
-		return nil
-	}
+	return distr.NonNegativeOutstandingInvariant(k)
 }
 
 // CanWithdrawInvariant checks that current rewards can be completely withdrawn
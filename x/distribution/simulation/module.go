@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// distribution module
+type AppModule struct {
+	accountKeeper auth.AccountKeeper
+	keeper        distr.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper, keeper distr.Keeper) AppModule {
+	return AppModule{
+		accountKeeper: accountKeeper,
+		keeper:        keeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return distr.ModuleName
+}
+
+// randomized genesis state
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 50, Op: SimulateMsgSetWithdrawAddress(am.accountKeeper, am.keeper)},
+		{Weight: 50, Op: SimulateMsgWithdrawDelegatorReward(am.accountKeeper, am.keeper)},
+		{Weight: 50, Op: SimulateMsgWithdrawValidatorCommission(am.accountKeeper, am.keeper)},
+	}
+}
@@ -15,6 +15,13 @@ const (
 	CodeSetWithdrawAddrDisabled CodeType          = 106
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidInput, "invalid input")
+	sdk.RegisterCode(DefaultCodespace, CodeNoDistributionInfo, "no distribution info")
+	sdk.RegisterCode(DefaultCodespace, CodeNoValidatorCommission, "no validator commission to withdraw")
+	sdk.RegisterCode(DefaultCodespace, CodeSetWithdrawAddrDisabled, "set withdraw address disabled")
+}
+
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "delegator address is nil")
 }
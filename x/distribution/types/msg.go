@@ -109,7 +109,7 @@ func (msg MsgWithdrawValidatorCommission) Type() string  { return "withdraw_vali
 
 // Return address that must sign over msg.GetSignBytes()
 func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr.Bytes())}
+	return []sdk.AccAddress{sdk.AccAddressFromValAddress(msg.ValidatorAddr)}
 }
 
 // get the bytes for the message signer to sign on
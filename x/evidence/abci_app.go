@@ -0,0 +1,27 @@
+package evidence
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker routes the evidence of misbehaviour reported by Tendermint to
+// the registered handlers
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	logger := ctx.ModuleLogger("x/evidence")
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			equivocation := ConvertDuplicateVoteEvidence(evidence)
+			if err := k.HandleEvidence(ctx, equivocation); err != nil {
+				logger.Error(fmt.Sprintf("ignored equivocation evidence %s: %s", equivocation.Hash(), err.Result().Log))
+			}
+		default:
+			logger.Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
+		}
+	}
+}
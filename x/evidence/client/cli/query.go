@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+)
+
+// nolint
+const (
+	FlagPage  = "page"
+	FlagLimit = "limit"
+)
+
+// GetCmdQueryEvidence implements the command to query the processed evidence,
+// or a single one by its hash.
+func GetCmdQueryEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evidence [hash]",
+		Short: "Query the processed evidence of misbehaviour, or a single one by its hash",
+		Long: `Query the evidence of misbehaviour processed by the chain. If a hash is
+given, only the evidence of that hash is returned.
+
+Example:
+$ gaiacli query evidence
+$ gaiacli query evidence DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var params interface{}
+			var route string
+			if len(args) == 1 {
+				params = evidence.NewQueryEvidenceParams(args[0])
+				route = fmt.Sprintf("custom/%s/%s", evidence.QuerierRoute, evidence.QueryEvidence)
+			} else {
+				params = evidence.NewQueryAllEvidenceParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+				route = fmt.Sprintf("custom/%s/%s", evidence.QuerierRoute, evidence.QueryAllEvidence)
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, 0, "Query a specific page of paginated results (requires --limit)")
+	cmd.Flags().Int(FlagLimit, 0, "Maximum number of results per page (0 returns all the results)")
+
+	return cmd
+}
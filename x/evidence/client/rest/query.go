@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+)
+
+// RegisterRoutes registers evidence-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/evidence",
+		queryAllEvidenceHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/evidence/{evidenceHash}",
+		queryEvidenceHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+}
+
+// http request handler to query a single processed evidence by its hash
+func queryEvidenceHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+
+		bz, err := cdc.MarshalJSON(evidence.NewQueryEvidenceParams(vars["evidenceHash"]))
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", evidence.QuerierRoute, evidence.QueryEvidence)
+		res, height, err := cliCtx.QueryWithHeight(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
+	}
+}
+
+// http request handler to query all the processed evidence
+func queryAllEvidenceHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		pagination, page, ok := utils.ParseQuerierPaginationOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(evidence.NewQueryAllEvidenceParams(page, pagination.Limit))
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", evidence.QuerierRoute, evidence.QueryAllEvidence)
+		res, height, err := cliCtx.QueryWithHeight(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQuerierPageResponse(w, cliCtx, pagination, res)
+	}
+}
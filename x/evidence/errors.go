@@ -0,0 +1,52 @@
+// nolint
+package evidence
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default evidence codespace
+	DefaultCodespace sdk.CodespaceType = "EVIDENCE"
+
+	CodeInvalidEvidence   CodeType = 101
+	CodeNoEvidenceHandler CodeType = 102
+	CodeEvidenceExists    CodeType = 103
+	CodeEvidenceNotFound  CodeType = 104
+	CodeInvalidSubmitter  CodeType = 105
+)
+
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidEvidence, "invalid evidence")
+	sdk.RegisterCode(DefaultCodespace, CodeNoEvidenceHandler, "no evidence handler")
+	sdk.RegisterCode(DefaultCodespace, CodeEvidenceExists, "evidence already processed")
+	sdk.RegisterCode(DefaultCodespace, CodeEvidenceNotFound, "evidence not found")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidSubmitter, "invalid submitter")
+}
+
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+func ErrNoEvidenceHandler(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandler, fmt.Sprintf("no handler registered for evidence route %s", route))
+}
+
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence %s has already been processed", hash))
+}
+
+func ErrEvidenceNotFound(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceNotFound, fmt.Sprintf("evidence %s not found", hash))
+}
+
+func ErrNilSubmitter(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSubmitter, "submitter address is nil")
+}
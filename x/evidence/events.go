@@ -0,0 +1,9 @@
+package evidence
+
+// Evidence events
+var (
+	EventTypeEvidence = "evidence"
+
+	AttributeKeyRoute        = "route"
+	AttributeKeyEvidenceHash = "evidence_hash"
+)
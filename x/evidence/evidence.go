@@ -0,0 +1,84 @@
+package evidence
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence of misbehaviour, routed to the handler registered for its route
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() error
+
+	// height at which the infraction occurred
+	GetHeight() int64
+}
+
+// RouteEquivocation is the route of the evidence of validators double signing
+const RouteEquivocation = "equivocation"
+
+var _ Evidence = Equivocation{}
+
+// Equivocation is the evidence of a validator signing two conflicting blocks
+// at the same height, as reported by Tendermint.
+type Equivocation struct {
+	Height           int64           `json:"height"`
+	Time             time.Time       `json:"time"`
+	Power            int64           `json:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address"`
+}
+
+// NewEquivocation creates a new Equivocation instance
+func NewEquivocation(height int64, t time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             t,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+// ConvertDuplicateVoteEvidence converts the ABCI evidence of a duplicate vote
+// to an Equivocation
+func ConvertDuplicateVoteEvidence(evidence abci.Evidence) Equivocation {
+	return NewEquivocation(evidence.Height, evidence.Time, evidence.Validator.Power,
+		sdk.ConsAddress(evidence.Validator.Address))
+}
+
+// nolint
+func (e Equivocation) Route() string      { return RouteEquivocation }
+func (e Equivocation) Type() string       { return "equivocation" }
+func (e Equivocation) GetHeight() int64   { return e.Height }
+func (e Equivocation) Hash() cmn.HexBytes { return tmhash.Sum(msgCdc.MustMarshalBinaryBare(e)) }
+
+// ValidateBasic performs basic stateless validation of the evidence
+func (e Equivocation) ValidateBasic() error {
+	if e.Height < 1 {
+		return fmt.Errorf("invalid equivocation height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid equivocation validator power: %d", e.Power)
+	}
+	if e.ConsensusAddress.Empty() {
+		return fmt.Errorf("invalid equivocation validator consensus address: %s", e.ConsensusAddress)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (e Equivocation) String() string {
+	return fmt.Sprintf(`Equivocation:
+  Height:            %d
+  Time:              %s
+  Power:             %d
+  Consensus Address: %s`, e.Height, e.Time, e.Power, e.ConsensusAddress)
+}
@@ -0,0 +1,53 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all evidence state that must be provided at genesis
+type GenesisState struct {
+	Evidence []Evidence `json:"evidence"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(evidence []Evidence) GenesisState {
+	return GenesisState{
+		Evidence: evidence,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Evidence{})
+}
+
+// InitGenesis stores the processed evidence of the genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, evidence := range data.Evidence {
+		keeper.SetEvidence(ctx, evidence)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetAllEvidence(ctx))
+}
+
+// ValidateGenesis performs basic validation of evidence genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	hashes := make(map[string]bool, len(data.Evidence))
+	for _, evidence := range data.Evidence {
+		if err := evidence.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid evidence %s: %s", evidence.Hash(), err)
+		}
+		hash := evidence.Hash().String()
+		if hashes[hash] {
+			return fmt.Errorf("duplicate evidence %s", hash)
+		}
+		hashes[hash] = true
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package evidence
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StoreKey is the store key string for evidence
+	StoreKey = "evidence"
+
+	// RouterKey is the message route for evidence
+	RouterKey = "evidence"
+
+	// QuerierRoute is the querier route for evidence
+	QuerierRoute = "evidence"
+)
+
+// Keys for evidence store
+var (
+	EvidenceKeyPrefix = []byte{0x00} // prefix for the processed evidence
+)
+
+// GetEvidenceKey gets the key storing the evidence of the given hash
+func GetEvidenceKey(hash cmn.HexBytes) []byte {
+	return append(EvidenceKeyPrefix, hash...)
+}
+
+// Handler processes the evidence routed to it, returning an error if the
+// evidence is invalid
+type Handler func(ctx sdk.Context, evidence Evidence) error
+
+// Keeper of the evidence store
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       *codec.Codec
+	handlers  map[string]Handler
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new evidence Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		handlers:  make(map[string]Handler),
+		codespace: codespace,
+	}
+}
+
+// SetHandler registers the handler processing the evidence of the given route.
+// It panics if a handler is already registered for the route.
+func (k Keeper) SetHandler(route string, handler Handler) {
+	if _, ok := k.handlers[route]; ok {
+		panic(fmt.Sprintf("evidence handler for route %s has already been registered", route))
+	}
+	k.handlers[route] = handler
+}
+
+// HasHandler returns whether a handler is registered for the given route
+func (k Keeper) HasHandler(route string) bool {
+	_, ok := k.handlers[route]
+	return ok
+}
+
+// HandleEvidence routes the evidence to its handler and stores it once
+// processed. Evidence which has already been processed is rejected.
+func (k Keeper) HandleEvidence(ctx sdk.Context, evidence Evidence) sdk.Error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(k.codespace, err.Error())
+	}
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return ErrEvidenceExists(k.codespace, evidence.Hash())
+	}
+
+	handler, ok := k.handlers[evidence.Route()]
+	if !ok {
+		return ErrNoEvidenceHandler(k.codespace, evidence.Route())
+	}
+
+	// only persist the state changes and events of the handler if it accepts
+	// the evidence
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := handler(cacheCtx, evidence); err != nil {
+		return ErrInvalidEvidence(k.codespace, err.Error())
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.SetEvidence(ctx, evidence)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeEvidence,
+		sdk.NewAttribute(AttributeKeyRoute, evidence.Route()),
+		sdk.NewAttribute(AttributeKeyEvidenceHash, evidence.Hash().String()),
+	))
+	return nil
+}
+
+// SubmitEvidence handles the evidence submitted by a user. Equivocations can
+// only be reported by Tendermint, as the user-submitted ones cannot be
+// verified.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence Evidence) sdk.Error {
+	if evidence.Route() == RouteEquivocation {
+		return ErrInvalidEvidence(k.codespace, "equivocations can only be reported by Tendermint")
+	}
+	return k.HandleEvidence(ctx, evidence)
+}
+
+// GetEvidence returns the processed evidence of the given hash, if any
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// SetEvidence stores processed evidence
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	store.Set(GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// IterateEvidence iterates over the processed evidence, ordered by hash
+func (k Keeper) IterateEvidence(ctx sdk.Context, handler func(evidence Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, EvidenceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var evidence Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &evidence)
+		if handler(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all the processed evidence
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []Evidence) {
+	k.IterateEvidence(ctx, func(e Evidence) (stop bool) {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
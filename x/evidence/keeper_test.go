@@ -0,0 +1,157 @@
+package evidence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	consAddr  = sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	submitter = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	handled   = []byte("handled")
+)
+
+// evidence which can be submitted by users, rejected by its handler if Reject is set
+type testEvidence struct {
+	Height int64
+	Reject bool
+}
+
+// nolint
+func (e testEvidence) Route() string        { return "test" }
+func (e testEvidence) Type() string         { return "test" }
+func (e testEvidence) String() string       { return "test evidence" }
+func (e testEvidence) Hash() cmn.HexBytes   { return tmhash.Sum(msgCdc.MustMarshalBinaryBare(e)) }
+func (e testEvidence) ValidateBasic() error { return nil }
+func (e testEvidence) GetHeight() int64     { return e.Height }
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyEvidence := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "test/testEvidence", nil)
+
+	keeper := NewKeeper(cdc, keyEvidence, DefaultCodespace)
+
+	// handlers flag in the store the evidence they processed
+	handler := func(ctx sdk.Context, e Evidence) error {
+		ctx.KVStore(keyEvidence).Set(append(handled, e.Hash()...), []byte{0x01})
+		if test, ok := e.(testEvidence); ok && test.Reject {
+			return errors.New("rejected")
+		}
+		return nil
+	}
+	keeper.SetHandler(RouteEquivocation, handler)
+	keeper.SetHandler("test", handler)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	return ctx, keeper
+}
+
+func isHandled(ctx sdk.Context, k Keeper, e Evidence) bool {
+	return ctx.KVStore(k.storeKey).Has(append(handled, e.Hash()...))
+}
+
+func TestSetHandler(t *testing.T) {
+	_, keeper := createTestInput(t)
+	require.True(t, keeper.HasHandler("test"))
+	require.False(t, keeper.HasHandler("other"))
+	require.Panics(t, func() { keeper.SetHandler("test", func(sdk.Context, Evidence) error { return nil }) })
+}
+
+func TestHandleEvidence(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	equivocation := NewEquivocation(5, time.Unix(0, 0).UTC(), 10, consAddr)
+
+	require.Nil(t, keeper.HandleEvidence(ctx, equivocation))
+	require.True(t, isHandled(ctx, keeper, equivocation))
+	require.Equal(t, sdk.Events{sdk.NewEvent(EventTypeEvidence,
+		sdk.NewAttribute(AttributeKeyRoute, RouteEquivocation),
+		sdk.NewAttribute(AttributeKeyEvidenceHash, equivocation.Hash().String()),
+	)}, ctx.EventManager().Events())
+
+	stored, found := keeper.GetEvidence(ctx, equivocation.Hash())
+	require.True(t, found)
+	require.Equal(t, equivocation, stored)
+
+	// evidence is processed once only
+	err := keeper.HandleEvidence(ctx, equivocation)
+	require.NotNil(t, err)
+	require.Equal(t, CodeEvidenceExists, err.Code())
+
+	// invalid evidence
+	err = keeper.HandleEvidence(ctx, NewEquivocation(0, time.Unix(0, 0), 10, consAddr))
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidEvidence, err.Code())
+
+	// the state changes of a handler rejecting the evidence are discarded
+	rejected := testEvidence{Height: 5, Reject: true}
+	err = keeper.HandleEvidence(ctx, rejected)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidEvidence, err.Code())
+	require.False(t, isHandled(ctx, keeper, rejected))
+	_, found = keeper.GetEvidence(ctx, rejected.Hash())
+	require.False(t, found)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	require.Len(t, keeper.GetAllEvidence(ctx), 1)
+}
+
+func TestHandleMsgSubmitEvidence(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+
+	// equivocations can only be reported by Tendermint
+	res := handler(ctx, NewMsgSubmitEvidence(submitter, NewEquivocation(5, time.Unix(0, 0), 10, consAddr)))
+	require.Equal(t, CodeInvalidEvidence, res.Code)
+
+	accepted := testEvidence{Height: 5}
+	res = handler(ctx, NewMsgSubmitEvidence(submitter, accepted))
+	require.True(t, res.IsOK(), "%v", res)
+	require.Equal(t, []byte(accepted.Hash()), res.Data)
+	require.True(t, isHandled(ctx, keeper, accepted))
+
+	res = handler(ctx, NewMsgSubmitEvidence(submitter, testEvidence{Height: 5, Reject: true}))
+	require.Equal(t, CodeInvalidEvidence, res.Code)
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	abciEvidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: consAddr, Power: 10},
+		Height:    5,
+		Time:      time.Unix(0, 0).UTC(),
+	}
+	req := abci.RequestBeginBlock{ByzantineValidators: []abci.Evidence{
+		abciEvidence,
+		{Type: "unknown"},
+		abciEvidence, // duplicates are ignored
+	}}
+
+	require.NotPanics(t, func() { BeginBlocker(ctx, req, keeper) })
+
+	equivocation := NewEquivocation(5, time.Unix(0, 0).UTC(), 10, consAddr)
+	require.Equal(t, []Evidence{equivocation}, keeper.GetAllEvidence(ctx))
+	require.True(t, isHandled(ctx, keeper, equivocation))
+}
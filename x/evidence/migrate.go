@@ -0,0 +1,12 @@
+package evidence
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGenesisMigration registers the migration of the genesis state of the
+// previous release, which has no evidence module, to the default evidence
+// genesis state.
+func RegisterGenesisMigration(m *sdk.GenesisMigrator) {
+	m.RegisterMigration(ModuleName, sdk.DefaultsGenesisMigration(DefaultGenesisState()))
+}
@@ -0,0 +1,63 @@
+package evidence
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleName is the name of the evidence module
+const ModuleName = "evidence"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the evidence module
+type AppModule struct {
+	cdc    *codec.Codec
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object, using the application codec
+// for the genesis state
+func NewAppModule(cdc *codec.Codec, keeper Keeper) AppModule {
+	return AppModule{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants is a no-op, the module having no invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// InitGenesis initializes the evidence state from its genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	am.cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return nil
+}
+
+// ExportGenesis exports the evidence state as a genesis state
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.keeper)
+	return am.cdc.MustMarshalJSON(genesisState)
+}
+
+// BeginBlock slashes the validators which double signed
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, req, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// EndBlock is a no-op for the evidence module
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return nil, sdk.EmptyTags()
+}
@@ -0,0 +1,51 @@
+package evidence
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// verify interface at compile time
+var _ sdk.Msg = &MsgSubmitEvidence{}
+
+// MsgSubmitEvidence - message submitting evidence of misbehaviour
+type MsgSubmitEvidence struct {
+	Submitter sdk.AccAddress `json:"submitter"`
+	Evidence  Evidence       `json:"evidence"`
+}
+
+func NewMsgSubmitEvidence(submitter sdk.AccAddress, evidence Evidence) MsgSubmitEvidence {
+	return MsgSubmitEvidence{
+		Submitter: submitter,
+		Evidence:  evidence,
+	}
+}
+
+// nolint
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+func (msg MsgSubmitEvidence) Type() string  { return "submit_evidence" }
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Submitter.Empty() {
+		return ErrNilSubmitter(DefaultCodespace)
+	}
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	if err := msg.Evidence.ValidateBasic(); err != nil {
+		return ErrInvalidEvidence(DefaultCodespace, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package evidence
+
+import (
+	"encoding/hex"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query endpoints supported by the evidence querier
+const (
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// NewQuerier creates a new querier for evidence clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryEvidence:
+			return queryEvidence(ctx, req, k)
+		case QueryAllEvidence:
+			return queryAllEvidence(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown evidence query endpoint")
+		}
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/evidence/evidence'
+type QueryEvidenceParams struct {
+	EvidenceHash string
+}
+
+func NewQueryEvidenceParams(hash string) QueryEvidenceParams {
+	return QueryEvidenceParams{
+		EvidenceHash: hash,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/evidence/all_evidence'
+// A zero limit returns all the evidence.
+type QueryAllEvidenceParams struct {
+	Page  int
+	Limit int
+}
+
+func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
+	return QueryAllEvidenceParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryEvidenceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	hash, err := hex.DecodeString(params.EvidenceHash)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid evidence hash", err.Error()))
+	}
+
+	evidence, found := k.GetEvidence(ctx, hash)
+	if !found {
+		return nil, ErrEvidenceNotFound(k.codespace, hash)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAllEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAllEvidenceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if params.Page < 0 || params.Limit < 0 {
+		return nil, sdk.ErrUnknownRequest("page and limit cannot be negative")
+	}
+
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+	skip := (page - 1) * params.Limit
+
+	evidence := []Evidence{}
+	k.IterateEvidence(ctx, func(e Evidence) (stop bool) {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		evidence = append(evidence, e)
+		return params.Limit != 0 && len(evidence) == params.Limit
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package evidence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueryEvidence(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	querier := NewQuerier(keeper)
+
+	evidence := []Evidence{
+		NewEquivocation(5, time.Unix(0, 0).UTC(), 10, consAddr),
+		NewEquivocation(6, time.Unix(0, 0).UTC(), 10, consAddr),
+		NewEquivocation(7, time.Unix(0, 0).UTC(), 10, consAddr),
+	}
+	for _, e := range evidence {
+		require.Nil(t, keeper.HandleEvidence(ctx, e))
+	}
+
+	query := func(path string, params interface{}) ([]byte, sdk.Error) {
+		bz, err := keeper.cdc.MarshalJSON(params)
+		require.NoError(t, err)
+		return querier(ctx, []string{path}, abci.RequestQuery{Data: bz})
+	}
+
+	// single evidence
+	res, err := query(QueryEvidence, NewQueryEvidenceParams(evidence[1].Hash().String()))
+	require.Nil(t, err)
+	var e Evidence
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &e))
+	require.Equal(t, evidence[1], e)
+
+	_, err = query(QueryEvidence, NewQueryEvidenceParams("DEADBEEF"))
+	require.NotNil(t, err)
+	require.Equal(t, CodeEvidenceNotFound, err.Code())
+
+	_, err = query(QueryEvidence, NewQueryEvidenceParams("invalid"))
+	require.NotNil(t, err)
+
+	// all the evidence, paginated
+	var all []Evidence
+	res, err = query(QueryAllEvidence, NewQueryAllEvidenceParams(0, 0))
+	require.Nil(t, err)
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &all))
+	require.Len(t, all, 3)
+
+	var page []Evidence
+	res, err = query(QueryAllEvidence, NewQueryAllEvidenceParams(2, 2))
+	require.Nil(t, err)
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, all[2:], page)
+
+	_, err = query(QueryAllEvidence, NewQueryAllEvidenceParams(-1, 0))
+	require.NotNil(t, err)
+}
@@ -76,18 +76,24 @@ Query for a all proposals. You can filter the returns with the following flags:
 $ gaiacli query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ gaiacli query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+
+Matching proposals can be paginated from the latest one with the --page and --limit flags:
+
+$ gaiacli query gov proposals --status VotingPeriod --page 2 --limit 10
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr := viper.GetString(flagDepositor)
 			bechVoterAddr := viper.GetString(flagVoter)
 			strProposalStatus := viper.GetString(flagStatus)
 			numLimit := uint64(viper.GetInt64(flagNumLimit))
+			page := uint64(viper.GetInt64(flagPage))
 
 			var depositorAddr sdk.AccAddress
 			var voterAddr sdk.AccAddress
 			var proposalStatus gov.ProposalStatus
 
 			params := gov.NewQueryProposalsParams(proposalStatus, numLimit, voterAddr, depositorAddr)
+			params.Page = page
 
 			if len(bechDepositorAddr) != 0 {
 				depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -144,7 +150,8 @@ $ gaiacli query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Reject
 		},
 	}
 
-	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] proposals. Defaults to all proposals")
+	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] matching proposals. Defaults to all proposals")
+	cmd.Flags().String(flagPage, "", "(optional) page of [limit] matching proposals, counted from the latest ones. Defaults to the first page")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
@@ -436,12 +443,55 @@ $ gaiacli query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryLiveTally implements the command to query for the current tally
+// of a proposal in voting period.
+func GetCmdQueryLiveTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "live-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the current tally of a proposal in voting period",
+		Long: strings.TrimSpace(`
+Query the current tally of votes on a proposal in voting period, along with its
+progress towards the quorum and pass threshold:
+
+$ gaiacli query gov live-tally 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Construct query
+			params := gov.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/live_tally", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|proposal) of the governance process",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
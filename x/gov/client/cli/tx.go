@@ -13,6 +13,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	"encoding/json"
 	"io/ioutil"
@@ -34,7 +36,9 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
+	flagPage         = "page"
 	flagProposal     = "proposal"
+	flagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -42,6 +46,9 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Changes     []params.ParamChange
+	Plan        *upgrade.Plan
+	Expedited   bool
 }
 
 var proposalFlags = []string{
@@ -73,6 +80,46 @@ where proposal.json contains:
 is equivalent to
 
 $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Parameter change proposals can only be submitted through a proposal JSON file.
+Each change names the param subspace, the parameter key and its new JSON encoded
+value, which is applied once the proposal passes:
+
+{
+  "title": "Lower Max Validators",
+  "description": "Reduce the size of the validator set",
+  "type": "ParameterChange",
+  "deposit": "10test",
+  "changes": [
+    {
+      "subspace": "staking",
+      "key": "MaxValidators",
+      "value": "80"
+    }
+  ]
+}
+
+Software upgrade proposals are submitted the same way, with the upgrade plan
+scheduled once the proposal passes. The chain halts at the plan height until
+it is restarted with the upgraded binary. A scheduled upgrade can be cancelled
+with a proposal of type "CancelSoftwareUpgrade".
+
+{
+  "title": "Upgrade to v2",
+  "description": "Switch to the v2 binary",
+  "type": "SoftwareUpgrade",
+  "deposit": "10test",
+  "plan": {
+    "name": "v2",
+    "height": 1000000,
+    "info": "https://github.com/cosmos/cosmos-sdk/releases"
+  }
+}
+
+Time-critical proposals can be expedited with the --expedited flag, or the
+"expedited" field of the proposal JSON file. Expedited proposals need a higher
+deposit and pass threshold but have a shorter voting period. An expedited
+proposal which doesn't pass falls back to a regular proposal.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			proposal, err := parseSubmitProposalFlags()
@@ -114,6 +161,13 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 			}
 
 			msg := gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, from, amount)
+			switch {
+			case len(proposal.Changes) != 0:
+				msg = gov.NewMsgSubmitParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes, from, amount)
+			case proposal.Plan != nil:
+				msg = gov.NewMsgSubmitSoftwareUpgradeProposal(proposal.Title, proposal.Description, *proposal.Plan, from, amount)
+			}
+			msg.Expedited = proposal.Expedited
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -132,8 +186,9 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade/cancel_software_upgrade")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagExpedited, false, "submit an expedited proposal, with a shorter voting period and a higher deposit and threshold")
 	cmd.Flags().String(flagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
@@ -148,6 +203,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(flagDescription)
 		proposal.Type = govClientUtils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(flagDeposit)
+		proposal.Expedited = viper.GetBool(flagExpedited)
 		return proposal, nil
 	}
 
@@ -156,6 +212,9 @@ func parseSubmitProposalFlags() (*proposal, error) {
 			return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flag)
 		}
 	}
+	if viper.GetBool(flagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flagExpedited)
+	}
 
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
@@ -300,3 +359,143 @@ $ gaiacli tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power across options",
+		Long: strings.TrimSpace(`
+Submit a vote for an acive proposal, splitting the voting power across several options.
+The weights of the options must sum up to 1. You can find the proposal-id by running gaiacli query gov proposals:
+
+$ gaiacli tx gov weighted-vote 1 yes=0.6,abstain=0.4 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get voting address
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// check to see if the proposal is in the store
+			_, err = queryProposal(proposalID, cliCtx, cdc, queryRoute)
+			if err != nil {
+				return fmt.Errorf("Failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			options, err := parseWeightedVoteOptions(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := gov.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// If generate only print the transaction
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+
+			// Build and sign the transaction, then broadcast to a Tendermint node.
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdCancelProposal implements the cancel proposal command.
+func GetCmdCancelProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in its deposit or voting period",
+		Long: strings.TrimSpace(`
+Cancel a proposal in its deposit or voting period. Only the proposer can cancel a proposal.
+A portion of the deposits, set by the cancel_burn_rate deposit parameter, is burned and the
+rest is refunded to the depositors:
+
+$ gaiacli tx gov cancel 1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get proposer address
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// check to see if the proposal is in the store
+			_, err = queryProposal(proposalID, cliCtx, cdc, queryRoute)
+			if err != nil {
+				return fmt.Errorf("Failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			msg := gov.NewMsgCancelProposal(from, proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+
+			// Build and sign the transaction, then broadcast to a Tendermint node.
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// parses weighted vote options of the form yes=0.6,abstain=0.4
+func parseWeightedVoteOptions(str string) (gov.WeightedVoteOptions, error) {
+	var options gov.WeightedVoteOptions
+	for _, part := range strings.Split(str, ",") {
+		fields := strings.Split(part, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("weighted vote option %s is not of the form option=weight", part)
+		}
+
+		option, err := gov.VoteOptionFromString(govClientUtils.NormalizeVoteOption(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, gov.NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
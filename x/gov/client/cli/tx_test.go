@@ -50,6 +50,9 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 		require.Error(t, err)
 		viper.Set(incompatibleFlag, "")
 	}
+	viper.Set(flagExpedited, true)
+	_, err = parseSubmitProposalFlags()
+	require.Error(t, err)
 
 	// no --proposal, only flags
 	viper.Set(flagProposal, "")
@@ -63,6 +66,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, proposal1.Description, proposal2.Description)
 	require.Equal(t, proposal1.Type, proposal2.Type)
 	require.Equal(t, proposal1.Deposit, proposal2.Deposit)
+	require.True(t, proposal2.Expedited)
+	viper.Set(flagExpedited, false)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -35,7 +35,8 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		govCli.GetCmdQueryProposer(mc.storeKey, mc.cdc),
 		govCli.GetCmdQueryDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdQueryDeposits(mc.storeKey, mc.cdc),
-		govCli.GetCmdQueryTally(mc.storeKey, mc.cdc))...)
+		govCli.GetCmdQueryTally(mc.storeKey, mc.cdc),
+		govCli.GetCmdQueryLiveTally(mc.storeKey, mc.cdc))...)
 
 	return govQueryCmd
 }
@@ -50,7 +51,9 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	govTxCmd.AddCommand(client.PostCommands(
 		govCli.GetCmdDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdVote(mc.storeKey, mc.cdc),
+		govCli.GetCmdWeightedVote(mc.storeKey, mc.cdc),
 		govCli.GetCmdSubmitProposal(mc.cdc),
+		govCli.GetCmdCancelProposal(mc.storeKey, mc.cdc),
 	)...)
 
 	return govTxCmd
@@ -24,6 +24,8 @@ func NormalizeProposalType(proposalType string) string {
 		return "ParameterChange"
 	case "SoftwareUpgrade", "software_upgrade":
 		return "SoftwareUpgrade"
+	case "CancelSoftwareUpgrade", "cancel_software_upgrade":
+		return "CancelSoftwareUpgrade"
 	}
 	return ""
 }
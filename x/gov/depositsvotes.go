@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -14,11 +15,23 @@ type Vote struct {
 	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
 	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
 	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+
+	Options WeightedVoteOptions `json:"options,omitempty"` //  weighted options of a split vote, set instead of Option
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option &&
+		voteA.Options.Equals(voteB.Options)
+}
+
+// Returns the options of the vote along with their weights. A plain vote
+// has its option weighted by one.
+func (voteA Vote) WeightedOptions() WeightedVoteOptions {
+	if len(voteA.Options) != 0 {
+		return voteA.Options
+	}
+	return WeightedVoteOptions{NewWeightedVoteOption(voteA.Option, sdk.OneDec())}
 }
 
 // Returns whether a vote is empty
@@ -27,6 +40,69 @@ func (voteA Vote) Empty() bool {
 	return voteA.Equals(voteB)
 }
 
+// WeightedVoteOption defines the share of a voter's voting power given to an option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"` //  option from OptionSet
+	Weight sdk.Dec    `json:"weight"` //  share of the voting power given to the option
+}
+
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+func (wo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wo.Option, wo.Weight)
+}
+
+// WeightedVoteOptions splits a voter's voting power across options
+type WeightedVoteOptions []WeightedVoteOption
+
+// Returns whether 2 sets of weighted options are equal
+func (optionsA WeightedVoteOptions) Equals(optionsB WeightedVoteOptions) bool {
+	if len(optionsA) != len(optionsB) {
+		return false
+	}
+	for i, option := range optionsA {
+		if option.Option != optionsB[i].Option || !option.Weight.Equal(optionsB[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+func (optionsA WeightedVoteOptions) String() string {
+	out := make([]string, len(optionsA))
+	for i, option := range optionsA {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Are the weighted options valid, i.e. distinct valid options with positive
+// weights summing up to one
+func validWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !validVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() {
+			return false
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // Deposit
 type Deposit struct {
 	Depositor  sdk.AccAddress `json:"depositor"`   //  Address of the depositor
@@ -9,7 +9,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -204,3 +207,248 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 }
+
+func TestParameterChangeProposalPassed(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{5})
+	staking.EndBlocker(ctx, sk)
+
+	deposit := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}
+
+	// changes to unknown subspaces, keys, badly encoded or invalid values are rejected on submission
+	invalidChanges := [][]params.ParamChange{
+		{params.NewParamChange("unknown", "MaxValidators", "80")},
+		{params.NewParamChange(staking.DefaultParamspace, "unknown", "80")},
+		{params.NewParamChange(staking.DefaultParamspace, "MaxValidators", `"eighty"`)},
+		{params.NewParamChange(staking.DefaultParamspace, "MaxValidators", "0")},
+		{params.NewParamChange(staking.DefaultParamspace, "PowerReduction", `"0"`)},
+	}
+	for i, changes := range invalidChanges {
+		res := govHandler(ctx, NewMsgSubmitParameterChangeProposal("Test", "test", changes, addrs[1], deposit))
+		require.False(t, res.IsOK(), "tc #%d", i)
+		require.Equal(t, CodeInvalidParamChange, res.Code, "tc #%d", i)
+	}
+
+	changes := []params.ParamChange{params.NewParamChange(staking.DefaultParamspace, "MaxValidators", "80")}
+	res := govHandler(ctx, NewMsgSubmitParameterChangeProposal("Test", "test", changes, addrs[1], deposit))
+	require.True(t, res.IsOK())
+	var proposalID uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*ParameterChangeProposal)
+	require.True(t, ok)
+	require.Equal(t, ProposalTypeParameterChange, proposal.GetProposalType())
+	require.Equal(t, changes, proposal.Changes)
+	require.Equal(t, StatusVotingPeriod, proposal.GetStatus())
+
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+
+	// the parameter is only changed once the proposal passes
+	require.Equal(t, stakingTypes.DefaultParams().MaxValidators, sk.MaxValidators(ctx))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, keeper)
+
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	require.Equal(t, uint16(80), sk.MaxValidators(ctx))
+}
+
+func TestSoftwareUpgradeProposalPassed(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{5})
+	staking.EndBlocker(ctx, sk)
+
+	deposit := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}
+	passProposal := func(msg MsgSubmitProposal) uint64 {
+		res := govHandler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+		var proposalID uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+		res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+		require.True(t, res.IsOK(), res.Log)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+		EndBlocker(ctx, keeper)
+		require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+		return proposalID
+	}
+
+	// plans which can't be scheduled are rejected on submission
+	res := govHandler(ctx, NewMsgSubmitSoftwareUpgradeProposal("Test", "test", upgrade.NewPlan("v2", ctx.BlockHeight(), ""), addrs[1], deposit))
+	require.False(t, res.IsOK())
+	require.Equal(t, upgrade.CodeInvalidPlan, res.Code)
+
+	plan := upgrade.NewPlan("v2", 100, "info")
+	proposalID := passProposal(NewMsgSubmitSoftwareUpgradeProposal("Test", "test", plan, addrs[1], deposit))
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*SoftwareUpgradeProposal)
+	require.True(t, ok)
+	require.Equal(t, plan, proposal.Plan)
+
+	scheduled, found := keeper.uk.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, scheduled)
+
+	// a passed cancel proposal clears the scheduled plan
+	passProposal(NewMsgSubmitProposal("Test", "test", ProposalTypeCancelSoftwareUpgrade, addrs[1], deposit))
+	_, found = keeper.uk.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestRejectedProposalDeposits(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{5})
+	staking.EndBlocker(ctx, sk)
+
+	deposit := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}
+	rejectProposal := func() {
+		res := govHandler(ctx, NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[1], deposit))
+		require.True(t, res.IsOK(), res.Log)
+		var proposalID uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+		res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionNo))
+		require.True(t, res.IsOK(), res.Log)
+
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+		EndBlocker(ctx, keeper)
+		require.Equal(t, StatusRejected, keeper.GetProposal(ctx, proposalID).GetStatus())
+	}
+
+	// deposits are burned by default
+	initialCoins := keeper.ck.GetCoins(ctx, addrs[1])
+	rejectProposal()
+	require.Equal(t, initialCoins.Minus(deposit), keeper.ck.GetCoins(ctx, addrs[1]))
+	require.Equal(t, deposit, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr))
+
+	// and refunded if the deposit params say so
+	depositParams := keeper.GetDepositParams(ctx)
+	depositParams.RefundOnReject = true
+	keeper.setDepositParams(ctx, depositParams)
+
+	initialCoins = keeper.ck.GetCoins(ctx, addrs[1])
+	rejectProposal()
+	require.Equal(t, initialCoins, keeper.ck.GetCoins(ctx, addrs[1]))
+	require.Equal(t, deposit, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr))
+}
+
+func TestExpeditedProposalFallback(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, sk)
+
+	// the regular minimum deposit doesn't start the voting period of an expedited proposal
+	msg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[2], sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 40)})
+	msg.Expedited = true
+	res := govHandler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	var proposalID uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	require.Equal(t, StatusDepositPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	res = govHandler(ctx, NewMsgDeposit(addrs[3], proposalID, sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}))
+	require.True(t, res.IsOK(), res.Log)
+	proposal := keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusVotingPeriod, proposal.GetStatus())
+	require.True(t, proposal.GetExpedited())
+	require.Equal(t, ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.GetVotingEndTime())
+
+	// 60% of yes votes isn't enough for the expedited threshold
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK(), res.Log)
+	res = govHandler(ctx, NewMsgVote(addrs[1], proposalID, OptionNo))
+	require.True(t, res.IsOK(), res.Log)
+
+	votingStartTime := proposal.GetVotingStartTime()
+	ctx = ctx.WithBlockTime(votingStartTime.Add(keeper.GetVotingParams(ctx).ExpeditedVotingPeriod))
+	EndBlocker(ctx, keeper)
+
+	proposal = keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusVotingPeriod, proposal.GetStatus())
+	require.False(t, proposal.GetExpedited())
+	require.Equal(t, votingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod), proposal.GetVotingEndTime())
+	_, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+
+	// but passes the regular threshold at the end of the regular voting period
+	ctx = ctx.WithBlockTime(proposal.GetVotingEndTime())
+	EndBlocker(ctx, keeper)
+
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	_, found = keeper.GetVote(ctx, proposalID, addrs[0])
+	require.False(t, found)
+}
+
+func TestCancelProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{5})
+	staking.EndBlocker(ctx, sk)
+
+	deposit := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)}
+	res := govHandler(ctx, NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[1], deposit))
+	require.True(t, res.IsOK(), res.Log)
+	var proposalID uint64
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	require.Equal(t, addrs[1], keeper.GetProposal(ctx, proposalID).GetProposer())
+
+	res = govHandler(ctx, NewMsgDeposit(addrs[2], proposalID, sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 5)}))
+	require.True(t, res.IsOK(), res.Log)
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK(), res.Log)
+
+	// only the proposer can cancel the proposal
+	res = govHandler(ctx, NewMsgCancelProposal(addrs[2], proposalID))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInvalidProposer, res.Code)
+
+	proposerCoins := keeper.ck.GetCoins(ctx, addrs[1])
+	depositorCoins := keeper.ck.GetCoins(ctx, addrs[2])
+	res = govHandler(ctx, NewMsgCancelProposal(addrs[1], proposalID))
+	require.True(t, res.IsOK(), res.Log)
+	require.Nil(t, keeper.GetProposal(ctx, proposalID))
+	_, found := keeper.GetVote(ctx, proposalID, addrs[0])
+	require.False(t, found)
+
+	// half of each deposit is burned by default, truncating the burned amount
+	require.Equal(t, proposerCoins.Plus(sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 5)}), keeper.ck.GetCoins(ctx, addrs[1]))
+	require.Equal(t, depositorCoins.Plus(sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 3)}), keeper.ck.GetCoins(ctx, addrs[2]))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 7)}, keeper.ck.GetCoins(ctx, BurnedDepositCoinsAccAddr))
+	require.True(t, keeper.ck.GetCoins(ctx, DepositedCoinsAccAddr).IsZero())
+
+	// the proposal can't be cancelled twice
+	res = govHandler(ctx, NewMsgCancelProposal(addrs[1], proposalID))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeUnknownProposal, res.Code)
+}
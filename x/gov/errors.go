@@ -21,8 +21,26 @@ const (
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeInvalidParamChange      sdk.CodeType = 12
+	CodeInvalidProposer         sdk.CodeType = 13
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeUnknownProposal, "unknown proposal")
+	sdk.RegisterCode(DefaultCodespace, CodeInactiveProposal, "inactive proposal")
+	sdk.RegisterCode(DefaultCodespace, CodeAlreadyActiveProposal, "proposal already active")
+	sdk.RegisterCode(DefaultCodespace, CodeAlreadyFinishedProposal, "proposal already finished")
+	sdk.RegisterCode(DefaultCodespace, CodeAddressNotStaked, "address not staked")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidTitle, "invalid proposal title")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidDescription, "invalid proposal description")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidProposalType, "invalid proposal type")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidVote, "invalid vote")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidGenesis, "invalid genesis")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidProposalStatus, "invalid proposal status")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidParamChange, "invalid parameter change")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidProposer, "invalid proposer")
+}
+
 //----------------------------------------
 // Error constructors
 
@@ -54,6 +72,14 @@ func ErrInvalidDescription(codespace sdk.CodespaceType, description string) sdk.
 	return sdk.NewError(codespace, CodeInvalidDescription, fmt.Sprintf("Proposal Desciption '%s' is not valid", description))
 }
 
+func ErrTitleTooLong(codespace sdk.CodespaceType, length int, maxLength uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTitle, fmt.Sprintf("Proposal Title is too long: %d bytes, maximum is %d", length, maxLength))
+}
+
+func ErrDescriptionTooLong(codespace sdk.CodespaceType, length int, maxLength uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDescription, fmt.Sprintf("Proposal Description is too long: %d bytes, maximum is %d", length, maxLength))
+}
+
 func ErrInvalidProposalType(codespace sdk.CodespaceType, proposalType ProposalKind) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidProposalType, fmt.Sprintf("Proposal Type '%s' is not valid", proposalType))
 }
@@ -62,6 +88,18 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption))
 }
 
+func ErrInvalidParamChange(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, fmt.Sprintf("Invalid parameter change: %s", msg))
+}
+
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("Address %s is not the proposer of proposal %d", address, proposalID))
+}
+
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted vote options", options))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
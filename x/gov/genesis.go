@@ -17,6 +17,7 @@ type GenesisState struct {
 	DepositParams      DepositParams         `json:"deposit_params"`
 	VotingParams       VotingParams          `json:"voting_params"`
 	TallyParams        TallyParams           `json:"tally_params"`
+	ProposalParams     ProposalParams        `json:"proposal_params"`
 }
 
 // DepositWithMetadata (just for genesis)
@@ -31,12 +32,13 @@ type VoteWithMetadata struct {
 	Vote       Vote   `json:"vote"`
 }
 
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams, pp ProposalParams) GenesisState {
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		DepositParams:      dp,
 		VotingParams:       vp,
 		TallyParams:        tp,
+		ProposalParams:     pp,
 	}
 }
 
@@ -45,17 +47,25 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		StartingProposalID: 1,
 		DepositParams: DepositParams{
-			MinDeposit:       sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)},
-			MaxDepositPeriod: time.Duration(172800) * time.Second,
+			MinDeposit:          sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)},
+			MaxDepositPeriod:    time.Duration(172800) * time.Second,
+			ExpeditedMinDeposit: sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 50)},
+			CancelBurnRate:      sdk.NewDecWithPrec(5, 1),
 		},
 		VotingParams: VotingParams{
-			VotingPeriod: time.Duration(172800) * time.Second,
+			VotingPeriod:          time.Duration(172800) * time.Second,
+			ExpeditedVotingPeriod: time.Duration(86400) * time.Second,
 		},
 		TallyParams: TallyParams{
-			Quorum:            sdk.NewDecWithPrec(334, 3),
-			Threshold:         sdk.NewDecWithPrec(5, 1),
-			Veto:              sdk.NewDecWithPrec(334, 3),
-			GovernancePenalty: sdk.NewDecWithPrec(1, 2),
+			Quorum:             sdk.NewDecWithPrec(334, 3),
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
+			Veto:               sdk.NewDecWithPrec(334, 3),
+			GovernancePenalty:  sdk.NewDecWithPrec(1, 2),
+		},
+		ProposalParams: ProposalParams{
+			MaxTitleLength:       140,
+			MaxDescriptionLength: 5000,
 		},
 	}
 }
@@ -65,7 +75,8 @@ func (data GenesisState) Equal(data2 GenesisState) bool {
 	if data.StartingProposalID != data.StartingProposalID ||
 		!data.DepositParams.Equal(data2.DepositParams) ||
 		data.VotingParams != data2.VotingParams ||
-		data.TallyParams != data2.TallyParams {
+		data.TallyParams != data2.TallyParams ||
+		data.ProposalParams != data2.ProposalParams {
 		return false
 	}
 
@@ -114,22 +125,17 @@ func (data GenesisState) IsEmpty() bool {
 
 // ValidateGenesis TODO https://github.com/cosmos/cosmos-sdk/issues/3007
 func ValidateGenesis(data GenesisState) error {
-	threshold := data.TallyParams.Threshold
-	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
-			threshold.String())
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
 	}
-
-	veto := data.TallyParams.Veto
-	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
-			veto.String())
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
 	}
-
-	govPenalty := data.TallyParams.GovernancePenalty
-	if govPenalty.IsNegative() || govPenalty.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
-			govPenalty.String())
+	if err := validateTallyParams(data.TallyParams); err != nil {
+		return err
+	}
+	if err := validateProposalParams(data.ProposalParams); err != nil {
+		return err
 	}
 
 	if data.DepositParams.MaxDepositPeriod > data.VotingParams.VotingPeriod {
@@ -137,11 +143,6 @@ func ValidateGenesis(data GenesisState) error {
 			data.VotingParams.VotingPeriod, data.DepositParams.MaxDepositPeriod)
 	}
 
-	if !data.DepositParams.MinDeposit.IsValid() {
-		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s",
-			data.DepositParams.MinDeposit.String())
-	}
-
 	return nil
 }
 
@@ -155,6 +156,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 	k.setDepositParams(ctx, data.DepositParams)
 	k.setVotingParams(ctx, data.VotingParams)
 	k.setTallyParams(ctx, data.TallyParams)
+	k.setProposalParams(ctx, data.ProposalParams)
 	for _, deposit := range data.Deposits {
 		k.setDeposit(ctx, deposit.ProposalID, deposit.Deposit.Depositor, deposit.Deposit)
 	}
@@ -178,6 +180,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	proposalParams := k.GetProposalParams(ctx)
 	var deposits []DepositWithMetadata
 	var votes []VoteWithMetadata
 	proposals := k.GetProposalsFiltered(ctx, nil, nil, StatusNil, 0)
@@ -205,5 +208,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ProposalParams:     proposalParams,
 	}
 }
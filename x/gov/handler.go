@@ -17,6 +17,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized gov msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -25,8 +29,32 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	proposalParams := keeper.GetProposalParams(ctx)
+	if uint64(len(msg.Title)) > proposalParams.MaxTitleLength {
+		return ErrTitleTooLong(keeper.codespace, len(msg.Title), proposalParams.MaxTitleLength).Result()
+	}
+	if uint64(len(msg.Description)) > proposalParams.MaxDescriptionLength {
+		return ErrDescriptionTooLong(keeper.codespace, len(msg.Description), proposalParams.MaxDescriptionLength).Result()
+	}
+
+	var proposal Proposal
+	var err sdk.Error
+	switch msg.ProposalType {
+	case ProposalTypeParameterChange:
+		proposal, err = keeper.NewParameterChangeProposal(ctx, msg.Title, msg.Description, msg.Changes)
+	case ProposalTypeSoftwareUpgrade:
+		proposal, err = keeper.NewSoftwareUpgradeProposal(ctx, msg.Title, msg.Description, *msg.Plan)
+	default:
+		proposal = keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	}
+	if err != nil {
+		return err.Result()
+	}
+	proposal.SetProposer(msg.Proposer)
+	proposal.SetExpedited(msg.Expedited)
+	keeper.SetProposal(ctx, proposal)
 	proposalID := proposal.GetProposalID()
+	keeper.AfterProposalSubmission(ctx, proposalID)
 	proposalIDBytes := []byte(fmt.Sprintf("%d", proposalID))
 
 	err, votingStarted := keeper.AddDeposit(ctx, proposalID, msg.Proposer, msg.InitialDeposit)
@@ -87,9 +115,39 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	}
 }
 
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Action, tags.ActionProposalVote,
+			tags.Voter, []byte(msg.Voter.String()),
+			tags.ProposalID, []byte(fmt.Sprintf("%d", msg.ProposalID)),
+		),
+	}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+	err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Action, tags.ActionProposalCancelled,
+			tags.Proposer, []byte(msg.Proposer.String()),
+			tags.ProposalID, []byte(fmt.Sprintf("%d", msg.ProposalID)),
+		),
+	}
+}
+
 // Called every block, process inflation, update validator set
 func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
-	logger := ctx.Logger().With("module", "x/gov")
+	logger := ctx.ModuleLogger("x/gov")
 	resTags := sdk.NewTags()
 
 	inactiveIterator := keeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
@@ -101,6 +159,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		keeper.DeleteProposal(ctx, proposalID)
 		keeper.DeleteDeposits(ctx, proposalID) // delete any associated deposits (burned)
+		keeper.AfterProposalFailed(ctx, proposalID)
 
 		resTags = resTags.AppendTag(tags.ProposalID, []byte(fmt.Sprintf("%d", proposalID)))
 		resTags = resTags.AppendTag(tags.ProposalResult, tags.ActionProposalDropped)
@@ -123,23 +182,59 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(activeIterator.Value(), &proposalID)
 		activeProposal := keeper.GetProposal(ctx, proposalID)
-		passes, tallyResults := tally(ctx, keeper, activeProposal)
+		passes, burnDeposits, tallyResults := tally(ctx, keeper, activeProposal)
+
+		// a failed expedited proposal falls back to a regular proposal, keeping its votes and deposits
+		if !passes && activeProposal.GetExpedited() {
+			keeper.cancelExpedition(ctx, activeProposal)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) didn't pass; voting period extended to %s",
+					activeProposal.GetProposalID(), activeProposal.GetTitle(), activeProposal.GetVotingEndTime(),
+				),
+			)
+
+			resTags = resTags.AppendTag(tags.ProposalID, []byte(fmt.Sprintf("%d", proposalID)))
+			resTags = resTags.AppendTag(tags.ProposalResult, tags.ActionProposalExpeditionCancelled)
+			continue
+		}
 
 		var tagValue []byte
 		if passes {
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
 			activeProposal.SetStatus(StatusPassed)
 			tagValue = tags.ActionProposalPassed
+
+			// only persist the proposal's state changes if all of them could be applied
+			cacheCtx, writeCache := ctx.CacheContext()
+			err := keeper.executeProposal(cacheCtx, activeProposal)
+			if err != nil {
+				logger.Error(fmt.Sprintf("proposal %d failed to execute: %s", proposalID, err.Result().Log))
+			} else {
+				writeCache()
+			}
 		} else {
-			keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
+			if burnDeposits {
+				keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
+			} else {
+				keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
+			}
 			activeProposal.SetStatus(StatusRejected)
 			tagValue = tags.ActionProposalRejected
 		}
 
+		keeper.deleteVotes(ctx, activeProposal.GetProposalID())
 		activeProposal.SetFinalTallyResult(tallyResults)
 		keeper.SetProposal(ctx, activeProposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.GetVotingEndTime(), activeProposal.GetProposalID())
 
+		if passes {
+			keeper.AfterProposalPassed(ctx, proposalID)
+		} else {
+			keeper.AfterProposalFailed(ctx, proposalID)
+		}
+
 		logger.Info(
 			fmt.Sprintf(
 				"proposal %d (%s) tallied; passed: %v",
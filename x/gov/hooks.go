@@ -0,0 +1,37 @@
+//nolint
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Expose the hooks if present
+func (keeper Keeper) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalSubmission(ctx, proposalID)
+	}
+}
+
+func (keeper Keeper) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalDeposit(ctx, proposalID, depositorAddr)
+	}
+}
+
+func (keeper Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalVote(ctx, proposalID, voterAddr)
+	}
+}
+
+func (keeper Keeper) AfterProposalFailed(ctx sdk.Context, proposalID uint64) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalFailed(ctx, proposalID)
+	}
+}
+
+func (keeper Keeper) AfterProposalPassed(ctx sdk.Context, proposalID uint64) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalPassed(ctx, proposalID)
+	}
+}
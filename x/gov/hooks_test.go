@@ -0,0 +1,85 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mockHooks records the proposals passed to each hook
+type mockHooks struct {
+	submitted []uint64
+	deposited []sdk.AccAddress
+	voted     []sdk.AccAddress
+	failed    []uint64
+	passed    []uint64
+}
+
+var _ sdk.GovHooks = &mockHooks{}
+
+// nolint
+func (h *mockHooks) AfterProposalSubmission(_ sdk.Context, proposalID uint64) {
+	h.submitted = append(h.submitted, proposalID)
+}
+func (h *mockHooks) AfterProposalDeposit(_ sdk.Context, _ uint64, depositorAddr sdk.AccAddress) {
+	h.deposited = append(h.deposited, depositorAddr)
+}
+func (h *mockHooks) AfterProposalVote(_ sdk.Context, _ uint64, voterAddr sdk.AccAddress) {
+	h.voted = append(h.voted, voterAddr)
+}
+func (h *mockHooks) AfterProposalFailed(_ sdk.Context, proposalID uint64) {
+	h.failed = append(h.failed, proposalID)
+}
+func (h *mockHooks) AfterProposalPassed(_ sdk.Context, proposalID uint64) {
+	h.passed = append(h.passed, proposalID)
+}
+
+func TestHooks(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+	require.Panics(t, func() { keeper.SetHooks(hooks) })
+	govHandler := NewHandler(keeper)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0])}
+	createValidators(t, staking.NewHandler(sk), ctx, valAddrs, []int64{5})
+	staking.EndBlocker(ctx, sk)
+
+	submit := func(deposit int64) uint64 {
+		msg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[1], sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, deposit)})
+		res := govHandler(ctx, msg)
+		require.True(t, res.IsOK(), res.Log)
+		var proposalID uint64
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+		return proposalID
+	}
+
+	// the first proposal never reaches the minimum deposit
+	droppedID := submit(5)
+	passedID := submit(5)
+	res := govHandler(ctx, NewMsgDeposit(addrs[2], passedID, sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 5)}))
+	require.True(t, res.IsOK(), res.Log)
+	res = govHandler(ctx, NewMsgVote(addrs[0], passedID, OptionYes))
+	require.True(t, res.IsOK(), res.Log)
+
+	require.Equal(t, []uint64{droppedID, passedID}, hooks.submitted)
+	require.Equal(t, []sdk.AccAddress{addrs[1], addrs[1], addrs[2]}, hooks.deposited)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, hooks.voted)
+
+	require.Nil(t, hooks.failed)
+	require.Nil(t, hooks.passed)
+
+	// the deposit and voting periods have the same length
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.GetVotingParams(ctx).VotingPeriod))
+	EndBlocker(ctx, keeper)
+	require.Equal(t, []uint64{droppedID}, hooks.failed)
+	require.Equal(t, []uint64{passedID}, hooks.passed)
+}
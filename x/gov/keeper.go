@@ -1,12 +1,14 @@
 package gov
 
 import (
+	"fmt"
 	"time"
 
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	"github.com/tendermint/tendermint/crypto"
 )
@@ -27,9 +29,10 @@ const (
 
 // Parameter store key
 var (
-	ParamStoreKeyDepositParams = []byte("depositparams")
-	ParamStoreKeyVotingParams  = []byte("votingparams")
-	ParamStoreKeyTallyParams   = []byte("tallyparams")
+	ParamStoreKeyDepositParams  = []byte("depositparams")
+	ParamStoreKeyVotingParams   = []byte("votingparams")
+	ParamStoreKeyTallyParams    = []byte("tallyparams")
+	ParamStoreKeyProposalParams = []byte("proposalparams")
 
 	DepositedCoinsAccAddr     = sdk.AccAddress(crypto.AddressHash([]byte("govDepositedCoins")))
 	BurnedDepositCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("govBurnedDepositCoins")))
@@ -41,7 +44,12 @@ func ParamTypeTable() params.TypeTable {
 		ParamStoreKeyDepositParams, DepositParams{},
 		ParamStoreKeyVotingParams, VotingParams{},
 		ParamStoreKeyTallyParams, TallyParams{},
-	)
+		ParamStoreKeyProposalParams, ProposalParams{},
+	).
+		RegisterValidator(ParamStoreKeyDepositParams, validateDepositParams).
+		RegisterValidator(ParamStoreKeyVotingParams, validateVotingParams).
+		RegisterValidator(ParamStoreKeyTallyParams, validateTallyParams).
+		RegisterValidator(ParamStoreKeyProposalParams, validateProposalParams)
 }
 
 // Governance Keeper
@@ -61,6 +69,9 @@ type Keeper struct {
 	// The reference to the DelegationSet to get information about delegators
 	ds sdk.DelegationSet
 
+	// The reference to the upgrade Keeper to schedule software upgrades
+	uk upgrade.Keeper
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -69,6 +80,9 @@ type Keeper struct {
 
 	// Reserved codespace
 	codespace sdk.CodespaceType
+
+	// Hooks called when proposals change state
+	hooks sdk.GovHooks
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -76,7 +90,7 @@ type Keeper struct {
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace, ck bank.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace, ck bank.Keeper, ds sdk.DelegationSet, uk upgrade.Keeper, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
 		storeKey:     key,
 		paramsKeeper: paramsKeeper,
@@ -84,11 +98,22 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, p
 		ck:           ck,
 		ds:           ds,
 		vs:           ds.GetValidatorSet(),
+		uk:           uk,
 		cdc:          cdc,
 		codespace:    codespace,
+		hooks:        nil,
 	}
 }
 
+// Set the governance hooks
+func (keeper *Keeper) SetHooks(gh sdk.GovHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set governance hooks twice")
+	}
+	keeper.hooks = gh
+	return keeper
+}
+
 // =====================================================
 // Proposals
 
@@ -109,12 +134,124 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 		SubmitTime:       ctx.BlockHeader().Time,
 	}
 
+	keeper.submitProposal(ctx, proposal)
+	return proposal
+}
+
+// Creates a new parameter change proposal. The changes are checked against
+// the registered param subspaces before the proposal is stored.
+func (keeper Keeper) NewParameterChangeProposal(ctx sdk.Context, title string, description string, changes []params.ParamChange) (Proposal, sdk.Error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := keeper.applyParamChanges(cacheCtx, changes); err != nil {
+		return nil, err
+	}
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var proposal Proposal = &ParameterChangeProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeParameterChange,
+			Status:           StatusDepositPeriod,
+			FinalTallyResult: EmptyTallyResult(),
+			TotalDeposit:     sdk.Coins{},
+			SubmitTime:       ctx.BlockHeader().Time,
+		},
+		Changes: changes,
+	}
+
+	keeper.submitProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// Creates a new software upgrade proposal. The plan is checked against the
+// upgrade store before the proposal is stored.
+func (keeper Keeper) NewSoftwareUpgradeProposal(ctx sdk.Context, title string, description string, plan upgrade.Plan) (Proposal, sdk.Error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := keeper.uk.ScheduleUpgrade(cacheCtx, plan); err != nil {
+		return nil, err
+	}
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var proposal Proposal = &SoftwareUpgradeProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeSoftwareUpgrade,
+			Status:           StatusDepositPeriod,
+			FinalTallyResult: EmptyTallyResult(),
+			TotalDeposit:     sdk.Coins{},
+			SubmitTime:       ctx.BlockHeader().Time,
+		},
+		Plan: plan,
+	}
+
+	keeper.submitProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// sets the deposit end time of a new proposal, stores it and inserts it into the inactive queue
+func (keeper Keeper) submitProposal(ctx sdk.Context, proposal Proposal) {
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 	proposal.SetDepositEndTime(proposal.GetSubmitTime().Add(depositPeriod))
 
 	keeper.SetProposal(ctx, proposal)
-	keeper.InsertInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposalID)
-	return proposal
+	keeper.InsertInactiveProposalQueue(ctx, proposal.GetDepositEndTime(), proposal.GetProposalID())
+}
+
+// applies the content of a passed proposal
+func (keeper Keeper) executeProposal(ctx sdk.Context, proposal Proposal) sdk.Error {
+	switch proposal := proposal.(type) {
+	case *ParameterChangeProposal:
+		return keeper.applyParamChanges(ctx, proposal.Changes)
+	case *SoftwareUpgradeProposal:
+		return keeper.uk.ScheduleUpgrade(ctx, proposal.Plan)
+	}
+
+	if proposal.GetProposalType() == ProposalTypeCancelSoftwareUpgrade {
+		keeper.uk.ClearUpgradePlan(ctx)
+	}
+	return nil
+}
+
+// applies a set of parameter changes to their subspaces, stopping at the first failing change
+func (keeper Keeper) applyParamChanges(ctx sdk.Context, changes []params.ParamChange) sdk.Error {
+	for _, change := range changes {
+		err := keeper.paramsKeeper.UpdateParam(ctx, change)
+		if err != nil {
+			return ErrInvalidParamChange(keeper.codespace, fmt.Sprintf("%s: %s", change, err))
+		}
+	}
+	return nil
+}
+
+// Cancels a proposal in its deposit or voting period on behalf of its proposer.
+// The CancelBurnRate portion of each deposit is burned and the rest refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposerAddr sdk.AccAddress) sdk.Error {
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+	if (proposal.GetStatus() != StatusDepositPeriod) && (proposal.GetStatus() != StatusVotingPeriod) {
+		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+	if !proposal.GetProposer().Equals(proposerAddr) {
+		return ErrInvalidProposer(keeper.codespace, proposalID, proposerAddr)
+	}
+
+	keeper.burnAndRefundDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).CancelBurnRate)
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+	keeper.AfterProposalFailed(ctx, proposalID)
+	return nil
 }
 
 // Get Proposal from store by ProposalID
@@ -240,6 +377,9 @@ func (keeper Keeper) peekCurrentProposalID(ctx sdk.Context) (proposalID uint64,
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.SetVotingStartTime(ctx.BlockHeader().Time)
 	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	if proposal.GetExpedited() {
+		votingPeriod = keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	}
 	proposal.SetVotingEndTime(proposal.GetVotingStartTime().Add(votingPeriod))
 	proposal.SetStatus(StatusVotingPeriod)
 	keeper.SetProposal(ctx, proposal)
@@ -248,6 +388,19 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	keeper.InsertActiveProposalQueue(ctx, proposal.GetVotingEndTime(), proposal.GetProposalID())
 }
 
+// turns a failed expedited proposal into a regular one, extending its voting
+// period to the regular length so that it is tallied again under the regular rules
+func (keeper Keeper) cancelExpedition(ctx sdk.Context, proposal Proposal) {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.GetVotingEndTime(), proposal.GetProposalID())
+
+	proposal.SetExpedited(false)
+	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	proposal.SetVotingEndTime(proposal.GetVotingStartTime().Add(votingPeriod))
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.GetVotingEndTime(), proposal.GetProposalID())
+}
+
 // =====================================================
 // Params
 
@@ -275,6 +428,14 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) TallyParams {
 	return tallyParams
 }
 
+// Returns the current ProposalParams from the global param store
+// nolint: errcheck
+func (keeper Keeper) GetProposalParams(ctx sdk.Context) ProposalParams {
+	var proposalParams ProposalParams
+	keeper.paramSpace.Get(ctx, ParamStoreKeyProposalParams, &proposalParams)
+	return proposalParams
+}
+
 // nolint: errcheck
 func (keeper Keeper) setDepositParams(ctx sdk.Context, depositParams DepositParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyDepositParams, &depositParams)
@@ -290,19 +451,16 @@ func (keeper Keeper) setTallyParams(ctx sdk.Context, tallyParams TallyParams) {
 	keeper.paramSpace.Set(ctx, ParamStoreKeyTallyParams, &tallyParams)
 }
 
+// nolint: errcheck
+func (keeper Keeper) setProposalParams(ctx sdk.Context, proposalParams ProposalParams) {
+	keeper.paramSpace.Set(ctx, ParamStoreKeyProposalParams, &proposalParams)
+}
+
 // =====================================================
 // Votes
 
 // Adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
-	proposal := keeper.GetProposal(ctx, proposalID)
-	if proposal == nil {
-		return ErrUnknownProposal(keeper.codespace, proposalID)
-	}
-	if proposal.GetStatus() != StatusVotingPeriod {
-		return ErrInactiveProposal(keeper.codespace, proposalID)
-	}
-
 	if !validVoteOption(option) {
 		return ErrInvalidVote(keeper.codespace, option)
 	}
@@ -312,8 +470,35 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		Voter:      voterAddr,
 		Option:     option,
 	}
-	keeper.setVote(ctx, proposalID, voterAddr, vote)
+	return keeper.addVote(ctx, vote)
+}
+
+// Adds a vote splitting the voter's voting power across several options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
+	if !validWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
+	}
+
+	vote := Vote{
+		ProposalID: proposalID,
+		Voter:      voterAddr,
+		Options:    options,
+	}
+	return keeper.addVote(ctx, vote)
+}
 
+// stores a vote on a proposal in its voting period
+func (keeper Keeper) addVote(ctx sdk.Context, vote Vote) sdk.Error {
+	proposal := keeper.GetProposal(ctx, vote.ProposalID)
+	if proposal == nil {
+		return ErrUnknownProposal(keeper.codespace, vote.ProposalID)
+	}
+	if proposal.GetStatus() != StatusVotingPeriod {
+		return ErrInactiveProposal(keeper.codespace, vote.ProposalID)
+	}
+
+	keeper.setVote(ctx, vote.ProposalID, vote.Voter, vote)
+	keeper.AfterProposalVote(ctx, vote.ProposalID, vote.Voter)
 	return nil
 }
 
@@ -341,9 +526,16 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID uint64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+// Deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyVote(proposalID, voterAddr))
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		store.Delete(votesIterator.Key())
+	}
+
+	votesIterator.Close()
 }
 
 // =====================================================
@@ -394,7 +586,11 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit tipped proposal into voting period
 	// Active voting period if so
 	activatedVotingPeriod := false
-	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+	if proposal.GetExpedited() {
+		minDeposit = keeper.GetDepositParams(ctx).ExpeditedMinDeposit
+	}
+	if proposal.GetStatus() == StatusDepositPeriod && proposal.GetTotalDeposit().IsAllGTE(minDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -409,6 +605,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		keeper.setDeposit(ctx, proposalID, depositorAddr, currDeposit)
 	}
 
+	keeper.AfterProposalDeposit(ctx, proposalID, depositorAddr)
 	return nil, activatedVotingPeriod
 }
 
@@ -458,6 +655,35 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	depositsIterator.Close()
 }
 
+// Burns the given portion of each deposit on a specific proposal, refunds the
+// rest to the depositors and deletes the deposits
+func (keeper Keeper) burnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRate sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+	depositsIterator := keeper.GetDeposits(ctx, proposalID)
+
+	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(depositsIterator.Value(), deposit)
+
+		truncated, _ := sdk.NewDecCoins(deposit.Amount).MulDec(burnRate).TruncateDecimal()
+		burned := sdk.Coins{}.Plus(truncated)
+		refunded := deposit.Amount.Minus(burned)
+
+		_, err := keeper.ck.SendCoins(ctx, DepositedCoinsAccAddr, BurnedDepositCoinsAccAddr, burned)
+		if err != nil {
+			panic("should not happen")
+		}
+		_, err = keeper.ck.SendCoins(ctx, DepositedCoinsAccAddr, deposit.Depositor, refunded)
+		if err != nil {
+			panic("should not happen")
+		}
+
+		store.Delete(depositsIterator.Key())
+	}
+
+	depositsIterator.Close()
+}
+
 // =====================================================
 // ProposalQueues
 
@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -228,3 +229,24 @@ func TestProposalQueues(t *testing.T) {
 	require.Equal(t, proposalID, proposal.GetProposalID())
 	activeIterator.Close()
 }
+
+func TestProposalLengthLimits(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+
+	keeper.setProposalParams(ctx, ProposalParams{MaxTitleLength: 10, MaxDescriptionLength: 20})
+	deposit := sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 5)}
+
+	res := govHandler(ctx, NewMsgSubmitProposal(strings.Repeat("T", 10), strings.Repeat("d", 20), ProposalTypeText, addrs[0], deposit))
+	require.True(t, res.IsOK(), res.Log)
+
+	res = govHandler(ctx, NewMsgSubmitProposal(strings.Repeat("T", 11), "description", ProposalTypeText, addrs[0], deposit))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInvalidTitle, res.Code)
+
+	res = govHandler(ctx, NewMsgSubmitProposal("Test", strings.Repeat("d", 21), ProposalTypeText, addrs[0], deposit))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInvalidDescription, res.Code)
+}
@@ -0,0 +1,12 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGenesisMigration registers the migration of the gov genesis state of
+// the previous release, which lacks the expedited proposal, deposit burning,
+// cancellation and proposal params, set to their default values.
+func RegisterGenesisMigration(m *sdk.GenesisMigrator) {
+	m.RegisterMigration(ModuleName, sdk.DefaultsGenesisMigration(DefaultGenesisState()))
+}
@@ -0,0 +1,62 @@
+package gov
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleName is the name of the gov module
+const ModuleName = "gov"
+
+var _ sdk.AppModule = AppModule{}
+
+// AppModule implements the sdk.AppModule interface for the gov module
+type AppModule struct {
+	cdc    *codec.Codec
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object, using the application codec
+// for the genesis state
+func NewAppModule(cdc *codec.Codec, keeper Keeper) AppModule {
+	return AppModule{
+		cdc:    cdc,
+		keeper: keeper,
+	}
+}
+
+// Name returns the name of the module
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterInvariants is a no-op, the module having no invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// InitGenesis initializes the gov state from its genesis state
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	am.cdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return nil
+}
+
+// ExportGenesis exports the gov state as a genesis state
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState := ExportGenesis(ctx, am.keeper)
+	return am.cdc.MustMarshalJSON(genesisState)
+}
+
+// BeginBlock is a no-op for the gov module
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// EndBlock tallies the proposals whose voting period ended
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return nil, EndBlocker(ctx, am.keeper)
+}
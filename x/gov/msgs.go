@@ -4,16 +4,27 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 // Governance message types and routes
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+// Upper bounds of the proposal content lengths, independent of the ProposalParams
+// of the chain which can only lower them
+const (
+	MaxTitleLength       = 500
+	MaxDescriptionLength = 50000
+)
+
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
 
 //-----------------------------------------------------------
 // MsgSubmitProposal
@@ -23,6 +34,11 @@ type MsgSubmitProposal struct {
 	ProposalType   ProposalKind   `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive.
+
+	Changes []params.ParamChange `json:"changes,omitempty"` //  Parameter changes of a ParameterChange proposal
+	Plan    *upgrade.Plan        `json:"plan,omitempty"`    //  Upgrade plan of a SoftwareUpgrade proposal
+
+	Expedited bool `json:"expedited,omitempty"` //  Submit the proposal with the expedited deposit, voting period and threshold
 }
 
 func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
@@ -35,6 +51,32 @@ func NewMsgSubmitProposal(title string, description string, proposalType Proposa
 	}
 }
 
+// NewMsgSubmitParameterChangeProposal creates a message submitting a proposal
+// which applies the given parameter changes once it passes
+func NewMsgSubmitParameterChangeProposal(title string, description string, changes []params.ParamChange, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   ProposalTypeParameterChange,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+		Changes:        changes,
+	}
+}
+
+// NewMsgSubmitSoftwareUpgradeProposal creates a message submitting a proposal
+// which schedules the given upgrade plan once it passes
+func NewMsgSubmitSoftwareUpgradeProposal(title string, description string, plan upgrade.Plan, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   ProposalTypeSoftwareUpgrade,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+		Plan:           &plan,
+	}
+}
+
 //nolint
 func (msg MsgSubmitProposal) Route() string { return RouterKey }
 func (msg MsgSubmitProposal) Type() string  { return TypeMsgSubmitProposal }
@@ -44,21 +86,51 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if len(msg.Title) == 0 {
 		return ErrInvalidTitle(DefaultCodespace, msg.Title) // TODO: Proper Error
 	}
+	if len(msg.Title) > MaxTitleLength {
+		return ErrTitleTooLong(DefaultCodespace, len(msg.Title), MaxTitleLength)
+	}
 	if len(msg.Description) == 0 {
 		return ErrInvalidDescription(DefaultCodespace, msg.Description) // TODO: Proper Error
 	}
+	if len(msg.Description) > MaxDescriptionLength {
+		return ErrDescriptionTooLong(DefaultCodespace, len(msg.Description), MaxDescriptionLength)
+	}
 	if !validProposalType(msg.ProposalType) {
 		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
 	}
 	if len(msg.Proposer) == 0 {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
-	if !msg.InitialDeposit.IsValid() {
-		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
+	if err := msg.InitialDeposit.Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
 	}
 	if !msg.InitialDeposit.IsNotNegative() {
 		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
 	}
+	if len(msg.Changes) != 0 && msg.ProposalType != ProposalTypeParameterChange {
+		return ErrInvalidParamChange(DefaultCodespace, fmt.Sprintf("changes given for a %s proposal", msg.ProposalType))
+	}
+	// the content of the proposal must match its type, so that it is not
+	// submitted as a text proposal
+	if len(msg.Changes) == 0 && msg.ProposalType == ProposalTypeParameterChange {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	if msg.Plan == nil && msg.ProposalType == ProposalTypeSoftwareUpgrade {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	for _, change := range msg.Changes {
+		if err := change.ValidateBasic(); err != nil {
+			return ErrInvalidParamChange(DefaultCodespace, err.Error())
+		}
+	}
+	if msg.Plan != nil {
+		if msg.ProposalType != ProposalTypeSoftwareUpgrade {
+			return upgrade.ErrInvalidPlan(upgrade.DefaultCodespace, fmt.Sprintf("plan given for a %s proposal", msg.ProposalType))
+		}
+		if err := msg.Plan.ValidateBasic(); err != nil {
+			return upgrade.ErrInvalidPlan(upgrade.DefaultCodespace, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -111,8 +183,8 @@ func (msg MsgDeposit) ValidateBasic() sdk.Error {
 	if len(msg.Depositor) == 0 {
 		return sdk.ErrInvalidAddress(msg.Depositor.String())
 	}
-	if !msg.Amount.IsValid() {
-		return sdk.ErrInvalidCoins(msg.Amount.String())
+	if err := msg.Amount.Validate(); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
 	}
 	if !msg.Amount.IsNotNegative() {
 		return sdk.ErrInvalidCoins(msg.Amount.String())
@@ -203,3 +275,98 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+//-----------------------------------------------------------
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  options from OptionSet with the share of voting power given to each
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if len(msg.Voter.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !validWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf("MsgVoteWeighted{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+//-----------------------------------------------------------
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    // Address of the proposer
+}
+
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{
+		ProposalID: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+func (msg MsgCancelProposal) Type() string  { return TypeMsgCancelProposal }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if len(msg.Proposer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf("MsgCancelProposal{%s=>%v}", msg.Proposer, msg.ProposalID)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
@@ -1,13 +1,16 @@
 package gov
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 var (
@@ -34,12 +37,15 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, true},
+		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", 0x05, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
+		{strings.Repeat("T", MaxTitleLength), "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
+		{strings.Repeat("T", MaxTitleLength+1), "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
+		{"Test Proposal", strings.Repeat("d", MaxDescriptionLength+1), ProposalTypeText, addrs[0], coinsPos, false},
 	}
 
 	for i, tc := range tests {
@@ -52,6 +58,56 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for parameter change proposals
+func TestMsgSubmitParameterChangeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	change := params.NewParamChange("staking", "MaxValidators", "80")
+
+	msg := NewMsgSubmitParameterChangeProposal("Test Proposal", "the purpose of this proposal is to test", []params.ParamChange{change}, addrs[0], coinsPos)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ProposalType = ProposalTypeText
+	require.Error(t, msg.ValidateBasic())
+
+	// a parameter change proposal without changes is rejected
+	msg = NewMsgSubmitParameterChangeProposal("Test Proposal", "the purpose of this proposal is to test", nil, addrs[0], coinsPos)
+	err := msg.ValidateBasic()
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidProposalType, err.Code())
+
+	for _, invalid := range []params.ParamChange{
+		params.NewParamChange("", "MaxValidators", "80"),
+		params.NewParamChange("staking", "", "80"),
+		params.NewParamChange("staking", "MaxValidators", ""),
+	} {
+		msg = NewMsgSubmitParameterChangeProposal("Test Proposal", "the purpose of this proposal is to test", []params.ParamChange{change, invalid}, addrs[0], coinsPos)
+		require.Error(t, msg.ValidateBasic(), "change: %v", invalid)
+	}
+}
+
+// test ValidateBasic for software upgrade proposals
+func TestMsgSubmitSoftwareUpgradeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	msg := NewMsgSubmitSoftwareUpgradeProposal("Test Proposal", "the purpose of this proposal is to test", upgrade.NewPlan("v2", 100, ""), addrs[0], coinsPos)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ProposalType = ProposalTypeText
+	require.Error(t, msg.ValidateBasic())
+
+	// a software upgrade proposal without plan is rejected
+	msg = NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos)
+	err := msg.ValidateBasic()
+	require.Error(t, err)
+	require.Equal(t, CodeInvalidProposalType, err.Code())
+
+	msg = NewMsgSubmitSoftwareUpgradeProposal("Test Proposal", "the purpose of this proposal is to test", upgrade.NewPlan("", 100, ""), addrs[0], coinsPos)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitSoftwareUpgradeProposal("Test Proposal", "the purpose of this proposal is to test", upgrade.NewPlan("v2", 0, ""), addrs[0], coinsPos)
+	require.Error(t, msg.ValidateBasic())
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
@@ -103,3 +159,54 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, true},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{sdk.AccAddress{}, WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec())}, false},
+		{addrs[0], WeightedVoteOptions{}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionEmpty, sdk.OneDec())}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, 0, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{0, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
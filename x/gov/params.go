@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,22 +11,128 @@ import (
 type DepositParams struct {
 	MinDeposit       sdk.Coins     `json:"min_deposit"`        //  Minimum deposit for a proposal to enter voting period.
 	MaxDepositPeriod time.Duration `json:"max_deposit_period"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+
+	ExpeditedMinDeposit sdk.Coins `json:"expedited_min_deposit"` //  Minimum deposit for an expedited proposal to enter voting period.
+
+	RefundOnReject   bool `json:"refund_on_reject"`    //  Refund the deposits of a proposal rejected by the voters, otherwise burn them
+	RefundOnVeto     bool `json:"refund_on_veto"`      //  Refund the deposits of a vetoed proposal, otherwise burn them
+	RefundOnNoQuorum bool `json:"refund_on_no_quorum"` //  Refund the deposits of a proposal which didn't reach quorum, otherwise burn them
+
+	CancelBurnRate sdk.Dec `json:"cancel_burn_rate"` //  Portion of the deposits burned when the proposer cancels a proposal, the rest is refunded
 }
 
 // Checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) &&
+		dp.RefundOnReject == dp2.RefundOnReject && dp.RefundOnVeto == dp2.RefundOnVeto && dp.RefundOnNoQuorum == dp2.RefundOnNoQuorum &&
+		dp.CancelBurnRate.Equal(dp2.CancelBurnRate)
 }
 
 // Param around Tallying votes in governance
 type TallyParams struct {
-	Quorum            sdk.Dec `json:"quorum"`             //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold         sdk.Dec `json:"threshold"`          //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto              sdk.Dec `json:"veto"`               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
-	GovernancePenalty sdk.Dec `json:"governance_penalty"` //  Penalty if validator does not vote
+	Quorum             sdk.Dec `json:"quorum"`              //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold"`           //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold"` //  Minimum propotion of Yes votes for an expedited proposal to pass. Initial value: 0.667
+	Veto               sdk.Dec `json:"veto"`                //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	GovernancePenalty  sdk.Dec `json:"governance_penalty"`  //  Penalty if validator does not vote
+}
+
+// Param around the content of proposals in governance
+type ProposalParams struct {
+	MaxTitleLength       uint64 `json:"max_title_length"`       //  Maximum length of a proposal title, at most MaxTitleLength. Initial value: 140
+	MaxDescriptionLength uint64 `json:"max_description_length"` //  Maximum length of a proposal description, at most MaxDescriptionLength. Initial value: 5000
 }
 
 // Param around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period"`           //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period"` //  Length of the voting period of an expedited proposal.
+}
+
+func validateDepositParams(i interface{}) error {
+	depositParams := i.(DepositParams)
+
+	cancelBurnRate := depositParams.CancelBurnRate
+	if cancelBurnRate.IsNegative() || cancelBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance cancel burn rate should be positive and less or equal to one, is %s",
+			cancelBurnRate.String())
+	}
+
+	if err := depositParams.MinDeposit.Validate(); err != nil {
+		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s: %v",
+			depositParams.MinDeposit.String(), err)
+	}
+
+	if !depositParams.ExpeditedMinDeposit.IsValid() || !depositParams.ExpeditedMinDeposit.IsAllGTE(depositParams.MinDeposit) {
+		return fmt.Errorf("Governance expedited deposit amount must be a valid sdk.Coins amount greater or equal to the deposit amount (%s), is %s",
+			depositParams.MinDeposit.String(), depositParams.ExpeditedMinDeposit.String())
+	}
+
+	return nil
+}
+
+func validateVotingParams(i interface{}) error {
+	votingParams := i.(VotingParams)
+
+	if votingParams.ExpeditedVotingPeriod >= votingParams.VotingPeriod {
+		return fmt.Errorf("Governance expedited voting period should be less than the voting period (%ds), is %ds",
+			votingParams.VotingPeriod, votingParams.ExpeditedVotingPeriod)
+	}
+
+	return nil
+}
+
+func validateTallyParams(i interface{}) error {
+	tallyParams := i.(TallyParams)
+
+	quorum := tallyParams.Quorum
+	if quorum.IsNegative() || quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote quorum should be positive and less or equal to one, is %s",
+			quorum.String())
+	}
+
+	threshold := tallyParams.Threshold
+	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
+			threshold.String())
+	}
+
+	expeditedThreshold := tallyParams.ExpeditedThreshold
+	if expeditedThreshold.LT(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance expedited vote threshold should be greater or equal to the vote threshold (%s) and less or equal to one, is %s",
+			threshold.String(), expeditedThreshold.String())
+	}
+
+	veto := tallyParams.Veto
+	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
+			veto.String())
+	}
+
+	govPenalty := tallyParams.GovernancePenalty
+	if govPenalty.IsNegative() || govPenalty.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance penalty should be positive and less or equal to one, is %s",
+			govPenalty.String())
+	}
+
+	return nil
+}
+
+func validateProposalParams(i interface{}) error {
+	proposalParams := i.(ProposalParams)
+
+	maxTitleLength := proposalParams.MaxTitleLength
+	if maxTitleLength == 0 || maxTitleLength > MaxTitleLength {
+		return fmt.Errorf("Governance maximum title length should be positive and less or equal to %d, is %d",
+			MaxTitleLength, maxTitleLength)
+	}
+
+	maxDescriptionLength := proposalParams.MaxDescriptionLength
+	if maxDescriptionLength == 0 || maxDescriptionLength > MaxDescriptionLength {
+		return fmt.Errorf("Governance maximum description length should be positive and less or equal to %d, is %d",
+			MaxDescriptionLength, maxDescriptionLength)
+	}
+
+	return nil
 }
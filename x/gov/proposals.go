@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 //-----------------------------------------------------------
@@ -25,6 +27,12 @@ type Proposal interface {
 	GetProposalType() ProposalKind
 	SetProposalType(ProposalKind)
 
+	GetExpedited() bool
+	SetExpedited(bool)
+
+	GetProposer() sdk.AccAddress
+	SetProposer(sdk.AccAddress)
+
 	GetStatus() ProposalStatus
 	SetStatus(ProposalStatus)
 
@@ -53,6 +61,8 @@ func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
 		proposalA.GetTitle() == proposalB.GetTitle() &&
 		proposalA.GetDescription() == proposalB.GetDescription() &&
 		proposalA.GetProposalType() == proposalB.GetProposalType() &&
+		proposalA.GetExpedited() == proposalB.GetExpedited() &&
+		proposalA.GetProposer().Equals(proposalB.GetProposer()) &&
 		proposalA.GetStatus() == proposalB.GetStatus() &&
 		proposalA.GetFinalTallyResult().Equals(proposalB.GetFinalTallyResult()) &&
 		proposalA.GetSubmitTime().Equal(proposalB.GetSubmitTime()) &&
@@ -68,10 +78,12 @@ func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
 //-----------------------------------------------------------
 // Text Proposals
 type TextProposal struct {
-	ProposalID   uint64       `json:"proposal_id"`   //  ID of the proposal
-	Title        string       `json:"title"`         //  Title of the proposal
-	Description  string       `json:"description"`   //  Description of the proposal
-	ProposalType ProposalKind `json:"proposal_type"` //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	ProposalID   uint64         `json:"proposal_id"`   //  ID of the proposal
+	Title        string         `json:"title"`         //  Title of the proposal
+	Description  string         `json:"description"`   //  Description of the proposal
+	ProposalType ProposalKind   `json:"proposal_type"` //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	Expedited    bool           `json:"expedited"`     //  Whether the proposal uses the expedited deposit, voting period and threshold
+	Proposer     sdk.AccAddress `json:"proposer"`      //  Address of the proposer, the only one allowed to cancel the proposal
 
 	Status           ProposalStatus `json:"proposal_status"`    //  Status of the Proposal {Pending, Active, Passed, Rejected}
 	FinalTallyResult TallyResult    `json:"final_tally_result"` //  Result of Tallys
@@ -96,6 +108,10 @@ func (tp TextProposal) GetDescription() string                     { return tp.D
 func (tp *TextProposal) SetDescription(description string)         { tp.Description = description }
 func (tp TextProposal) GetProposalType() ProposalKind              { return tp.ProposalType }
 func (tp *TextProposal) SetProposalType(proposalType ProposalKind) { tp.ProposalType = proposalType }
+func (tp TextProposal) GetExpedited() bool                         { return tp.Expedited }
+func (tp *TextProposal) SetExpedited(expedited bool)               { tp.Expedited = expedited }
+func (tp TextProposal) GetProposer() sdk.AccAddress                { return tp.Proposer }
+func (tp *TextProposal) SetProposer(proposer sdk.AccAddress)       { tp.Proposer = proposer }
 func (tp TextProposal) GetStatus() ProposalStatus                  { return tp.Status }
 func (tp *TextProposal) SetStatus(status ProposalStatus)           { tp.Status = status }
 func (tp TextProposal) GetFinalTallyResult() TallyResult           { return tp.FinalTallyResult }
@@ -119,6 +135,28 @@ func (tp *TextProposal) SetVotingEndTime(votingEndTime time.Time) {
 	tp.VotingEndTime = votingEndTime
 }
 
+//-----------------------------------------------------------
+// Parameter Change Proposals
+type ParameterChangeProposal struct {
+	TextProposal `json:"text_proposal"`
+
+	Changes []params.ParamChange `json:"changes"` // Parameter changes applied when the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*ParameterChangeProposal)(nil)
+
+//-----------------------------------------------------------
+// Software Upgrade Proposals
+type SoftwareUpgradeProposal struct {
+	TextProposal `json:"text_proposal"`
+
+	Plan upgrade.Plan `json:"plan"` // Upgrade plan scheduled when the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*SoftwareUpgradeProposal)(nil)
+
 //-----------------------------------------------------------
 // ProposalQueue
 type ProposalQueue []uint64
@@ -131,10 +169,11 @@ type ProposalKind byte
 
 //nolint
 const (
-	ProposalTypeNil             ProposalKind = 0x00
-	ProposalTypeText            ProposalKind = 0x01
-	ProposalTypeParameterChange ProposalKind = 0x02
-	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+	ProposalTypeNil                   ProposalKind = 0x00
+	ProposalTypeText                  ProposalKind = 0x01
+	ProposalTypeParameterChange       ProposalKind = 0x02
+	ProposalTypeSoftwareUpgrade       ProposalKind = 0x03
+	ProposalTypeCancelSoftwareUpgrade ProposalKind = 0x04
 )
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -146,6 +185,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeParameterChange, nil
 	case "SoftwareUpgrade":
 		return ProposalTypeSoftwareUpgrade, nil
+	case "CancelSoftwareUpgrade":
+		return ProposalTypeCancelSoftwareUpgrade, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -155,7 +196,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 func validProposalType(pt ProposalKind) bool {
 	if pt == ProposalTypeText ||
 		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
+		pt == ProposalTypeSoftwareUpgrade ||
+		pt == ProposalTypeCancelSoftwareUpgrade {
 		return true
 	}
 	return false
@@ -202,6 +244,8 @@ func (pt ProposalKind) String() string {
 		return "ParameterChange"
 	case ProposalTypeSoftwareUpgrade:
 		return "SoftwareUpgrade"
+	case ProposalTypeCancelSoftwareUpgrade:
+		return "CancelSoftwareUpgrade"
 	default:
 		return ""
 	}
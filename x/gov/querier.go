@@ -19,10 +19,12 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+	QueryLiveTally = "live_tally"
 
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+	ParamProposal = "proposal"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -44,6 +46,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryLiveTally:
+			return queryLiveTally(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -70,6 +74,12 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case ParamProposal:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetProposalParams(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -217,7 +227,7 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		tallyResult = proposal.GetFinalTallyResult()
 	} else {
 		// proposal is in voting period
-		_, tallyResult = tally(ctx, keeper, proposal)
+		_, _, tallyResult = tally(ctx, keeper, proposal)
 	}
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, tallyResult)
@@ -227,6 +237,31 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func queryLiveTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposalID := params.ProposalID
+
+	proposal := keeper.GetProposal(ctx, proposalID)
+	if proposal == nil {
+		return nil, ErrUnknownProposal(DefaultCodespace, proposalID)
+	}
+	if proposal.GetStatus() != StatusVotingPeriod {
+		return nil, ErrInactiveProposal(DefaultCodespace, proposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, liveTally(ctx, keeper, proposal))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params QueryProposalParams
@@ -252,10 +287,14 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 }
 
 // Params for query 'custom/gov/proposals'
+// Matching proposals are paginated from the latest one: the first page holds
+// the Limit latest matching proposals. A zero page defaults to the first one
+// and a zero limit returns all the matching proposals.
 type QueryProposalsParams struct {
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
 	ProposalStatus ProposalStatus
+	Page           uint64
 	Limit          uint64
 }
 
@@ -277,7 +316,8 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
 	}
 
-	proposals := keeper.GetProposalsFiltered(ctx, params.Voter, params.Depositor, params.ProposalStatus, params.Limit)
+	proposals := keeper.GetProposalsFiltered(ctx, params.Voter, params.Depositor, params.ProposalStatus, 0)
+	proposals = proposalsPage(proposals, params.Page, params.Limit)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
 	if err != nil {
@@ -285,3 +325,27 @@ func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+// returns the requested page of proposals, counting pages from the latest
+// proposals and keeping the proposals of a page in ascending order
+func proposalsPage(proposals []Proposal, page, limit uint64) []Proposal {
+	if limit == 0 {
+		return proposals
+	}
+	if page == 0 {
+		page = 1
+	}
+
+	numProposals := uint64(len(proposals))
+	skipped := (page - 1) * limit
+	if skipped >= numProposals {
+		return []Proposal{}
+	}
+
+	end := numProposals - skipped
+	start := uint64(0)
+	if end > limit {
+		start = end - limit
+	}
+	return proposals[start:end]
+}
@@ -9,11 +9,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 const custom = "custom"
 
-func getQueriedParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (DepositParams, VotingParams, TallyParams) {
+func getQueriedParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (DepositParams, VotingParams, TallyParams, ProposalParams) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, QuerierRoute, QueryParams, ParamDeposit}, "/"),
 		Data: []byte{},
@@ -53,7 +54,20 @@ func getQueriedParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier s
 	err2 = cdc.UnmarshalJSON(bz, &tallyParams)
 	require.Nil(t, err2)
 
-	return depositParams, votingParams, tallyParams
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryParams, ParamProposal}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err = querier(ctx, []string{QueryParams, ParamProposal}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var proposalParams ProposalParams
+	err2 = cdc.UnmarshalJSON(bz, &proposalParams)
+	require.Nil(t, err2)
+
+	return depositParams, votingParams, tallyParams, proposalParams
 }
 
 func getQueriedProposal(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, proposalID uint64) Proposal {
@@ -184,7 +198,7 @@ func testQueries(t *testing.T) {
 	handler := NewHandler(keeper)
 	ctx := mapp.NewContext(false, abci.Header{})
 
-	depositParams, _, _ := getQueriedParams(t, ctx, cdc, querier)
+	depositParams, _, _, _ := getQueriedParams(t, ctx, cdc, querier)
 
 	// addrs[0] proposes (and deposits) proposals #1 and #2
 	res := handler(ctx, NewMsgSubmitProposal("title", "description", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("dummycoin", 1)}))
@@ -288,3 +302,110 @@ func testQueries(t *testing.T) {
 	tally := getQueriedTally(t, ctx, cdc, querier, proposalID2)
 	require.True(t, !tally.Equals(EmptyTallyResult()))
 }
+
+func TestQueryProposalsPagination(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 1, GenesisState{}, nil)
+	querier := NewQuerier(keeper)
+
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// proposals #2 and #4 are in their voting period
+	var proposalIDs []uint64
+	for i := 0; i < 5; i++ {
+		proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+		if i%2 == 1 {
+			proposal.SetStatus(StatusVotingPeriod)
+			keeper.SetProposal(ctx, proposal)
+		}
+		proposalIDs = append(proposalIDs, proposal.GetProposalID())
+	}
+
+	queryProposalsPage := func(status ProposalStatus, page, limit uint64) []uint64 {
+		params := NewQueryProposalsParams(status, limit, nil, nil)
+		params.Page = page
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, QuerierRoute, QueryProposals}, "/"),
+			Data: keeper.cdc.MustMarshalJSON(params),
+		}
+
+		bz, err := querier(ctx, []string{QueryProposals}, query)
+		require.Nil(t, err)
+
+		var proposals []Proposal
+		require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &proposals))
+
+		ids := []uint64{}
+		for _, proposal := range proposals {
+			ids = append(ids, proposal.GetProposalID())
+		}
+		return ids
+	}
+
+	require.Equal(t, proposalIDs, queryProposalsPage(StatusNil, 0, 0))
+	require.Equal(t, proposalIDs[3:], queryProposalsPage(StatusNil, 0, 2))
+	require.Equal(t, proposalIDs[1:3], queryProposalsPage(StatusNil, 2, 2))
+	require.Equal(t, proposalIDs[:1], queryProposalsPage(StatusNil, 3, 2))
+	require.Equal(t, []uint64{}, queryProposalsPage(StatusNil, 4, 2))
+
+	require.Equal(t, []uint64{proposalIDs[3]}, queryProposalsPage(StatusVotingPeriod, 1, 1))
+	require.Equal(t, []uint64{proposalIDs[1]}, queryProposalsPage(StatusVotingPeriod, 2, 1))
+	require.Equal(t, []uint64{proposalIDs[0], proposalIDs[2], proposalIDs[4]}, queryProposalsPage(StatusDepositPeriod, 1, 5))
+}
+
+func TestQueryLiveTally(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10, GenesisState{}, nil)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	querier := NewQuerier(keeper)
+	stakingHandler := staking.NewHandler(sk)
+
+	valAddrs := make([]sdk.ValAddress, len(addrs[:2]))
+	for i, addr := range addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, sk)
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+
+	// proposals in deposit period have no live tally
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryLiveTally}, "/"),
+		Data: keeper.cdc.MustMarshalJSON(NewQueryProposalParams(proposalID)),
+	}
+	_, err := querier(ctx, []string{QueryLiveTally}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInactiveProposal, err.Code())
+
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	err = keeper.AddVote(ctx, proposalID, addrs[0], OptionYes)
+	require.Nil(t, err)
+
+	bz, err := querier(ctx, []string{QueryLiveTally}, query)
+	require.Nil(t, err)
+
+	var liveTally LiveTally
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &liveTally))
+	require.Equal(t, sdk.NewDec(6), liveTally.TallyResult.Yes)
+	require.Equal(t, sdk.NewDec(6), liveTally.VotingPower)
+	require.Equal(t, sdk.NewDec(10), liveTally.BondedPower)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), liveTally.Turnout)
+	require.Equal(t, sdk.OneDec(), liveTally.YesRatio)
+	require.Equal(t, keeper.GetTallyParams(ctx).Threshold, liveTally.Threshold)
+	require.True(t, liveTally.Passes)
+
+	err = keeper.AddVote(ctx, proposalID, addrs[1], OptionNo)
+	require.Nil(t, err)
+
+	bz, err = querier(ctx, []string{QueryLiveTally}, query)
+	require.Nil(t, err)
+	require.Nil(t, keeper.cdc.UnmarshalJSON(bz, &liveTally))
+	require.Equal(t, sdk.OneDec(), liveTally.Turnout)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), liveTally.YesRatio)
+	require.True(t, liveTally.Passes)
+}
@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RandomizedGenState generates a random genesis state of the gov module
+func RandomizedGenState(simState *simulation.GenesisState) {
+	r := simState.Rand
+
+	govGenesis := randomizedGenesis(r)
+	fmt.Printf("Selected randomly generated governance parameters:\n\t%+v\n", govGenesis)
+
+	simState.SetGenesis(gov.ModuleName, govGenesis)
+}
+
+func randomizedGenesis(r *rand.Rand) gov.GenesisState {
+	vp := time.Duration(r.Intn(2*172800)) * time.Second
+	minDeposit := int64(r.Intn(1e3))
+	return gov.GenesisState{
+		StartingProposalID: uint64(r.Intn(100)),
+		DepositParams: gov.DepositParams{
+			MinDeposit:          sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, minDeposit)},
+			MaxDepositPeriod:    vp,
+			ExpeditedMinDeposit: sdk.Coins{sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, minDeposit*int64(r.Intn(5)+1))},
+			RefundOnReject:      r.Intn(2) == 0,
+			RefundOnVeto:        r.Intn(2) == 0,
+			RefundOnNoQuorum:    r.Intn(2) == 0,
+			CancelBurnRate:      sdk.NewDecWithPrec(int64(r.Intn(101)), 2),
+		},
+		VotingParams: gov.VotingParams{
+			VotingPeriod:          vp,
+			ExpeditedVotingPeriod: vp / 2,
+		},
+		TallyParams: gov.TallyParams{
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
+			Veto:               sdk.NewDecWithPrec(334, 3),
+			GovernancePenalty:  sdk.NewDecWithPrec(1, 2),
+		},
+		ProposalParams: gov.ProposalParams{
+			MaxTitleLength:       uint64(simulation.RandIntBetween(r, 140, gov.MaxTitleLength)),
+			MaxDescriptionLength: uint64(simulation.RandIntBetween(r, 5000, gov.MaxDescriptionLength)),
+		},
+	}
+}
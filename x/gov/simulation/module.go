@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// gov module
+type AppModule struct {
+	keeper        gov.Keeper
+	stakingKeeper staking.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper gov.Keeper, stakingKeeper staking.Keeper) AppModule {
+	return AppModule{
+		keeper:        keeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return gov.ModuleName
+}
+
+// randomized genesis state
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 5, Op: SimulateSubmittingVotingAndSlashingForProposal(am.keeper, am.stakingKeeper)},
+		{Weight: 100, Op: SimulateMsgDeposit(am.keeper)},
+	}
+}
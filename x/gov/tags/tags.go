@@ -6,12 +6,14 @@ import (
 
 // Governance tags
 var (
-	ActionProposalDropped   = []byte("proposal-dropped")
-	ActionProposalPassed    = []byte("proposal-passed")
-	ActionProposalRejected  = []byte("proposal-rejected")
-	ActionProposalSubmitted = []byte("proposal-submitted")
-	ActionProposalVote      = []byte("proposal-vote")
-	ActionProposalDeposit   = []byte("proposal-deposit")
+	ActionProposalDropped             = []byte("proposal-dropped")
+	ActionProposalPassed              = []byte("proposal-passed")
+	ActionProposalRejected            = []byte("proposal-rejected")
+	ActionProposalExpeditionCancelled = []byte("proposal-expedition-cancelled")
+	ActionProposalSubmitted           = []byte("proposal-submitted")
+	ActionProposalVote                = []byte("proposal-vote")
+	ActionProposalDeposit             = []byte("proposal-deposit")
+	ActionProposalCancelled           = []byte("proposal-cancelled")
 
 	Action            = sdk.TagAction
 	Proposer          = "proposer"
@@ -6,21 +6,122 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address         sdk.ValAddress // address of the validator operator
-	Power           sdk.Dec        // Power of a Validator
-	DelegatorShares sdk.Dec        // Total outstanding delegator shares
-	Minus           sdk.Dec        // Minus of validator, used to compute validator's voting power
-	Vote            VoteOption     // Vote of the validator
+	Address         sdk.ValAddress      // address of the validator operator
+	Power           sdk.Dec             // Power of a Validator
+	DelegatorShares sdk.Dec             // Total outstanding delegator shares
+	Minus           sdk.Dec             // Minus of validator, used to compute validator's voting power
+	Vote            WeightedVoteOptions // Vote of the validator
 }
 
-func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tallyResults TallyResult) {
-	results := make(map[VoteOption]sdk.Dec)
+// LiveTally is the partial tally of a proposal in its voting period, along
+// with its progress towards the quorum and pass threshold
+type LiveTally struct {
+	TallyResult TallyResult `json:"tally_result"` //  Voting power given to each option so far
+	VotingPower sdk.Dec     `json:"voting_power"` //  Voting power of the votes cast so far
+	BondedPower sdk.Dec     `json:"bonded_power"` //  Total voting power of the bonded validators
+	Turnout     sdk.Dec     `json:"turnout"`      //  Share of the bonded voting power which voted
+	Quorum      sdk.Dec     `json:"quorum"`       //  Turnout needed for the vote to be valid
+	YesRatio    sdk.Dec     `json:"yes_ratio"`    //  Share of yes votes among the non-abstaining votes
+	Threshold   sdk.Dec     `json:"threshold"`    //  Yes ratio needed for the proposal to pass
+	Passes      bool        `json:"passes"`       //  Whether the proposal would pass if its voting period ended now
+}
+
+// tally the votes of a proposal, returning whether it passes and, if it
+// doesn't, whether its deposits should be burned according to the reason it failed
+func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results, totalVotingPower := tallyVotes(ctx, keeper, proposal)
+	return tallyOutcome(ctx, keeper, proposal, results, totalVotingPower)
+}
+
+// decides the outcome of a proposal from the voting power given to each option
+func tallyOutcome(ctx sdk.Context, keeper Keeper, proposal Proposal, results map[VoteOption]sdk.Dec,
+	totalVotingPower sdk.Dec) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	depositParams := keeper.GetDepositParams(ctx)
+
+	tallyResults = TallyResult{
+		Yes:        results[OptionYes],
+		Abstain:    results[OptionAbstain],
+		No:         results[OptionNo],
+		NoWithVeto: results[OptionNoWithVeto],
+	}
+
+	// If there is no staked coins, the proposal fails
+	if keeper.vs.TotalPower(ctx).IsZero() {
+		return false, !depositParams.RefundOnNoQuorum, tallyResults
+	}
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.vs.TotalPower(ctx)))
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, !depositParams.RefundOnNoQuorum, tallyResults
+	}
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, !depositParams.RefundOnReject, tallyResults
+	}
+	// If more than 1/3 of voters veto, proposal fails
+	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, !depositParams.RefundOnVeto, tallyResults
+	}
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	// Expedited proposals need the higher expedited threshold instead
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(passThreshold(tallyParams, proposal)) {
+		return true, false, tallyResults
+	}
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+
+	return false, !depositParams.RefundOnReject, tallyResults
+}
+
+// tally the current votes of a proposal in its voting period
+func liveTally(ctx sdk.Context, keeper Keeper, proposal Proposal) LiveTally {
+	results, totalVotingPower := tallyVotes(ctx, keeper, proposal)
+	passes, _, tallyResults := tallyOutcome(ctx, keeper, proposal, results, totalVotingPower)
+	tallyParams := keeper.GetTallyParams(ctx)
+
+	bondedPower := sdk.NewDecFromInt(keeper.vs.TotalPower(ctx))
+	turnout := sdk.ZeroDec()
+	if bondedPower.IsPositive() {
+		turnout = totalVotingPower.Quo(bondedPower)
+	}
+
+	yesRatio := sdk.ZeroDec()
+	nonAbstaining := totalVotingPower.Sub(results[OptionAbstain])
+	if nonAbstaining.IsPositive() {
+		yesRatio = results[OptionYes].Quo(nonAbstaining)
+	}
+
+	return LiveTally{
+		TallyResult: tallyResults,
+		VotingPower: totalVotingPower,
+		BondedPower: bondedPower,
+		Turnout:     turnout,
+		Quorum:      tallyParams.Quorum,
+		YesRatio:    yesRatio,
+		Threshold:   passThreshold(tallyParams, proposal),
+		Passes:      passes,
+	}
+}
+
+// returns the yes ratio a proposal needs to pass
+func passThreshold(tallyParams TallyParams, proposal Proposal) sdk.Dec {
+	if proposal.GetExpedited() {
+		return tallyParams.ExpeditedThreshold
+	}
+	return tallyParams.Threshold
+}
+
+// sums up the voting power given to each option on a proposal, along with the
+// total voting power of the votes
+func tallyVotes(ctx sdk.Context, keeper Keeper, proposal Proposal) (results map[VoteOption]sdk.Dec, totalVotingPower sdk.Dec) {
+	results = make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
 	results[OptionNo] = sdk.ZeroDec()
 	results[OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
 
 	keeper.vs.IterateBondedValidatorsByPower(ctx, func(index int64, validator sdk.Validator) (stop bool) {
@@ -29,7 +130,6 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 			Power:           sdk.NewDecFromInt(validator.GetPower()),
 			DelegatorShares: validator.GetDelegatorShares(),
 			Minus:           sdk.ZeroDec(),
-			Vote:            OptionEmpty,
 		}
 		return false
 	})
@@ -43,9 +143,9 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 
 		// if validator, just record it in the map
 		// if delegator tally voting power
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
+		valAddrStr := sdk.ValAddressFromAccAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.WeightedOptions()
 			currValidators[valAddrStr] = val
 		} else {
 
@@ -59,20 +159,21 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 					votingPower := val.Power.Mul(delegatorShare)
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
+					for _, option := range vote.WeightedOptions() {
+						subPower := votingPower.Mul(option.Weight)
+						results[option.Option] = results[option.Option].Add(subPower)
+					}
 					totalVotingPower = totalVotingPower.Add(votingPower)
 				}
 
 				return false
 			})
 		}
-
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 	}
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -80,41 +181,12 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 		percentAfterMinus := sharesAfterMinus.Quo(val.DelegatorShares)
 		votingPower := val.Power.Mul(percentAfterMinus)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
-
-	tallyResults = TallyResult{
-		Yes:        results[OptionYes],
-		Abstain:    results[OptionAbstain],
-		No:         results[OptionNo],
-		NoWithVeto: results[OptionNoWithVeto],
-	}
-
-	// If there is no staked coins, the proposal fails
-	if keeper.vs.TotalPower(ctx).IsZero() {
-		return false, tallyResults
-	}
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.vs.TotalPower(ctx)))
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, tallyResults
-	}
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, tallyResults
-	}
-	// If more than 1/3 of voters veto, proposal fails
-	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
-		return false, tallyResults
-	}
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, tallyResults
-	}
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-
-	return false, tallyResults
+	return results, totalVotingPower
 }
@@ -14,6 +14,12 @@ const (
 	CodeUnknownRequest  sdk.CodeType = sdk.CodeUnknownRequest
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidSequence, codeToDefaultMsg(CodeInvalidSequence))
+	sdk.RegisterCode(DefaultCodespace, CodeIdenticalChains, codeToDefaultMsg(CodeIdenticalChains))
+	sdk.RegisterCode(DefaultCodespace, CodeUnknownRequest, codeToDefaultMsg(CodeUnknownRequest))
+}
+
 func codeToDefaultMsg(code sdk.CodeType) string {
 	switch code {
 	case CodeInvalidSequence:
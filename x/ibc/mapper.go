@@ -101,6 +101,23 @@ func (ibcm Mapper) SetIngressSequence(ctx sdk.Context, srcChain string, sequence
 	store.Set(key, bz)
 }
 
+// GetEgressLength returns the number of outgoing IBC packets posted to the
+// given chain.
+func (ibcm Mapper) GetEgressLength(ctx sdk.Context, destChain string) uint64 {
+	return ibcm.getEgressLength(ctx.KVStore(ibcm.key), destChain)
+}
+
+// GetEgressPacket returns the outgoing IBC packet posted to the given chain at
+// the given index.
+func (ibcm Mapper) GetEgressPacket(ctx sdk.Context, destChain string, index uint64) (packet IBCPacket, found bool) {
+	bz := ctx.KVStore(ibcm.key).Get(EgressKey(destChain, index))
+	if bz == nil {
+		return packet, false
+	}
+	unmarshalBinaryPanic(ibcm.cdc, bz, &packet)
+	return packet, true
+}
+
 // Retrieves the index of the currently stored outgoing IBC packets.
 func (ibcm Mapper) getEgressLength(store sdk.KVStore, destChain string) uint64 {
 	bz := store.Get(EgressLengthKey(destChain))
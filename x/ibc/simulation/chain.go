@@ -0,0 +1,194 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// Chain is a simulated chain running the ibc module on a mock app. Its ID
+// names it in the IBC packets; the transactions are signed for the chain ID of
+// the mock app.
+type Chain struct {
+	ID     string
+	App    *mock.App
+	Mapper ibc.Mapper
+
+	header abci.Header
+}
+
+// NewChain creates a chain whose accounts are the given ones, funded at
+// genesis with random coins of the given sorted denominations.
+func NewChain(r *rand.Rand, id string, accs []simulation.Account, denoms []string) *Chain {
+	mapp := mock.NewApp()
+	ibc.RegisterCodec(mapp.Cdc)
+	keyIBC := sdk.NewKVStoreKey("ibc")
+	mapper := ibc.NewMapper(mapp.Cdc, keyIBC, ibc.DefaultCodespace)
+	mapp.Router().AddRoute("ibc", ibc.NewHandler(mapper, bank.NewBaseKeeper(mapp.AccountKeeper)))
+	if err := mapp.CompleteSetup(keyIBC); err != nil {
+		panic(err)
+	}
+
+	simulation.RandomSetGenesis(r, mapp, accs, denoms)
+	mock.SetGenesis(mapp, mapp.GenesisAccounts)
+
+	return &Chain{
+		ID:     id,
+		App:    mapp,
+		Mapper: mapper,
+		header: abci.Header{Height: mapp.LastBlockHeight() + 1, Time: time.Unix(0, 0)},
+	}
+}
+
+func (c *Chain) beginBlock() {
+	c.App.BeginBlock(abci.RequestBeginBlock{Header: c.header})
+}
+
+// endBlock ends and commits the current block
+func (c *Chain) endBlock() {
+	c.App.EndBlock(abci.RequestEndBlock{})
+	c.App.Commit()
+	c.header.Height++
+	c.header.Time = c.header.Time.Add(5 * time.Second)
+}
+
+// deliverCtx returns a context on the state of the current block
+func (c *Chain) deliverCtx() sdk.Context {
+	return c.App.NewContext(false, c.header)
+}
+
+// queryCtx returns a context on the last committed state, as queried by the
+// relayers
+func (c *Chain) queryCtx() sdk.Context {
+	return c.App.NewContext(true, abci.Header{Height: c.App.LastBlockHeight()})
+}
+
+// deliver signs a transaction of the message by the given account and
+// delivers it in the current block
+func (c *Chain) deliver(msg sdk.Msg, signer simulation.Account) sdk.Result {
+	acc := c.App.AccountKeeper.GetAccount(c.deliverCtx(), signer.Address)
+	if acc == nil {
+		return sdk.ErrUnknownAddress(signer.Address.String()).Result()
+	}
+	tx := mock.GenTx([]sdk.Msg{msg}, []uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()}, []crypto.PrivKey{signer.PrivKey}...)
+	return c.App.Deliver(tx)
+}
+
+// totalCoins returns the coins of all the accounts of the last committed state
+func (c *Chain) totalCoins() sdk.Coins {
+	var total sdk.Coins
+	for _, acc := range mock.GetAllAccounts(c.App.AccountKeeper, c.queryCtx()) {
+		total = total.Plus(acc.GetCoins())
+	}
+	return total
+}
+
+// Relayer relays in memory the packets posted on a source chain to a
+// destination chain, in the order of their sequence. It reads the committed
+// state of the source chain and signs the receive messages with its account,
+// which must exist on the destination chain.
+type Relayer struct {
+	Src     *Chain
+	Dest    *Chain
+	Account simulation.Account
+
+	// sequence of the next packet to relay
+	sequence uint64
+}
+
+// NewRelayer creates a relayer from the source to the destination chain.
+func NewRelayer(src, dest *Chain, acc simulation.Account) *Relayer {
+	return &Relayer{Src: src, Dest: dest, Account: acc}
+}
+
+// Sequence returns the sequence of the next packet to relay.
+func (rl *Relayer) Sequence() uint64 {
+	return rl.sequence
+}
+
+// Pending returns the number of committed packets not relayed yet.
+func (rl *Relayer) Pending() uint64 {
+	return rl.Src.Mapper.GetEgressLength(rl.Src.queryCtx(), rl.Dest.ID) - rl.sequence
+}
+
+// Relay relays at most n pending packets, failing if one is rejected by the
+// destination chain.
+func (rl *Relayer) Relay(n uint64) (relayed uint64, err error) {
+	ctx := rl.Src.queryCtx()
+	length := rl.Src.Mapper.GetEgressLength(ctx, rl.Dest.ID)
+	for ; relayed < n && rl.sequence < length; relayed++ {
+		packet, found := rl.Src.Mapper.GetEgressPacket(ctx, rl.Dest.ID, rl.sequence)
+		if !found {
+			return relayed, fmt.Errorf("packet %d from %s to %s not found", rl.sequence, rl.Src.ID, rl.Dest.ID)
+		}
+		res := rl.receive(packet, rl.sequence)
+		if !res.IsOK() {
+			return relayed, fmt.Errorf("packet %d from %s to %s rejected: %s",
+				rl.sequence, rl.Src.ID, rl.Dest.ID, res.Log)
+		}
+		rl.sequence++
+	}
+	return relayed, nil
+}
+
+// RelayOutOfOrder posts a packet with a sequence other than the next one,
+// either the last relayed packet again or a packet past the next pending one,
+// failing if the destination chain does not reject it. It returns false if
+// there is no such packet.
+func (rl *Relayer) RelayOutOfOrder(r *rand.Rand) (posted bool, err error) {
+	ctx := rl.Src.queryCtx()
+	length := rl.Src.Mapper.GetEgressLength(ctx, rl.Dest.ID)
+
+	var sequence uint64
+	switch {
+	case rl.sequence > 0 && (r.Intn(2) == 0 || rl.sequence+1 >= length):
+		sequence = rl.sequence - 1
+	case rl.sequence+1 < length:
+		sequence = rl.sequence + 1 + uint64(r.Int63n(int64(length-rl.sequence-1)))
+	default:
+		return false, nil
+	}
+
+	packet, found := rl.Src.Mapper.GetEgressPacket(ctx, rl.Dest.ID, sequence)
+	if !found {
+		return true, fmt.Errorf("packet %d from %s to %s not found", sequence, rl.Src.ID, rl.Dest.ID)
+	}
+	res := rl.receive(packet, sequence)
+	if res.Codespace != ibc.DefaultCodespace || res.Code != ibc.CodeInvalidSequence {
+		return true, fmt.Errorf("packet %d from %s to %s relayed out of order, expected %d: %v",
+			sequence, rl.Src.ID, rl.Dest.ID, rl.sequence, res)
+	}
+	return true, nil
+}
+
+func (rl *Relayer) receive(packet ibc.IBCPacket, sequence uint64) sdk.Result {
+	msg := ibc.IBCReceiveMsg{
+		IBCPacket: packet,
+		Relayer:   rl.Account.Address,
+		Sequence:  sequence,
+	}
+	return rl.Dest.deliver(msg, rl.Account)
+}
+
+// inFlight returns the coins of the committed packets not relayed yet
+func (rl *Relayer) inFlight() sdk.Coins {
+	ctx := rl.Src.queryCtx()
+	length := rl.Src.Mapper.GetEgressLength(ctx, rl.Dest.ID)
+
+	var coins sdk.Coins
+	for seq := rl.sequence; seq < length; seq++ {
+		packet, _ := rl.Src.Mapper.GetEgressPacket(ctx, rl.Dest.ID, seq)
+		coins = coins.Plus(packet.Coins)
+	}
+	return coins
+}
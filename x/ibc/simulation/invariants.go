@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueueInvariant checks that the egress queue of the source chain of the
+// relayer holds a packet, from the source to the destination chain, at every
+// index below its length and none past it.
+func QueueInvariant(rl *Relayer) error {
+	ctx := rl.Src.queryCtx()
+	length := rl.Src.Mapper.GetEgressLength(ctx, rl.Dest.ID)
+	for index := uint64(0); index < length; index++ {
+		packet, found := rl.Src.Mapper.GetEgressPacket(ctx, rl.Dest.ID, index)
+		if !found {
+			return fmt.Errorf("no packet %d in the egress queue of %s to %s of length %d",
+				index, rl.Src.ID, rl.Dest.ID, length)
+		}
+		if packet.SrcChain != rl.Src.ID || packet.DestChain != rl.Dest.ID {
+			return fmt.Errorf("packet %d in the egress queue of %s to %s is from %s to %s",
+				index, rl.Src.ID, rl.Dest.ID, packet.SrcChain, packet.DestChain)
+		}
+	}
+	if _, found := rl.Src.Mapper.GetEgressPacket(ctx, rl.Dest.ID, length); found {
+		return fmt.Errorf("packet past the length %d of the egress queue of %s to %s",
+			length, rl.Src.ID, rl.Dest.ID)
+	}
+	return nil
+}
+
+// SequenceInvariant checks that the ingress sequence of the destination chain
+// of the relayer is the number of packets it relayed, at most the length of
+// the egress queue of the source chain.
+func SequenceInvariant(rl *Relayer) error {
+	length := rl.Src.Mapper.GetEgressLength(rl.Src.queryCtx(), rl.Dest.ID)
+	sequence := rl.Dest.Mapper.GetIngressSequence(rl.Dest.queryCtx(), rl.Src.ID)
+	if sequence != rl.Sequence() {
+		return fmt.Errorf("ingress sequence of %s from %s is %d, %d packets relayed",
+			rl.Dest.ID, rl.Src.ID, sequence, rl.Sequence())
+	}
+	if sequence > length {
+		return fmt.Errorf("ingress sequence of %s from %s is %d, past the egress queue length %d",
+			rl.Dest.ID, rl.Src.ID, sequence, length)
+	}
+	return nil
+}
+
+// SupplyInvariant checks that the coins of the accounts of the chains, each
+// the source of one of the relayers, with the coins of the packets in flight,
+// sum to the given supply.
+func SupplyInvariant(relayers []*Relayer, supply sdk.Coins) error {
+	var total sdk.Coins
+	for _, rl := range relayers {
+		total = total.Plus(rl.Src.totalCoins()).Plus(rl.inFlight())
+	}
+	if !total.IsEqual(supply) {
+		return fmt.Errorf("total coins of the chains and in flight %v, expected %v", total, supply)
+	}
+	return nil
+}
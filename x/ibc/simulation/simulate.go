@@ -0,0 +1,122 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+// chain IDs and coin denominations of the simulated chains
+const (
+	chainA = "chain-a"
+	chainB = "chain-b"
+)
+
+var denoms = []string{"atom", "photon"}
+
+// SimulateFromSeed runs two chains, each on its own mock app, exchanging random
+// IBC transfers for numBlocks blocks of at most blockSize transfers per chain.
+// An in-memory relayer in each direction relays a random number of the packets
+// committed on its source chain at every block, and posts packets out of order
+// which must be rejected. The queue, sequence and supply invariants are checked
+// after every block.
+//
+// The ibc module has no connection handshake nor packet timeouts yet: the
+// harness exercises its egress queues and ingress sequences.
+func SimulateFromSeed(seed int64, numBlocks, blockSize int) error {
+	r := rand.New(rand.NewSource(seed))
+	accs := simulation.RandomAccounts(r, simulation.RandIntBetween(r, 2, 20))
+
+	a := NewChain(r, chainA, accs, denoms)
+	b := NewChain(r, chainB, accs, denoms)
+	relayers := []*Relayer{
+		NewRelayer(a, b, simulation.RandomAcc(r, accs)),
+		NewRelayer(b, a, simulation.RandomAcc(r, accs)),
+	}
+	supply := a.totalCoins().Plus(b.totalCoins())
+
+	var transfers, relayed, rejected int
+	for height := 1; height <= numBlocks; height++ {
+		a.beginBlock()
+		b.beginBlock()
+
+		for _, rl := range relayers {
+			n, err := rl.Relay(uint64(r.Intn(blockSize + 1)))
+			relayed += int(n)
+			if err != nil {
+				return fmt.Errorf("block %d: %v", height, err)
+			}
+			if r.Intn(4) == 0 {
+				posted, err := rl.RelayOutOfOrder(r)
+				if err != nil {
+					return fmt.Errorf("block %d: %v", height, err)
+				}
+				if posted {
+					rejected++
+				}
+			}
+		}
+
+		for _, rl := range relayers {
+			for i := r.Intn(blockSize + 1); i > 0; i-- {
+				ok, err := transfer(r, rl.Src, rl.Dest, accs)
+				if err != nil {
+					return fmt.Errorf("block %d: %v", height, err)
+				}
+				if ok {
+					transfers++
+				}
+			}
+		}
+
+		a.endBlock()
+		b.endBlock()
+
+		for _, rl := range relayers {
+			if err := QueueInvariant(rl); err != nil {
+				return fmt.Errorf("block %d: %v", height, err)
+			}
+			if err := SequenceInvariant(rl); err != nil {
+				return fmt.Errorf("block %d: %v", height, err)
+			}
+		}
+		if err := SupplyInvariant(relayers, supply); err != nil {
+			return fmt.Errorf("block %d: %v", height, err)
+		}
+	}
+
+	fmt.Printf("IBC simulation complete: %d blocks, %d transfers, %d packets relayed, "+
+		"%d out of order packets rejected\n", numBlocks, transfers, relayed, rejected)
+	return nil
+}
+
+// transfer delivers on the source chain a transfer of random coins of a
+// random account to a random account of the destination chain. It returns
+// false if the account has no coins to transfer.
+func transfer(r *rand.Rand, src, dest *Chain, accs []simulation.Account) (ok bool, err error) {
+	from := simulation.RandomAcc(r, accs)
+	to := simulation.RandomAcc(r, accs)
+
+	coins := src.App.AccountKeeper.GetAccount(src.deliverCtx(), from.Address).GetCoins()
+	if len(coins) == 0 {
+		return false, nil
+	}
+	coin := coins[r.Intn(len(coins))]
+	amount := sdk.NewIntFromBigInt(new(big.Int).Rand(r, coin.Amount.BigInt()))
+	if amount.IsZero() {
+		return false, nil
+	}
+
+	packet := ibc.NewIBCPacket(from.Address, to.Address,
+		sdk.Coins{sdk.NewCoin(coin.Denom, amount)}, src.ID, dest.ID)
+	res := src.deliver(ibc.IBCTransferMsg{IBCPacket: packet}, from)
+	if !res.IsOK() {
+		return false, fmt.Errorf("transfer of %v from %s to %s rejected: %s",
+			packet.Coins, src.ID, dest.ID, res.Log)
+	}
+	return true, nil
+}
@@ -0,0 +1,13 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateFromSeed(t *testing.T) {
+	for _, seed := range []int64{1, 7, 42} {
+		require.NoError(t, SimulateFromSeed(seed, 20, 10), "seed %d", seed)
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/mint/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group minting queries under a subcommand
+	mintingQueryCmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Querying commands for the minting module",
+	}
+
+	mintingQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQueryParams(mc.cdc),
+			cli.GetCmdQueryInflation(mc.cdc),
+			cli.GetCmdQueryAnnualProvisions(mc.cdc),
+		)...,
+	)
+
+	return mintingQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	return &cobra.Command{Hidden: true}
+}
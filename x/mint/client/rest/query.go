@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+)
+
+// RegisterRoutes registers minting-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/minting/parameters",
+		queryHandlerFn(cdc, cliCtx, mint.QueryParameters),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/minting/inflation",
+		queryHandlerFn(cdc, cliCtx, mint.QueryInflation),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/minting/annual_provisions",
+		queryHandlerFn(cdc, cliCtx, mint.QueryAnnualProvisions),
+	).Methods("GET")
+}
+
+// http request handler to query one of the minting endpoints
+func queryHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", mint.QuerierRoute, endpoint)
+
+		res, height, err := cliCtx.QueryWithHeight(route, nil)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
+	}
+}
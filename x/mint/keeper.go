@@ -42,7 +42,9 @@ var (
 func ParamTypeTable() params.TypeTable {
 	return params.NewTypeTable(
 		ParamStoreKeyParams, Params{},
-	)
+	).RegisterValidator(ParamStoreKeyParams, func(value interface{}) error {
+		return validateParams(value.(Params))
+	})
 }
 
 const (
@@ -51,6 +53,9 @@ const (
 
 	// StoreKey is the default store key for mint
 	StoreKey = "mint"
+
+	// QuerierRoute is the querier route for the minting store.
+	QuerierRoute = StoreKey
 )
 
 //______________________________________________________________________
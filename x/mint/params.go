@@ -50,9 +50,18 @@ func validateParams(params Params) error {
 	if params.GoalBonded.GT(sdk.OneDec()) {
 		return fmt.Errorf("mint parameter GoalBonded must be <= 1, is %s", params.GoalBonded.String())
 	}
+	if params.InflationMin.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("mint parameter InflationMin should be positive, is %s", params.InflationMin.String())
+	}
 	if params.InflationMax.LT(params.InflationMin) {
 		return fmt.Errorf("mint parameter Max inflation must be greater than or equal to min inflation")
 	}
+	if params.InflationRateChange.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("mint parameter InflationRateChange should be positive, is %s", params.InflationRateChange.String())
+	}
+	if params.BlocksPerYear == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive")
+	}
 	if params.MintDenom == "" {
 		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
 	}
@@ -0,0 +1,34 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	params := DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(30, 2)
+	params.BlocksPerYear = 100
+	require.NoError(t, keeper.paramSpace.Update(ctx, ParamStoreKeyParams, keeper.cdc.MustMarshalJSON(params)))
+	require.Equal(t, params, keeper.GetParams(ctx))
+
+	// the minimum inflation must not exceed the maximum one
+	invalid := params
+	invalid.InflationMin = sdk.NewDecWithPrec(40, 2)
+	require.Error(t, keeper.paramSpace.Update(ctx, ParamStoreKeyParams, keeper.cdc.MustMarshalJSON(invalid)))
+
+	invalid = params
+	invalid.BlocksPerYear = 0
+	require.Error(t, keeper.paramSpace.Update(ctx, ParamStoreKeyParams, keeper.cdc.MustMarshalJSON(invalid)))
+
+	invalid = params
+	invalid.GoalBonded = sdk.NewDec(2)
+	require.Error(t, keeper.paramSpace.Update(ctx, ParamStoreKeyParams, keeper.cdc.MustMarshalJSON(invalid)))
+
+	require.Equal(t, params, keeper.GetParams(ctx))
+}
@@ -0,0 +1,64 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyMint := sdk.NewKVStoreKey(StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	keeper := NewKeeper(cdc, keyMint, paramsKeeper.Subspace(DefaultParamspace), nil, nil)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+	return ctx, keeper
+}
+
+func TestQueries(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	querier := NewQuerier(keeper)
+
+	keeper.SetMinter(ctx, NewMinter(sdk.NewDecWithPrec(15, 2), sdk.NewDec(1000)))
+
+	res, err := querier(ctx, []string{QueryParameters}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var params Params
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &params))
+	require.Equal(t, keeper.GetParams(ctx), params)
+
+	res, err = querier(ctx, []string{QueryInflation}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var inflation sdk.Dec
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &inflation))
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), inflation)
+
+	res, err = querier(ctx, []string{QueryAnnualProvisions}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var annualProvisions sdk.Dec
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &annualProvisions))
+	require.Equal(t, sdk.NewDec(1000), annualProvisions)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RandomizedParams returns random parameters of the mint module
+func RandomizedParams(r *rand.Rand) mint.Params {
+	return mint.NewParams(
+		stakingTypes.DefaultBondDenom,
+		sdk.NewDecWithPrec(int64(r.Intn(99)), 2),
+		sdk.NewDecWithPrec(20, 2),
+		sdk.NewDecWithPrec(7, 2),
+		sdk.NewDecWithPrec(67, 2),
+		uint64(60*60*8766/5))
+}
+
+// RandomizedGenState generates a random genesis state of the mint module
+func RandomizedGenState(simState *simulation.GenesisState) {
+	r := simState.Rand
+
+	mintGenesis := mint.GenesisState{
+		Minter: mint.InitialMinter(sdk.NewDecWithPrec(int64(r.Intn(99)), 2)),
+		Params: RandomizedParams(r),
+	}
+	fmt.Printf("Selected randomly generated minting parameters:\n\t%+v\n", mintGenesis)
+
+	simState.SetGenesis(mint.ModuleName, mintGenesis)
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// mint module
+type AppModule struct{}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule() AppModule {
+	return AppModule{}
+}
+
+// module name
+func (AppModule) Name() string {
+	return mint.ModuleName
+}
+
+// randomized genesis state
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations, none the module having no messages
+func (AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return nil
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -104,3 +105,50 @@ func TestCheckGenTx(t *testing.T) {
 		false, privKeys[0],
 	)
 }
+
+// testModule is a module recording the calls of its lifecycle methods.
+type testModule struct {
+	genesis   *json.RawMessage
+	endBlocks *int
+}
+
+func (testModule) Name() string                           { return "test" }
+func (testModule) RegisterInvariants(sdk.InvariantRouter) {}
+func (m testModule) InitGenesis(_ sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	*m.genesis = data
+	return nil
+}
+func (testModule) ExportGenesis(sdk.Context) json.RawMessage { return nil }
+func (testModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+func (m testModule) EndBlock(sdk.Context, abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	*m.endBlocks++
+	return nil, sdk.EmptyTags()
+}
+
+func TestNewTestApp(t *testing.T) {
+	module := testModule{new(json.RawMessage), new(int)}
+	tApp := NewTestApp(t, NewApp(), TestAppConfig{
+		Modules:      []sdk.AppModule{module},
+		Genesis:      map[string]json.RawMessage{"test": json.RawMessage(`{"a":1}`)},
+		NumAccounts:  3,
+		AccountCoins: genCoins,
+	})
+
+	// the module is initialized with its genesis state
+	require.Equal(t, json.RawMessage(`{"a":1}`), *module.genesis)
+
+	// the generated accounts are funded in the state of the context
+	require.Len(t, tApp.Accounts, 3)
+	for _, acc := range tApp.Accounts {
+		require.Equal(t, genCoins, tApp.AccountKeeper.GetAccount(tApp.Ctx, acc.Address).GetCoins())
+	}
+
+	// the module ends the blocks of the app
+	header := abci.Header{Height: tApp.LastBlockHeight() + 1}
+	tApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	tApp.EndBlock(abci.RequestEndBlock{})
+	tApp.Commit()
+	require.Equal(t, 1, *module.endBlocks)
+}
@@ -21,5 +21,10 @@ Then to perform a randomized simulation, select the set of desired operations,
 the weightings for each, the invariants you want to test, and how long to run
 it for. Then run simulation.Simulate! The simulator will handle things like
 ensuring that validators periodically double signing, or go offline.
+
+To reproduce a simulation exactly, run it with SimulateAndExport: its seed,
+randomized params and operation schedule are exported to a JSON Record, even
+when it fails. ReplaySimulation runs the simulation of such a record, and fails
+as soon as the operations selected diverge from the recorded schedule.
 */
 package simulation
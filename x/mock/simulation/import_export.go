@@ -0,0 +1,87 @@
+package simulation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StorePair pairs a substore of a simulated application with the same
+// substore of the application imported from its exported genesis state.
+type StorePair struct {
+	A sdk.StoreKey
+	B sdk.StoreKey
+
+	// prefixes of the keys whose values are not compared, e.g. queues whose
+	// ordering may change on import
+	SkipPrefixes [][]byte
+}
+
+// ExportImportFn exports the genesis state of an application at the given
+// context and imports it into a fresh application. It returns a context on the
+// state of the fresh application and the pairs of substores to compare.
+type ExportImportFn func(ctx sdk.Context) (newCtx sdk.Context, stores []StorePair, err error)
+
+// ImportExportInvariant returns an invariant exporting the genesis state of the
+// simulated application, importing it into a fresh application and comparing
+// the hashes of each of their substores. It catches the state not exported by
+// ExportGenesis, or not imported back by InitGenesis. It is only asserted at
+// the end of the blocks, as a genesis state is exported after a block.
+func ImportExportInvariant(exportImport ExportImportFn) Invariant {
+	return EndBlockInvariant(func(ctx sdk.Context) error {
+		newCtx, stores, err := exportImport(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to export and import the genesis state: %v", err)
+		}
+
+		for _, pair := range stores {
+			storeA, storeB := ctx.KVStore(pair.A), newCtx.KVStore(pair.B)
+			hashA := storeHash(storeA, pair.SkipPrefixes)
+			hashB := storeHash(storeB, pair.SkipPrefixes)
+			if bytes.Equal(hashA, hashB) {
+				continue
+			}
+
+			kvA, kvB, count, _ := sdk.DiffKVStores(storeA, storeB, pair.SkipPrefixes)
+			return fmt.Errorf("store %s differs after importing the exported genesis "+
+				"(hash %X, imported %X), after %d equal key/value pairs:\n"+
+				"exported %X => %X\nimported %X => %X",
+				pair.A.Name(), hashA, hashB, count, kvA.Key, kvA.Value, kvB.Key, kvB.Value)
+		}
+		return nil
+	})
+}
+
+// storeHash hashes the keys and values of a store, the values under the given
+// prefixes excepted.
+func storeHash(store sdk.KVStore, skipPrefixes [][]byte) []byte {
+	hasher := sha256.New()
+	writeBytes := func(bz []byte) {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+		hasher.Write(length[:])
+		hasher.Write(bz)
+	}
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		writeBytes(key)
+
+		skipValue := false
+		for _, prefix := range skipPrefixes {
+			if bytes.HasPrefix(key, prefix) {
+				skipValue = true
+				break
+			}
+		}
+		if !skipValue {
+			writeBytes(iter.Value())
+		}
+	}
+	return hasher.Sum(nil)
+}
@@ -1,6 +1,8 @@
 package simulation
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -14,16 +16,95 @@ import (
 // If the invariant has been broken, it should return an error
 // containing a descriptive message about what happened.
 // The simulator will then halt and print the logs.
-type Invariant func(ctx sdk.Context) error
+type Invariant = sdk.Invariant
 
 // group of Invarient
 type Invariants []Invariant
 
-// assertAll asserts the all invariants against application state
+// StateDumpFn returns a dump of the state of the application at the given
+// context, e.g. its exported genesis state.
+type StateDumpFn func(ctx sdk.Context) (json.RawMessage, error)
+
+// InvariantRegistry registers the invariants of the modules of a simulated
+// application under their route, implementing sdk.InvariantRouter, and returns
+// them to be checked periodically by the simulation.
+type InvariantRegistry struct {
+	routes    []string
+	invars    map[string]Invariant
+	dumpState StateDumpFn
+}
+
+var _ sdk.InvariantRouter = (*InvariantRegistry)(nil)
+
+// NewInvariantRegistry creates an empty InvariantRegistry. The state of the
+// application is dumped with dumpState when an invariant is broken, if not nil.
+func NewInvariantRegistry(dumpState StateDumpFn) *InvariantRegistry {
+	return &InvariantRegistry{
+		invars:    make(map[string]Invariant),
+		dumpState: dumpState,
+	}
+}
+
+// RegisterRoute registers an invariant under the given module name and route.
+// It panics if the route is already registered for the module.
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar Invariant) {
+	fullRoute := fmt.Sprintf("%s/%s", moduleName, route)
+	if _, ok := ir.invars[fullRoute]; ok {
+		panic(fmt.Sprintf("invariant route %s has already been registered", fullRoute))
+	}
+	ir.routes = append(ir.routes, fullRoute)
+	ir.invars[fullRoute] = invar
+}
+
+// Routes returns the full routes of the registered invariants, in the order
+// of registration.
+func (ir *InvariantRegistry) Routes() []string {
+	return ir.routes
+}
+
+// Invariants returns the registered invariants, checked at the blocks whose
+// height is a multiple of the given period. The error of a broken invariant
+// names its route and the height, followed by the state of the application.
+func (ir *InvariantRegistry) Invariants(period int) Invariants {
+	invs := make(Invariants, len(ir.routes))
+	for i, route := range ir.routes {
+		invs[i] = PeriodicInvariant(ir.checkRoute(route), period, 0)
+	}
+	return invs
+}
+
+func (ir *InvariantRegistry) checkRoute(route string) Invariant {
+	invar := ir.invars[route]
+	return func(ctx sdk.Context) error {
+		err := invar(ctx)
+		if err == nil {
+			return nil
+		}
+
+		msg := fmt.Sprintf("invariant %s broken at height %d: %s", route, ctx.BlockHeight(), err)
+		if ir.dumpState == nil {
+			return errors.New(msg)
+		}
+		state, dumpErr := ir.dumpState(ctx)
+		if dumpErr != nil {
+			return fmt.Errorf("%s\nfailed to dump the state: %s", msg, dumpErr)
+		}
+		return fmt.Errorf("%s\nstate:\n%s", msg, state)
+	}
+}
+
+// context key of the simulation event after which invariants are asserted
+type eventKey struct{}
+
+// event after which invariants are asserted once the block has ended
+const endBlockEvent = "EndBlock"
+
+// assertAll asserts the all invariants against application state, at the
+// header of the block being simulated
 func (invs Invariants) assertAll(t *testing.T, app *baseapp.BaseApp,
-	event string, displayLogs func()) {
+	header abci.Header, event string, displayLogs func()) {
 
-	ctx := app.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
+	ctx := app.NewContext(false, header).WithValue(eventKey{}, event)
 
 	for i := 0; i < len(invs); i++ {
 		if err := invs[i](ctx); err != nil {
@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// AppModuleSimulation is the interface a module implements to take part in the
+// simulation of an application driven by a SimulationManager.
+type AppModuleSimulation interface {
+	// name of the module, also the key of its genesis state
+	Name() string
+
+	// generate the randomized genesis state of the module, including its
+	// randomized parameters, into the genesis states of the simulation
+	RandomizedGenesis(simState *GenesisState)
+
+	// random operations of the module, weighted by their frequency
+	WeightedOperations() []WeightedOperation
+}
+
+// GenesisState is the randomized genesis of a simulation, which the modules
+// complete with their genesis state in the order of their registration, so
+// that a module can depend on the randomized values of the previous ones.
+type GenesisState struct {
+	Rand             *rand.Rand
+	Cdc              *codec.Codec
+	Accounts         []Account
+	GenesisTimestamp time.Time
+
+	// amount of the bond denomination of each account
+	InitialStake int64
+	// number of accounts, taken first, bonded as validators at genesis
+	NumBonded int64
+	// unbonding time, set by the staking module
+	UnbondTime time.Duration
+
+	// genesis state of each module, by module name
+	GenState map[string]json.RawMessage
+}
+
+// SetGenesis sets the genesis state of the given module to the JSON of the
+// given genesis.
+func (simState *GenesisState) SetGenesis(moduleName string, genesis interface{}) {
+	simState.GenState[moduleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
+
+// SimulationManager drives the simulation of the modules of an application,
+// generating its randomized genesis and collecting the operations of the
+// modules.
+type SimulationManager struct {
+	Modules []AppModuleSimulation
+}
+
+// NewSimulationManager creates a SimulationManager for the given modules,
+// which generate their genesis state in that order. It panics if two modules
+// have the same name.
+func NewSimulationManager(modules ...AppModuleSimulation) *SimulationManager {
+	names := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		if names[module.Name()] {
+			panic(fmt.Sprintf("module %s has already been registered", module.Name()))
+		}
+		names[module.Name()] = true
+	}
+	return &SimulationManager{Modules: modules}
+}
+
+// AppStateFn returns the function generating the randomized genesis state of
+// the application, the JSON object of the genesis states of the modules
+// marshaled with the given codec, by module name.
+func (sm *SimulationManager) AppStateFn(cdc *codec.Codec) AppStateFn {
+	return func(r *rand.Rand, accs []Account, genesisTimestamp time.Time) json.RawMessage {
+		numBonded := int64(r.Intn(250))
+		if numAccs := int64(len(accs)); numBonded > numAccs {
+			numBonded = numAccs
+		}
+		simState := &GenesisState{
+			Rand:             r,
+			Cdc:              cdc,
+			Accounts:         accs,
+			GenesisTimestamp: genesisTimestamp,
+			InitialStake:     int64(r.Intn(1e6)),
+			NumBonded:        numBonded,
+			GenState:         make(map[string]json.RawMessage),
+		}
+		fmt.Printf("Selected randomly generated parameters for simulated genesis:\n"+
+			"\t{amount of steak per account: %v, initially bonded validators: %v}\n",
+			simState.InitialStake, simState.NumBonded)
+
+		for _, module := range sm.Modules {
+			module.RandomizedGenesis(simState)
+		}
+
+		appState, err := json.Marshal(simState.GenState)
+		if err != nil {
+			panic(err)
+		}
+		return appState
+	}
+}
+
+// WeightedOperations returns the weighted operations of all the modules.
+func (sm *SimulationManager) WeightedOperations() []WeightedOperation {
+	var ops []WeightedOperation
+	for _, module := range sm.Modules {
+		ops = append(ops, module.WeightedOperations()...)
+	}
+	return ops
+}
+
+// defaultGenesisModule is a module simulated with a fixed genesis state and
+// no operations.
+type defaultGenesisModule struct {
+	name    string
+	genesis interface{}
+}
+
+// NewDefaultGenesisModule returns the simulation of a module with the given
+// genesis state, e.g. its default genesis state, and no operations, for the
+// modules which don't implement AppModuleSimulation.
+func NewDefaultGenesisModule(name string, genesis interface{}) AppModuleSimulation {
+	return defaultGenesisModule{name, genesis}
+}
+
+func (m defaultGenesisModule) Name() string { return m.name }
+
+func (m defaultGenesisModule) RandomizedGenesis(simState *GenesisState) {
+	simState.SetGenesis(m.name, m.genesis)
+}
+
+func (m defaultGenesisModule) WeightedOperations() []WeightedOperation { return nil }
@@ -94,19 +94,21 @@ func (ops WeightedOperations) totalWeight() int {
 	return totalOpWeight
 }
 
-type selectOpFn func(r *rand.Rand) Operation
+// selectOpFn returns a randomly selected operation, and its index in the
+// weighted operations
+type selectOpFn func(r *rand.Rand) (int, Operation)
 
 func (ops WeightedOperations) getSelectOpFn() selectOpFn {
 	totalOpWeight := ops.totalWeight()
-	return func(r *rand.Rand) Operation {
+	return func(r *rand.Rand) (int, Operation) {
 		x := r.Intn(totalOpWeight)
 		for i := 0; i < len(ops); i++ {
 			if x <= ops[i].Weight {
-				return ops[i].Op
+				return i, ops[i].Op
 			}
 			x -= ops[i].Weight
 		}
 		// shouldn't happen
-		return ops[0].Op
+		return 0, ops[0].Op
 	}
 }
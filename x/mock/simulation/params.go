@@ -36,12 +36,12 @@ var (
 
 // Simulation parameters
 type Params struct {
-	PastEvidenceFraction      float64
-	NumKeys                   int
-	EvidenceFraction          float64
-	InitialLivenessWeightings []int
-	LivenessTransitionMatrix  TransitionMatrix
-	BlockSizeTransitionMatrix TransitionMatrix
+	PastEvidenceFraction      float64          `json:"past_evidence_fraction"`
+	NumKeys                   int              `json:"num_keys"`
+	EvidenceFraction          float64          `json:"evidence_fraction"`
+	InitialLivenessWeightings []int            `json:"initial_liveness_weightings"`
+	LivenessTransitionMatrix  TransitionMatrix `json:"liveness_transition_matrix"`
+	BlockSizeTransitionMatrix TransitionMatrix `json:"block_size_transition_matrix"`
 }
 
 // Return default simulation parameters
@@ -47,6 +47,11 @@ func RandomDecAmount(r *rand.Rand, max sdk.Dec) sdk.Dec {
 	return sdk.NewDecFromBigIntWithPrec(randInt, sdk.Precision)
 }
 
+// RandIntBetween returns a random integer in [min, max)
+func RandIntBetween(r *rand.Rand, min, max int) int {
+	return r.Intn(max-min) + min
+}
+
 // RandomSetGenesis wraps mock.RandomSetGenesis, but using simulation accounts
 func RandomSetGenesis(r *rand.Rand, app *mock.App, accs []Account, denoms []string) {
 	addrs := make([]sdk.AccAddress, len(accs))
@@ -0,0 +1,108 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// Record of a simulation: its seed, its randomized params and the schedule of
+// its operations. A simulation exported to a record, for example by a CI run
+// finding a failure, can be replayed exactly from it.
+type Record struct {
+	Seed      int64  `json:"seed"`
+	NumBlocks int    `json:"num_blocks"`
+	BlockSize int    `json:"block_size"`
+	Commit    bool   `json:"commit"`
+	Params    Params `json:"params"`
+
+	// indexes in the weighted operations of the operations selected in each
+	// block, without the queued operations
+	Schedule [][]int `json:"schedule"`
+}
+
+func newRecord(seed int64, numBlocks, blockSize int, commit bool) *Record {
+	return &Record{
+		Seed:      seed,
+		NumBlocks: numBlocks,
+		BlockSize: blockSize,
+		Commit:    commit,
+	}
+}
+
+// scheduleBlock records the operations selected in the given block or, when
+// replaying, checks that they are the recorded ones. Blocks past the recorded
+// schedule are recorded in both cases.
+func (record *Record) scheduleBlock(tb testing.TB, replay bool, block int, opIndexes []int) {
+	if !replay || block >= len(record.Schedule) {
+		record.Schedule = append(record.Schedule, opIndexes)
+		return
+	}
+
+	recorded := record.Schedule[block]
+	if len(recorded) != len(opIndexes) {
+		tb.Fatalf("replay diverged on block %d: %d operations selected, %d recorded",
+			block+1, len(opIndexes), len(recorded))
+	}
+	for i := range recorded {
+		if recorded[i] != opIndexes[i] {
+			tb.Fatalf("replay diverged on operation %d within block %d: "+
+				"operation %d selected, %d recorded", i, block+1, opIndexes[i], recorded[i])
+		}
+	}
+}
+
+// ExportRecord writes the record as JSON to the given file.
+func ExportRecord(record *Record, path string) error {
+	bz, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// ImportRecord reads a record exported to the given file.
+func ImportRecord(path string) (*Record, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	record := new(Record)
+	if err := json.Unmarshal(bz, record); err != nil {
+		return nil, fmt.Errorf("invalid simulation record %s: %v", path, err)
+	}
+	return record, nil
+}
+
+// SimulateAndExport runs SimulateFromSeed and exports the record of the
+// simulation to the given file, including when the simulation fails.
+func SimulateAndExport(tb testing.TB, app *baseapp.BaseApp,
+	appStateFn AppStateFn, seed int64, ops WeightedOperations,
+	invariants Invariants, numBlocks int, blockSize int, commit bool,
+	path string) (stopEarly bool, simError error) {
+
+	record := newRecord(seed, numBlocks, blockSize, commit)
+	defer func() {
+		if err := ExportRecord(record, path); err != nil {
+			fmt.Printf("failed to export the simulation record: %v\n", err)
+			return
+		}
+		fmt.Printf("Simulation record exported to %s\n", path)
+	}()
+	return simulate(tb, app, appStateFn, ops, invariants, record, false)
+}
+
+// ReplaySimulation runs the simulation of the record, with its seed and
+// params, failing if an operation selected differs from the recorded schedule.
+// To replay with edited params, clear the schedule of the record.
+func ReplaySimulation(tb testing.TB, app *baseapp.BaseApp,
+	appStateFn AppStateFn, ops WeightedOperations, invariants Invariants,
+	record *Record) (stopEarly bool, simError error) {
+
+	fmt.Printf("Replaying the simulation of seed %d over %d recorded blocks\n",
+		record.Seed, len(record.Schedule))
+	return simulate(tb, app, appStateFn, ops, invariants, record, true)
+}
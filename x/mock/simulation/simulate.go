@@ -48,12 +48,26 @@ func initChain(
 
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided seed.
-// TODO split this monster function up
 func SimulateFromSeed(tb testing.TB, app *baseapp.BaseApp,
 	appStateFn AppStateFn, seed int64, ops WeightedOperations,
 	invariants Invariants,
 	numBlocks int, blockSize int, commit bool) (stopEarly bool, simError error) {
 
+	record := newRecord(seed, numBlocks, blockSize, commit)
+	return simulate(tb, app, appStateFn, ops, invariants, record, false)
+}
+
+// simulate runs the simulation of the record, filling in its params and
+// operation schedule, or checking the operations against its schedule when
+// replaying it.
+// TODO split this monster function up
+func simulate(tb testing.TB, app *baseapp.BaseApp,
+	appStateFn AppStateFn, ops WeightedOperations, invariants Invariants,
+	record *Record, replay bool) (stopEarly bool, simError error) {
+
+	seed, numBlocks, blockSize, commit := record.Seed, record.NumBlocks,
+		record.BlockSize, record.Commit
+
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, t, b := getTestingMode(tb)
 	fmt.Printf("Starting SimulateFromSeed with randomness "+
@@ -61,6 +75,11 @@ func SimulateFromSeed(tb testing.TB, app *baseapp.BaseApp,
 
 	r := rand.New(rand.NewSource(seed))
 	params := RandomParams(r) // := DefaultParams()
+	if replay {
+		params = record.Params
+	} else {
+		record.Params = params
+	}
 	fmt.Printf("Randomized simulation params: %+v\n", params)
 
 	genesisTimestamp := RandTimestamp(r)
@@ -112,7 +131,7 @@ func SimulateFromSeed(tb testing.TB, app *baseapp.BaseApp,
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, t, params, eventStats.tally, invariants,
 		ops, operationQueue, timeOperationQueue,
-		numBlocks, blockSize, displayLogs)
+		numBlocks, blockSize, displayLogs, record, replay)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -146,7 +165,7 @@ func SimulateFromSeed(tb testing.TB, app *baseapp.BaseApp,
 		app.BeginBlock(request)
 
 		if testingMode {
-			invariants.assertAll(t, app, "BeginBlock", displayLogs)
+			invariants.assertAll(t, app, header, "BeginBlock", displayLogs)
 		}
 
 		ctx := app.NewContext(false, header)
@@ -164,28 +183,29 @@ func SimulateFromSeed(tb testing.TB, app *baseapp.BaseApp,
 			logWriter, displayLogs, eventStats.tally)
 
 		if testingMode && onOperation {
-			invariants.assertAll(t, app, "QueuedOperations", displayLogs)
+			invariants.assertAll(t, app, header, "QueuedOperations", displayLogs)
 		}
 
 		logWriter("Standard operations")
 		operations := blockSimulator(r, app, ctx, accs, header, logWriter)
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 		if testingMode {
-			invariants.assertAll(t, app, "StandardOperations", displayLogs)
+			invariants.assertAll(t, app, header, "StandardOperations", displayLogs)
 		}
 
 		res := app.EndBlock(abci.RequestEndBlock{})
+		logWriter("EndBlock")
+
+		if testingMode {
+			invariants.assertAll(t, app, header, endBlockEvent, displayLogs)
+		}
+
 		header.Height++
 		header.Time = header.Time.Add(
 			time.Duration(minTimePerBlock) * time.Second)
 		header.Time = header.Time.Add(
 			time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
 		header.ProposerAddress = validators.randomProposer(r)
-		logWriter("EndBlock")
-
-		if testingMode {
-			invariants.assertAll(t, app, "EndBlock", displayLogs)
-		}
 		if commit {
 			app.Commit()
 		}
@@ -231,7 +251,8 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, params Params,
 	event func(string), invariants Invariants, ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []FutureOperation,
-	totalNumBlocks int, avgBlockSize int, displayLogs func()) blockSimFn {
+	totalNumBlocks int, avgBlockSize int, displayLogs func(),
+	record *Record, replay bool) blockSimFn {
 
 	var lastBlocksizeState = 0 // state for [4 * uniform distribution]
 	var blocksize int
@@ -249,14 +270,18 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, params
 			rand *rand.Rand
 		}
 		opAndRz := make([]opAndR, 0, blocksize)
+		opIndexes := make([]int, 0, blocksize)
 		// Predetermine the blocksize slice so that we can do things like block
 		// out certain operations without changing the ops that follow.
 		for i := 0; i < blocksize; i++ {
+			opIndex, op := selectOp(r)
 			opAndRz = append(opAndRz, opAndR{
-				op:   selectOp(r),
+				op:   op,
 				rand: DeriveRand(r),
 			})
+			opIndexes = append(opIndexes, opIndex)
 		}
+		record.scheduleBlock(tb, replay, int(header.Height)-1, opIndexes)
 
 		for i := 0; i < blocksize; i++ {
 			// NOTE: the Rand 'r' should not be used here.
@@ -274,7 +299,7 @@ func createBlockSimulator(testingMode bool, tb testing.TB, t *testing.T, params
 			if testingMode {
 				if onOperation {
 					eventStr := fmt.Sprintf("operation: %v", logUpdate)
-					invariants.assertAll(t, app, eventStr, displayLogs)
+					invariants.assertAll(t, app, header, eventStr, displayLogs)
 				}
 				if opCount%50 == 0 {
 					fmt.Printf("\rSimulating... block %d/%d, operation %d/%d. ",
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 )
@@ -36,6 +37,25 @@ func CreateTransitionMatrix(weights [][]int) (TransitionMatrix, error) {
 	return TransitionMatrix{weights, totals, n}, nil
 }
 
+// MarshalJSON encodes the weights of the transition matrix.
+func (t TransitionMatrix) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.weights)
+}
+
+// UnmarshalJSON decodes a transition matrix from its weights.
+func (t *TransitionMatrix) UnmarshalJSON(bz []byte) error {
+	var weights [][]int
+	if err := json.Unmarshal(bz, &weights); err != nil {
+		return err
+	}
+	matrix, err := CreateTransitionMatrix(weights)
+	if err != nil {
+		return err
+	}
+	*t = matrix
+	return nil
+}
+
 // NextState returns the next state randomly chosen using r, and the weightings
 // provided in the transition matrix.
 func (t TransitionMatrix) NextState(r *rand.Rand, i int) int {
@@ -120,3 +120,15 @@ func PeriodicInvariant(invariant Invariant, period int, offset int) Invariant {
 		return nil
 	}
 }
+
+// EndBlockInvariant returns an Invariant function closure that asserts a given
+// invariant only once the EndBlock of the simulated block has run, when the
+// state of the application is consistent, e.g. to be exported.
+func EndBlockInvariant(invariant Invariant) Invariant {
+	return func(ctx sdk.Context) error {
+		if ctx.Value(eventKey{}) == endBlockEvent {
+			return invariant(ctx)
+		}
+		return nil
+	}
+}
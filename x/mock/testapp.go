@@ -0,0 +1,84 @@
+package mock
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// TestAppConfig configures the modules and the genesis of a TestApp.
+type TestAppConfig struct {
+	// modules of the app, driving its genesis and blocks in this order
+	Modules []sdk.AppModule
+	// stores of the modules, mounted on the app in addition to the ones of
+	// the mock App
+	StoreKeys []sdk.StoreKey
+	// genesis state of each module, by module name
+	Genesis map[string]json.RawMessage
+
+	// accounts at genesis; if none, NumAccounts accounts are generated, each
+	// funded with AccountCoins
+	GenesisAccounts []auth.Account
+	NumAccounts     int
+	AccountCoins    sdk.Coins
+}
+
+// TestApp is a mock App running a chosen subset of modules, initialized with
+// their genesis state and funded accounts, for the unit tests of the modules.
+type TestApp struct {
+	*App
+	ModuleManager *sdk.ModuleManager
+
+	// keys of the generated genesis accounts, sorted by address
+	Accounts []AddrKeys
+
+	// context on the state of the app after genesis
+	Ctx sdk.Context
+}
+
+// NewTestApp completes the setup of the given mock App, whose codec and
+// keepers are used by the given modules, and initializes its chain. The
+// handlers and queriers of the modules must be routed on the app before.
+func NewTestApp(t *testing.T, app *App, cfg TestAppConfig) *TestApp {
+	mm := sdk.NewModuleManager(cfg.Modules...)
+	for _, name := range mm.OrderInitGenesis {
+		_, ok := cfg.Genesis[name]
+		require.True(t, ok, "no genesis state for module %s", name)
+	}
+
+	app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		app.InitChainer(ctx, req)
+		return mm.InitGenesis(ctx, cfg.Genesis)
+	})
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		return mm.BeginBlock(ctx, req)
+	})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		return mm.EndBlock(ctx, req)
+	})
+	require.NoError(t, app.CompleteSetup(cfg.StoreKeys...))
+
+	tapp := &TestApp{App: app, ModuleManager: mm}
+
+	genAccs := cfg.GenesisAccounts
+	if len(genAccs) == 0 {
+		var addrs []sdk.AccAddress
+		var pubKeys []crypto.PubKey
+		var privKeys []crypto.PrivKey
+		genAccs, addrs, pubKeys, privKeys = CreateGenAccounts(cfg.NumAccounts, cfg.AccountCoins)
+		for i := range addrs {
+			tapp.Accounts = append(tapp.Accounts, AddrKeys{addrs[i], pubKeys[i], privKeys[i]})
+		}
+	}
+	SetGenesis(app, genAccs)
+
+	tapp.Ctx = app.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	return tapp
+}
@@ -0,0 +1,109 @@
+// Package testkeys derives stable named test accounts from a seed, so that
+// tests share the same keys, addresses and signatures across runs instead of
+// generating ad-hoc random keys.
+package testkeys
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+// DefaultSeed is the seed of the accounts returned by the package-level
+// helpers.
+const DefaultSeed = "cosmos-sdk test keys"
+
+// Account is a named test account along with its keys.
+type Account struct {
+	Name    string
+	PrivKey crypto.PrivKey
+	PubKey  crypto.PubKey
+	Address sdk.AccAddress
+}
+
+// ValAddress returns the validator operator address of the account.
+func (acc Account) ValAddress() sdk.ValAddress {
+	return sdk.ValAddress(acc.Address)
+}
+
+// NewAccount derives the secp256k1 account of the given name from the seed.
+// The same seed and name always derive the same account.
+func NewAccount(seed, name string) Account {
+	secret := sha256.Sum256([]byte(seed + "/" + name))
+	privKey := secp256k1.GenPrivKeySecp256k1(secret[:])
+	pubKey := privKey.PubKey()
+	return Account{
+		Name:    name,
+		PrivKey: privKey,
+		PubKey:  pubKey,
+		Address: sdk.AccAddress(pubKey.Address()),
+	}
+}
+
+// NewAccounts derives the accounts of the given names from the seed.
+func NewAccounts(seed string, names ...string) []Account {
+	accs := make([]Account, len(names))
+	for i, name := range names {
+		accs[i] = NewAccount(seed, name)
+	}
+	return accs
+}
+
+// Named returns the account of the given name derived from the DefaultSeed.
+func Named(name string) Account {
+	return NewAccount(DefaultSeed, name)
+}
+
+// Accounts returns n accounts derived from the DefaultSeed, named account0 to
+// account<n-1>.
+func Accounts(n int) []Account {
+	names := make([]string, n)
+	for i := range names {
+		names[i] = fmt.Sprintf("account%d", i)
+	}
+	return NewAccounts(DefaultSeed, names...)
+}
+
+// Addresses returns the addresses of the accounts.
+func Addresses(accs []Account) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(accs))
+	for i, acc := range accs {
+		addrs[i] = acc.Address
+	}
+	return addrs
+}
+
+// PrivKeys returns the private keys of the accounts, e.g. to sign with
+// mock.GenTx.
+func PrivKeys(accs []Account) []crypto.PrivKey {
+	privKeys := make([]crypto.PrivKey, len(accs))
+	for i, acc := range accs {
+		privKeys[i] = acc.PrivKey
+	}
+	return privKeys
+}
+
+// GenesisAccounts returns the genesis accounts of the accounts, each holding
+// the given coins.
+func GenesisAccounts(coins sdk.Coins, accs []Account) []auth.Account {
+	genAccs := make([]auth.Account, len(accs))
+	for i, acc := range accs {
+		genAccs[i] = &auth.BaseAccount{
+			Address: acc.Address,
+			Coins:   coins,
+		}
+	}
+	return genAccs
+}
+
+// Fund sets the genesis of the mock application with the accounts, each
+// holding the given coins.
+func Fund(app *mock.App, coins sdk.Coins, accs ...Account) {
+	mock.SetGenesis(app, GenesisAccounts(coins, accs))
+}
@@ -0,0 +1,45 @@
+package testkeys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestNewAccount(t *testing.T) {
+	acc := NewAccount("seed", "alice")
+	require.Equal(t, "alice", acc.Name)
+	require.Equal(t, acc.PrivKey.PubKey(), acc.PubKey)
+	require.Equal(t, sdk.AccAddress(acc.PubKey.Address()), acc.Address)
+	require.Equal(t, sdk.ValAddress(acc.Address), acc.ValAddress())
+
+	// the derivation is deterministic, and depends on the seed and the name
+	require.Equal(t, acc, NewAccount("seed", "alice"))
+	require.NotEqual(t, acc.Address, NewAccount("seed", "bob").Address)
+	require.NotEqual(t, acc.Address, NewAccount("other seed", "alice").Address)
+	require.Equal(t, NewAccount(DefaultSeed, "alice"), Named("alice"))
+
+	accs := Accounts(3)
+	require.Len(t, accs, 3)
+	require.Equal(t, Named("account2"), accs[2])
+	require.Equal(t, []sdk.AccAddress{accs[0].Address, accs[1].Address, accs[2].Address}, Addresses(accs))
+	require.Equal(t, accs[1].PrivKey, PrivKeys(accs)[1])
+}
+
+func TestFund(t *testing.T) {
+	app := mock.NewApp()
+	require.NoError(t, app.CompleteSetup())
+
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	accs := Accounts(2)
+	Fund(app, coins, accs...)
+
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	for _, acc := range accs {
+		require.Equal(t, coins, app.AccountKeeper.GetAccount(ctx, acc.Address).GetCoins())
+	}
+}
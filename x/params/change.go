@@ -0,0 +1,39 @@
+package params
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ParamChange defines a change of a single parameter, identified by the
+// subspace it is registered in and its key. Value is the JSON encoding
+// of the new parameter value.
+type ParamChange struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+// NewParamChange creates a new ParamChange instance
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{subspace, key, value}
+}
+
+// String implements the Stringer interface
+func (pc ParamChange) String() string {
+	return fmt.Sprintf("%s/%s: %s", pc.Subspace, pc.Key, pc.Value)
+}
+
+// ValidateBasic performs a stateless validity check of the change
+func (pc ParamChange) ValidateBasic() error {
+	if len(pc.Subspace) == 0 {
+		return errors.New("parameter change subspace cannot be empty")
+	}
+	if len(pc.Key) == 0 {
+		return errors.New("parameter change key cannot be empty")
+	}
+	if len(pc.Value) == 0 {
+		return errors.New("parameter change value cannot be empty")
+	}
+	return nil
+}
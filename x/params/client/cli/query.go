@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// GetCmdQuerySubspace implements the command to query the value of a
+// parameter of any registered subspace.
+func GetCmdQuerySubspace(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subspace [subspace] [key]",
+		Short: "Query the raw value of a parameter of a subspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(params.NewQuerySubspaceParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", params.QuerierRoute, params.QueryParams)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/params/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{storeKey, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group params queries under a subcommand
+	paramsQueryCmd := &cobra.Command{
+		Use:   "params",
+		Short: "Querying commands for the params module",
+	}
+
+	paramsQueryCmd.AddCommand(
+		client.GetCommands(
+			cli.GetCmdQuerySubspace(mc.cdc),
+		)...,
+	)
+
+	return paramsQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	return &cobra.Command{Hidden: true}
+}
@@ -1,6 +1,8 @@
 package params
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -63,3 +65,12 @@ func (k Keeper) GetSubspace(storename string) (Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// Apply a parameter change to its registered subspace
+func (k Keeper) UpdateParam(ctx sdk.Context, change ParamChange) error {
+	space, ok := k.GetSubspace(change.Subspace)
+	if !ok {
+		return fmt.Errorf("subspace %s not found", change.Subspace)
+	}
+	return space.Update(ctx, []byte(change.Key), []byte(change.Value))
+}
@@ -1,6 +1,7 @@
 package params
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -131,6 +132,64 @@ func TestKeeper(t *testing.T) {
 	}
 }
 
+func TestUpdateParam(t *testing.T) {
+	table := NewTypeTable(
+		[]byte("int"), int64(0),
+		[]byte("bool"), bool(false),
+	)
+
+	cdc := createTestCodec()
+	skey := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(skey, tkey)
+	keeper := NewKeeper(cdc, skey, tkey)
+	space := keeper.Subspace("test").WithTypeTable(table)
+
+	require.NoError(t, keeper.UpdateParam(ctx, NewParamChange("test", "int", `"10"`)))
+	require.NoError(t, keeper.UpdateParam(ctx, NewParamChange("test", "bool", `true`)))
+
+	var i int64
+	space.Get(ctx, []byte("int"), &i)
+	require.Equal(t, int64(10), i)
+	require.True(t, space.Modified(ctx, []byte("int")))
+
+	var b bool
+	space.Get(ctx, []byte("bool"), &b)
+	require.True(t, b)
+
+	// unknown subspace, unregistered key and mistyped value
+	require.Error(t, keeper.UpdateParam(ctx, NewParamChange("other", "int", `"10"`)))
+	require.Error(t, keeper.UpdateParam(ctx, NewParamChange("test", "unknown", `"10"`)))
+	require.Error(t, keeper.UpdateParam(ctx, NewParamChange("test", "int", `true`)))
+	space.Get(ctx, []byte("int"), &i)
+	require.Equal(t, int64(10), i)
+}
+
+func TestUpdateParamValidator(t *testing.T) {
+	table := NewTypeTable(
+		[]byte("int"), int64(0),
+	).RegisterValidator([]byte("int"), func(value interface{}) error {
+		if value.(int64) < 0 {
+			return errors.New("negative int")
+		}
+		return nil
+	})
+
+	cdc := createTestCodec()
+	skey := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(skey, tkey)
+	keeper := NewKeeper(cdc, skey, tkey)
+	space := keeper.Subspace("test").WithTypeTable(table)
+
+	require.NoError(t, keeper.UpdateParam(ctx, NewParamChange("test", "int", `"10"`)))
+	require.Error(t, keeper.UpdateParam(ctx, NewParamChange("test", "int", `"-10"`)))
+
+	var i int64
+	space.Get(ctx, []byte("int"), &i)
+	require.Equal(t, int64(10), i)
+}
+
 func indirect(ptr interface{}) interface{} {
 	return reflect.ValueOf(ptr).Elem().Interface()
 }
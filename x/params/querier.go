@@ -0,0 +1,79 @@
+package params
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// QuerierRoute is the querier route for the params store
+	QuerierRoute = StoreKey
+
+	// QueryParams is the query endpoint returning the value of a parameter
+	QueryParams = "params"
+)
+
+// QuerySubspaceParams defines the params for the following queries:
+// - 'custom/params/params'
+type QuerySubspaceParams struct {
+	Subspace string
+	Key      string
+}
+
+// NewQuerySubspaceParams creates a new QuerySubspaceParams instance
+func NewQuerySubspaceParams(subspace, key string) QuerySubspaceParams {
+	return QuerySubspaceParams{
+		Subspace: subspace,
+		Key:      key,
+	}
+}
+
+// SubspaceParamsResponse is the value of a parameter, identified by the
+// subspace it is registered in and its key. Value is the JSON encoding of
+// the parameter value as stored.
+type SubspaceParamsResponse struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+// NewQuerier returns a params Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySubspaceParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	space, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("subspace %s not found", params.Subspace))
+	}
+
+	value := space.GetRaw(ctx, []byte(params.Key))
+	if value == nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("parameter %s not found in subspace %s", params.Key, params.Subspace))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, SubspaceParamsResponse{params.Subspace, params.Key, string(value)})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
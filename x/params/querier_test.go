@@ -0,0 +1,58 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueryParams(t *testing.T) {
+	cdc := codec.New()
+	skey := sdk.NewKVStoreKey("test")
+	tkey := sdk.NewTransientStoreKey("transient_test")
+	ctx := defaultContext(skey, tkey)
+	keeper := NewKeeper(cdc, skey, tkey)
+	table := NewTypeTable([]byte("key1"), int64(0), []byte("key2"), int64(0))
+	space := keeper.Subspace("test").WithTypeTable(table)
+	space.Set(ctx, []byte("key1"), int64(10))
+
+	querier := NewQuerier(keeper)
+	query := func(subspace, key string) ([]byte, sdk.Error) {
+		bz, err := cdc.MarshalJSON(NewQuerySubspaceParams(subspace, key))
+		require.NoError(t, err)
+		return querier(ctx, []string{QueryParams}, abci.RequestQuery{Data: bz})
+	}
+
+	res, err := query("test", "key1")
+	require.Nil(t, err)
+
+	var resp SubspaceParamsResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &resp))
+	require.Equal(t, "test", resp.Subspace)
+	require.Equal(t, "key1", resp.Key)
+
+	var param int64
+	require.NoError(t, cdc.UnmarshalJSON([]byte(resp.Value), &param))
+	require.Equal(t, int64(10), param)
+
+	// parameter registered but never set
+	_, err = query("test", "key2")
+	require.NotNil(t, err)
+
+	// unknown subspace
+	_, err = query("other", "key1")
+	require.NotNil(t, err)
+
+	// unknown endpoint
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+
+	// malformed request data
+	_, err = querier(ctx, []string{QueryParams}, abci.RequestQuery{Data: []byte("invalid")})
+	require.NotNil(t, err)
+}
@@ -11,6 +11,7 @@ type (
 	ParamSet         = subspace.ParamSet
 	KeyValuePairs    = subspace.KeyValuePairs
 	TypeTable        = subspace.TypeTable
+	ValidatorFn      = subspace.ValidatorFn
 )
 
 // re-export functions from subspace
@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -36,7 +37,8 @@ func NewSubspace(cdc *codec.Codec, key sdk.StoreKey, tkey sdk.StoreKey, name str
 		tkey: tkey,
 		name: []byte(name),
 		table: TypeTable{
-			m: make(map[string]reflect.Type),
+			m:          make(map[string]reflect.Type),
+			validators: make(map[string]ValidatorFn),
 		},
 	}
 
@@ -55,6 +57,9 @@ func (s Subspace) WithTypeTable(table TypeTable) Subspace {
 	for k, v := range table.m {
 		s.table.m[k] = v
 	}
+	for k, fn := range table.validators {
+		s.table.validators[k] = fn
+	}
 
 	// Allocate additional capicity for Subspace.name
 	// So we don't have to allocate extra space each time appending to the key
@@ -150,6 +155,32 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 
 }
 
+// Update parameter from its JSON encoded value, return error if the
+// parameter is not registered, the value cannot be decoded into its type
+// or it is rejected by the validator registered for the parameter
+func (s Subspace) Update(ctx sdk.Context, key []byte, value []byte) error {
+	ty, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s not registered in subspace %s", key, s.name)
+	}
+
+	param := reflect.New(ty).Interface()
+	err := s.cdc.UnmarshalJSON(value, param)
+	if err != nil {
+		return err
+	}
+
+	if fn, ok := s.table.validators[string(key)]; ok {
+		err = fn(reflect.ValueOf(param).Elem().Interface())
+		if err != nil {
+			return err
+		}
+	}
+
+	s.Set(ctx, key, param)
+	return nil
+}
+
 // Get to ParamSet
 func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	for _, pair := range ps.KeyValuePairs() {
@@ -6,9 +6,13 @@ import (
 
 // TypeTable subspaces appropriate type for each parameter key
 type TypeTable struct {
-	m map[string]reflect.Type
+	m          map[string]reflect.Type
+	validators map[string]ValidatorFn
 }
 
+// ValidatorFn checks the value of a parameter before it is updated
+type ValidatorFn func(value interface{}) error
+
 // Constructs new table
 func NewTypeTable(keytypes ...interface{}) (res TypeTable) {
 	if len(keytypes)%2 != 0 {
@@ -16,7 +20,8 @@ func NewTypeTable(keytypes ...interface{}) (res TypeTable) {
 	}
 
 	res = TypeTable{
-		m: make(map[string]reflect.Type),
+		m:          make(map[string]reflect.Type),
+		validators: make(map[string]ValidatorFn),
 	}
 
 	for i := 0; i < len(keytypes); i += 2 {
@@ -62,6 +67,21 @@ func (t TypeTable) RegisterType(key []byte, ty interface{}) TypeTable {
 	return t
 }
 
+// Register the function validating updates of a registered key
+func (t TypeTable) RegisterValidator(key []byte, fn ValidatorFn) TypeTable {
+	keystr := string(key)
+	if _, ok := t.m[keystr]; !ok {
+		panic("validator registered for unknown parameter key")
+	}
+	if _, ok := t.validators[keystr]; ok {
+		panic("duplicate parameter validator")
+	}
+
+	t.validators[keystr] = fn
+
+	return t
+}
+
 // Register multiple pairs from ParamSet
 func (t TypeTable) RegisterParamSet(ps ParamSet) TypeTable {
 	for _, kvp := range ps.KeyValuePairs() {
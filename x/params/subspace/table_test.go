@@ -32,4 +32,9 @@ func TestTypeTable(t *testing.T) {
 
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
+
+	noop := func(interface{}) error { return nil }
+	require.Panics(t, func() { table.RegisterValidator([]byte("unknown"), noop) })
+	require.NotPanics(t, func() { table.RegisterValidator([]byte("hello"), noop) })
+	require.Panics(t, func() { table.RegisterValidator([]byte("hello"), noop) })
 }
@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,8 +22,9 @@ var (
 	coins = sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
 )
 
-// initialize the mock application for this module
-func getMockApp(t *testing.T) (*mock.App, staking.Keeper, Keeper) {
+// initialize the mock application for this module, running the staking and
+// slashing modules with the given genesis accounts
+func getMockApp(t *testing.T, accs []auth.Account) (*mock.App, staking.Keeper, Keeper) {
 	mapp := mock.NewApp()
 
 	RegisterCodec(mapp.Cdc)
@@ -36,40 +38,22 @@ func getMockApp(t *testing.T) (*mock.App, staking.Keeper, Keeper) {
 	mapp.Router().AddRoute(staking.RouterKey, staking.NewHandler(stakingKeeper))
 	mapp.Router().AddRoute(RouterKey, NewHandler(keeper))
 
-	mapp.SetEndBlocker(getEndBlocker(stakingKeeper))
-	mapp.SetInitChainer(getInitChainer(mapp, stakingKeeper))
-
-	require.NoError(t, mapp.CompleteSetup(keyStaking, tkeyStaking, keySlashing))
-
-	return mapp, stakingKeeper, keeper
-}
-
-// staking endblocker
-func getEndBlocker(keeper staking.Keeper) sdk.EndBlocker {
-	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-		validatorUpdates, tags := staking.EndBlocker(ctx, keeper)
-		return abci.ResponseEndBlock{
-			ValidatorUpdates: validatorUpdates,
-			Tags:             tags,
-		}
-	}
-}
-
-// overwrite the mock init chainer
-func getInitChainer(mapp *mock.App, keeper staking.Keeper) sdk.InitChainer {
-	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
-		mapp.InitChainer(ctx, req)
-		stakingGenesis := staking.DefaultGenesisState()
-		stakingGenesis.Pool.LooseTokens = sdk.NewInt(100000)
-		validators, err := staking.InitGenesis(ctx, keeper, stakingGenesis)
-		if err != nil {
-			panic(err)
-		}
-
-		return abci.ResponseInitChain{
-			Validators: validators,
-		}
-	}
+	stakingGenesis := staking.DefaultGenesisState()
+	stakingGenesis.Pool.LooseTokens = sdk.NewInt(100000)
+
+	tapp := mock.NewTestApp(t, mapp, mock.TestAppConfig{
+		Modules: []sdk.AppModule{
+			staking.NewAppModule(mapp.Cdc, stakingKeeper),
+			NewAppModule(mapp.Cdc, keeper),
+		},
+		StoreKeys: []sdk.StoreKey{keyStaking, tkeyStaking, keySlashing},
+		Genesis: map[string]json.RawMessage{
+			staking.ModuleName: mapp.Cdc.MustMarshalJSON(stakingGenesis),
+			ModuleName:         mapp.Cdc.MustMarshalJSON(DefaultGenesisState()),
+		},
+		GenesisAccounts: accs,
+	})
+	return tapp.App, stakingKeeper, keeper
 }
 
 func checkValidator(t *testing.T, mapp *mock.App, keeper staking.Keeper,
@@ -89,7 +73,6 @@ func checkValidatorSigningInfo(t *testing.T, mapp *mock.App, keeper Keeper,
 }
 
 func TestSlashingMsgs(t *testing.T) {
-	mapp, stakingKeeper, keeper := getMockApp(t)
 
 	genCoin := sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 42)
 	bondCoin := sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)
@@ -99,7 +82,7 @@ func TestSlashingMsgs(t *testing.T) {
 		Coins:   sdk.Coins{genCoin},
 	}
 	accs := []auth.Account{acc1}
-	mock.SetGenesis(mapp, accs)
+	mapp, stakingKeeper, keeper := getMockApp(t, accs)
 
 	description := staking.NewDescription("foo_moniker", "", "", "")
 	commission := staking.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
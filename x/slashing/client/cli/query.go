@@ -58,6 +58,58 @@ func GetCmdQuerySigningInfo(storeName string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQuerySigningInfos implements the command to query the signing info of
+// all the validators.
+func GetCmdQuerySigningInfos(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Query the signing information of all the validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := slashing.NewQuerySigningInfosParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QuerySigningInfos)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var signingInfos []slashing.SigningInfo
+			if err := cdc.UnmarshalJSON(res, &signingInfos); err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+
+			case "text":
+				for _, signingInfo := range signingInfos {
+					fmt.Printf("Validator %s: %s\n", signingInfo.Address, signingInfo.ValidatorSigningInfo.HumanReadableString())
+				}
+
+			case "json":
+				output, err := codec.MarshalJSONIndent(cdc, signingInfos)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(output))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, 0, "Query a specific page of paginated results (requires --limit)")
+	cmd.Flags().Int(FlagLimit, 0, "Maximum number of results per page (0 returns all the results)")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
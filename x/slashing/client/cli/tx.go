@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -34,9 +35,33 @@ func GetCmdUnjail(cdc *codec.Codec) *cobra.Command {
 			if cliCtx.GenerateOnly {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
+
+			// check that the validator can be unjailed before broadcasting
+			err = checkUnjail(cliCtx, cdc, sdk.ValAddress(valAddr))
+			if err != nil {
+				return err
+			}
+
 			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
 		},
 	}
 
 	return cmd
 }
+
+// checkUnjail queries whether a validator could be unjailed now, returning the
+// reason it cannot otherwise
+func checkUnjail(cliCtx context.CLIContext, cdc *codec.Codec, valAddr sdk.ValAddress) error {
+	bz, err := cdc.MarshalJSON(slashing.NewQueryUnjailParams(valAddr))
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryUnjail)
+	_, err = cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return fmt.Errorf("validator %s cannot be unjailed: %s", valAddr, err)
+	}
+
+	return nil
+}
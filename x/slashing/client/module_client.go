@@ -29,6 +29,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		client.GetCommands(
 			cli.GetCmdQuerySigningInfo(mc.storeKey, mc.cdc),
+			cli.GetCmdQuerySigningInfos(mc.cdc),
 			cli.GetCmdQueryParams(mc.cdc),
 		)...,
 	)
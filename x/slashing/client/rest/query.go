@@ -19,6 +19,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		signingInfoHandlerFn(cliCtx, slashing.StoreKey, cdc),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/signing_infos",
+		signingInfosHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/parameters",
 		queryParamsHandlerFn(cdc, cliCtx),
@@ -29,6 +34,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 // nolint: unparam
 func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 
 		pk, err := sdk.GetConsPubKeyBech32(vars["validatorPubKey"])
@@ -39,11 +49,12 @@ func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *code
 
 		key := slashing.GetValidatorSigningInfoKey(sdk.ConsAddress(pk.Address()))
 
-		res, err := cliCtx.QueryStore(key, storeName)
+		res, height, err := cliCtx.QueryStoreWithHeight(key, storeName)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		cliCtx = cliCtx.WithHeight(height)
 
 		if len(res) == 0 {
 			w.WriteHeader(http.StatusNoContent)
@@ -58,20 +69,57 @@ func signingInfoHandlerFn(cliCtx context.CLIContext, storeName string, cdc *code
 			return
 		}
 
-		utils.PostProcessResponse(w, cdc, signingInfo, cliCtx.Indent)
+		utils.PostProcessQueryResponse(w, cliCtx, signingInfo)
+	}
+}
+
+// http request handler to query the signing infos of all the validators
+func signingInfosHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		pagination, page, ok := utils.ParseQuerierPaginationOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(slashing.NewQuerySigningInfosParams(page, pagination.Limit))
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QuerySigningInfos)
+		res, height, err := cliCtx.QueryWithHeight(route, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQuerierPageResponse(w, cliCtx, pagination, res)
 	}
 }
 
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		route := fmt.Sprintf("custom/%s/parameters", slashing.QuerierRoute)
 
-		res, err := cliCtx.QueryWithData(route, nil)
+		res, height, err := cliCtx.QueryWithHeight(route, nil)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		cliCtx = cliCtx.WithHeight(height)
 
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
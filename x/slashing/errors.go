@@ -2,6 +2,9 @@
 package slashing
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,8 +19,19 @@ const (
 	CodeValidatorJailed       CodeType = 102
 	CodeValidatorNotJailed    CodeType = 103
 	CodeMissingSelfDelegation CodeType = 104
+	CodeValidatorTombstoned   CodeType = 105
+	CodeMissingSigningInfo    CodeType = 106
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidValidator, "invalid validator")
+	sdk.RegisterCode(DefaultCodespace, CodeValidatorJailed, "validator jailed")
+	sdk.RegisterCode(DefaultCodespace, CodeValidatorNotJailed, "validator not jailed")
+	sdk.RegisterCode(DefaultCodespace, CodeMissingSelfDelegation, "missing self-delegation")
+	sdk.RegisterCode(DefaultCodespace, CodeValidatorTombstoned, "validator tombstoned")
+	sdk.RegisterCode(DefaultCodespace, CodeMissingSigningInfo, "missing signing info")
+}
+
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "that address is not associated with any known validator")
 }
@@ -30,10 +44,22 @@ func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorJailed, "validator still jailed, cannot yet be unjailed")
 }
 
+func ErrValidatorJailedFor(codespace sdk.CodespaceType, remaining time.Duration) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, fmt.Sprintf("validator still jailed for %s, cannot yet be unjailed", remaining))
+}
+
 func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator not jailed, cannot be unjailed")
 }
 
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator was tombstoned for double signing, cannot be unjailed")
+}
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeMissingSigningInfo, fmt.Sprintf("no signing info found for address: %s", consAddr))
+}
+
 func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingSelfDelegation, "validator has no self-delegation; cannot be unjailed")
 }
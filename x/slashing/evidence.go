@@ -0,0 +1,25 @@
+package slashing
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+)
+
+// NewEquivocationHandler returns the evidence handler slashing, jailing and
+// tombstoning the validators which double signed
+func NewEquivocationHandler(k Keeper) evidence.Handler {
+	return func(ctx sdk.Context, e evidence.Evidence) error {
+		equivocation, ok := e.(evidence.Equivocation)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type %T for route %s", e, evidence.RouteEquivocation)
+		}
+
+		k.handleDoubleSign(ctx, crypto.Address(equivocation.ConsensusAddress), equivocation.Height,
+			equivocation.Time, equivocation.Power)
+		return nil
+	}
+}
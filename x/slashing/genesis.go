@@ -1,11 +1,7 @@
 package slashing
 
 import (
-	"fmt"
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // GenesisState - all slashing state that must be provided at genesis
@@ -32,45 +28,20 @@ func DefaultGenesisState() GenesisState {
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
-	downtime := data.Params.SlashFractionDowntime
-	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
-	}
-
-	dblSign := data.Params.SlashFractionDoubleSign
-	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
-	}
-
-	minSign := data.Params.MinSignedPerWindow
-	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
-	}
-
-	maxEvidence := data.Params.MaxEvidenceAge
-	if maxEvidence < 1*time.Minute {
-		return fmt.Errorf("Max evidence age must be at least 1 minute, is %s", maxEvidence.String())
-	}
-
-	downtimeJail := data.Params.DowntimeJailDuration
-	if downtimeJail < 1*time.Minute {
-		return fmt.Errorf("Downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
-	}
-
-	signedWindow := data.Params.SignedBlocksWindow
-	if signedWindow < 10 {
-		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
-	}
-
-	return nil
+	return data.Params.Validate()
 }
 
 // InitGenesis initialize default parameters
-// and the keeper's address to pubkey map
-func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.GenesisState) {
-	for _, validator := range sdata.Validators {
-		keeper.addPubkey(ctx, validator.GetConsPubKey())
-	}
+// and the keeper's address to pubkey map.
+// NOTE: the validators are read from the validator set, so the staking
+// genesis must be initialized first.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.validatorSet.IterateValidators(ctx,
+		func(index int64, validator sdk.Validator) (stop bool) {
+			keeper.addPubkey(ctx, validator.GetConsPubKey())
+			return false
+		},
+	)
 
 	for addr, info := range data.SigningInfos {
 		address, err := sdk.ConsAddressFromBech32(addr)
@@ -91,6 +62,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState, sdata types.
 	}
 
 	keeper.paramspace.SetParamSet(ctx, &data.Params)
+	keeper.setMissedBlockBitArrayWindow(ctx, data.Params.SignedBlocksWindow)
 }
 
 // ExportGenesis writes the current store values
@@ -73,3 +73,7 @@ func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+func (h Hooks) AfterValidatorBondedDelta(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress, _ int64) {
+}
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coin) {
+}
@@ -38,7 +38,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspa
 // handle a validator signing two blocks at the same height
 // power: power of the double-signing validator at the height of infraction
 func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
-	logger := ctx.Logger().With("module", "x/slashing")
+	logger := ctx.ModuleLogger("x/slashing")
 	time := ctx.BlockHeader().Time
 	age := time.Sub(timestamp)
 	consAddr := sdk.ConsAddress(addr)
@@ -97,20 +97,52 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		k.validatorSet.Jail(ctx, consAddr)
 	}
 
-	// Set slashed so far to total slash
-	signInfo.Tombstoned = true
+	// Set slashed so far to total slash and jail the validator forever
+	k.Tombstone(ctx, consAddr)
+}
 
-	// Set jailed until to be forever (max time)
-	signInfo.JailedUntil = DoubleSignJailEndTime
+// checks that a validator can currently be unjailed, returning the reason
+// it cannot otherwise
+func (k Keeper) checkUnjail(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.ConsAddress, sdk.Error) {
+	validator := k.validatorSet.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
 
-	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	// cannot be unjailed if no self-delegation exists
+	selfDel := k.validatorSet.Delegation(ctx, sdk.AccAddressFromValAddress(valAddr), valAddr)
+	if selfDel == nil {
+		return nil, ErrMissingSelfDelegation(k.codespace)
+	}
+
+	if !validator.GetJailed() {
+		return nil, ErrValidatorNotJailed(k.codespace)
+	}
+
+	consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+
+	info, found := k.getValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
+
+	// cannot be unjailed if tombstoned
+	if info.Tombstoned {
+		return nil, ErrValidatorTombstoned(k.codespace)
+	}
+
+	// cannot be unjailed until out of jail
+	if ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		return nil, ErrValidatorJailedFor(k.codespace, info.JailedUntil.Sub(ctx.BlockHeader().Time))
+	}
+
+	return consAddr, nil
 }
 
 // handle a validator signature, must be called once per validator per block
 // TODO refactor to take in a consensus address, additionally should maybe just take in the pubkey too
 func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
-	logger := ctx.Logger().With("module", "x/slashing")
+	logger := ctx.ModuleLogger("x/slashing")
 	height := ctx.BlockHeight()
 	consAddr := sdk.ConsAddress(addr)
 	pubkey, err := k.getPubkey(ctx, addr)
@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -69,6 +70,8 @@ func TestHandleDoubleSign(t *testing.T) {
 	msgUnjail := NewMsgUnjail(operatorAddr)
 	res := handleMsgUnjail(ctx, msgUnjail, keeper)
 	require.False(t, res.IsOK())
+	require.Equal(t, CodeValidatorTombstoned, res.Code)
+	require.True(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
 	// Should be able to unbond now
 	del, _ := sk.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
@@ -459,3 +462,26 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that equivocations routed by the evidence module slash the validator
+func TestEquivocationHandler(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	ctx = ctx.WithBlockHeight(-1)
+	amtInt := int64(100)
+	operatorAddr, val, amt := addrs[0], pks[0], sdk.NewInt(amtInt)
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+	keeper.handleValidatorSignature(ctx, val.Address(), amtInt, true)
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	ctx = ctx.WithBlockHeight(2)
+	handler := NewEquivocationHandler(keeper)
+	equivocation := evidence.NewEquivocation(1, time.Unix(0, 0), amtInt, sdk.ConsAddress(val.Address()))
+	require.NoError(t, handler(ctx, equivocation))
+
+	// should be jailed, tombstoned and slashed
+	require.True(t, sk.Validator(ctx, operatorAddr).GetJailed())
+	require.True(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
@@ -24,6 +24,7 @@ var (
 	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
+	MissedBlockBitArrayWindowKey    = []byte{0x05} // Key for the window the missed block bit arrays are indexed with
 )
 
 // stored by *Tendermint* address (not operator address)
@@ -25,7 +25,7 @@ func NewMsgUnjail(validatorAddr sdk.ValAddress) MsgUnjail {
 func (msg MsgUnjail) Route() string { return RouterKey }
 func (msg MsgUnjail) Type() string  { return "unjail" }
 func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+	return []sdk.AccAddress{sdk.AccAddressFromValAddress(msg.ValidatorAddr)}
 }
 
 // get the bytes for the message signer to sign on
@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,7 +30,13 @@ var (
 
 // ParamTypeTable for slashing module
 func ParamTypeTable() params.TypeTable {
-	return params.NewTypeTable().RegisterParamSet(&Params{})
+	return params.NewTypeTable().RegisterParamSet(&Params{}).
+		RegisterValidator(KeyMaxEvidenceAge, validateMaxEvidenceAge).
+		RegisterValidator(KeySignedBlocksWindow, validateSignedBlocksWindow).
+		RegisterValidator(KeyMinSignedPerWindow, validateMinSignedPerWindow).
+		RegisterValidator(KeyDowntimeJailDuration, validateDowntimeJailDuration).
+		RegisterValidator(KeySlashFractionDoubleSign, validateSlashFractionDoubleSign).
+		RegisterValidator(KeySlashFractionDowntime, validateSlashFractionDowntime)
 }
 
 // Params - used for initializing default parameter for slashing at genesis
@@ -75,6 +82,75 @@ func DefaultParams() Params {
 	}
 }
 
+// Validate the slashing parameters, checking the same constraints as
+// parameter change proposals
+func (p Params) Validate() error {
+	if err := validateMaxEvidenceAge(p.MaxEvidenceAge); err != nil {
+		return err
+	}
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	return validateSlashFractionDowntime(p.SlashFractionDowntime)
+}
+
+func validateMaxEvidenceAge(i interface{}) error {
+	maxEvidence := i.(time.Duration)
+	if maxEvidence < 1*time.Minute {
+		return fmt.Errorf("Max evidence age must be at least 1 minute, is %s", maxEvidence.String())
+	}
+	return nil
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	signedWindow := i.(int64)
+	if signedWindow < 10 {
+		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	minSign := i.(sdk.Dec)
+	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
+		return fmt.Errorf("Min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
+	}
+	return nil
+}
+
+func validateDowntimeJailDuration(i interface{}) error {
+	downtimeJail := i.(time.Duration)
+	if downtimeJail < 1*time.Minute {
+		return fmt.Errorf("Downtime unbond duration must be at least 1 minute, is %s", downtimeJail.String())
+	}
+	return nil
+}
+
+func validateSlashFractionDoubleSign(i interface{}) error {
+	dblSign := i.(sdk.Dec)
+	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
+		return fmt.Errorf("Slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
+	}
+	return nil
+}
+
+func validateSlashFractionDowntime(i interface{}) error {
+	downtime := i.(sdk.Dec)
+	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
+		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
+	}
+	return nil
+}
+
 // MaxEvidenceAge - Max age for evidence - 21 days (3 weeks)
 // MaxEvidenceAge = 60 * 60 * 24 * 7 * 3
 func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
@@ -0,0 +1,25 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams(t *testing.T) {
+	ctx, _, _, paramstore, keeper := createTestInput(t, DefaultParams())
+
+	require.NoError(t, paramstore.Update(ctx, KeySignedBlocksWindow, []byte(`"1000"`)))
+	require.Equal(t, int64(1000), keeper.SignedBlocksWindow(ctx))
+	require.NoError(t, paramstore.Update(ctx, KeyDowntimeJailDuration, []byte(`"3600000000000"`)))
+	require.Equal(t, time.Hour, keeper.DowntimeJailDuration(ctx))
+
+	// values failing genesis validation are rejected as well
+	require.Error(t, paramstore.Update(ctx, KeySignedBlocksWindow, []byte(`"0"`)))
+	require.Error(t, paramstore.Update(ctx, KeyMinSignedPerWindow, []byte(`"1.500000000000000000"`)))
+	require.Error(t, paramstore.Update(ctx, KeySlashFractionDoubleSign, []byte(`"-0.100000000000000000"`)))
+	require.Error(t, paramstore.Update(ctx, KeyMaxEvidenceAge, []byte(`"1000000000"`)))
+	require.Equal(t, int64(1000), keeper.SignedBlocksWindow(ctx))
+	require.NoError(t, keeper.GetParams(ctx).Validate())
+}
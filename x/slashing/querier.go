@@ -9,7 +9,10 @@ import (
 
 // Query endpoints supported by the slashing querier
 const (
-	QueryParameters = "parameters"
+	QueryParameters   = "parameters"
+	QueryUnjail       = "unjail"
+	QuerySigningInfo  = "signingInfo"
+	QuerySigningInfos = "signingInfos"
 )
 
 // NewQuerier creates a new querier for slashing clients.
@@ -18,6 +21,12 @@ func NewQuerier(k Keeper, cdc *codec.Codec) sdk.Querier {
 		switch path[0] {
 		case QueryParameters:
 			return queryParams(ctx, cdc, k)
+		case QueryUnjail:
+			return queryUnjail(ctx, cdc, req, k)
+		case QuerySigningInfo:
+			return querySigningInfo(ctx, cdc, req, k)
+		case QuerySigningInfos:
+			return querySigningInfos(ctx, cdc, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -34,3 +43,119 @@ func queryParams(ctx sdk.Context, cdc *codec.Codec, k Keeper) ([]byte, sdk.Error
 
 	return res, nil
 }
+
+// defines the params for the following queries:
+// - 'custom/slashing/signingInfo'
+type QuerySigningInfoParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+func NewQuerySigningInfoParams(consAddr sdk.ConsAddress) QuerySigningInfoParams {
+	return QuerySigningInfoParams{
+		ConsAddress: consAddr,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/signingInfos'
+// A zero limit returns the signing infos of all the validators.
+type QuerySigningInfosParams struct {
+	Page  int
+	Limit int
+}
+
+func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
+	return QuerySigningInfosParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+func querySigningInfo(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySigningInfoParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	info, found := k.getValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoSigningInfoFound(k.codespace, params.ConsAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(cdc, info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySigningInfos(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySigningInfosParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if params.Page < 0 || params.Limit < 0 {
+		return nil, sdk.ErrUnknownRequest("page and limit cannot be negative")
+	}
+
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+	skip := (page - 1) * params.Limit
+
+	signingInfos := []SigningInfo{}
+	k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		signingInfos = append(signingInfos, SigningInfo{address, info})
+		return params.Limit != 0 && len(signingInfos) == params.Limit
+	})
+
+	res, err := codec.MarshalJSONIndent(cdc, signingInfos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+// defines the params for the following queries:
+// - 'custom/slashing/unjail'
+type QueryUnjailParams struct {
+	ValidatorAddr sdk.ValAddress
+}
+
+func NewQueryUnjailParams(validatorAddr sdk.ValAddress) QueryUnjailParams {
+	return QueryUnjailParams{
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+// checks whether a validator could be unjailed now, failing with the reason
+// it cannot otherwise, and returns its signing info
+func queryUnjail(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryUnjailParams
+	err := cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	consAddr, sdkErr := k.checkUnjail(ctx, params.ValidatorAddr)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	info, _ := k.getValidatorSigningInfo(ctx, consAddr)
+	res, err := codec.MarshalJSONIndent(cdc, info)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -2,11 +2,14 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestNewQuerier(t *testing.T) {
@@ -36,3 +39,103 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
 }
+
+func TestQueryUnjail(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	querier := NewQuerier(keeper, cdc)
+
+	amt := sdk.NewInt(100)
+	valAddr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(valAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: cdc.MustMarshalJSON(NewQueryUnjailParams(valAddr)),
+	}
+
+	// validator is not jailed
+	_, err := querier(ctx, []string{QueryUnjail}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeValidatorNotJailed, err.Code())
+
+	// validator is jailed for another hour
+	sk.Jail(ctx, consAddr)
+	info := NewValidatorSigningInfo(0, 0, ctx.BlockHeader().Time.Add(time.Hour), false, 0)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	_, err = querier(ctx, []string{QueryUnjail}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeValidatorJailed, err.Code())
+	require.Contains(t, err.Error(), "1h0m0s")
+
+	// jail period is over
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(time.Hour)})
+	res, err := querier(ctx, []string{QueryUnjail}, query)
+	require.Nil(t, err)
+
+	var queriedInfo ValidatorSigningInfo
+	require.NoError(t, cdc.UnmarshalJSON(res, &queriedInfo))
+	require.Equal(t, info.JailedUntil.UTC(), queriedInfo.JailedUntil.UTC())
+}
+
+func TestQuerySigningInfos(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	querier := NewQuerier(keeper, cdc)
+
+	for i := 0; i < 2; i++ {
+		info := NewValidatorSigningInfo(int64(i), 0, time.Unix(0, 0), false, int64(i))
+		keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[i]), info)
+	}
+
+	// per validator route
+	query := abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQuerySigningInfoParams(sdk.ConsAddress(addrs[1])))}
+	res, err := querier(ctx, []string{QuerySigningInfo}, query)
+	require.Nil(t, err)
+
+	var info ValidatorSigningInfo
+	require.NoError(t, cdc.UnmarshalJSON(res, &info))
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	query = abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQuerySigningInfoParams(sdk.ConsAddress(addrs[2])))}
+	_, err = querier(ctx, []string{QuerySigningInfo}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeMissingSigningInfo, err.Code())
+
+	querySigningInfosPage := func(page, limit int) ([]SigningInfo, sdk.Error) {
+		query := abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQuerySigningInfosParams(page, limit))}
+		res, err := querier(ctx, []string{QuerySigningInfos}, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var signingInfos []SigningInfo
+		require.NoError(t, cdc.UnmarshalJSON(res, &signingInfos))
+		return signingInfos, nil
+	}
+
+	signingInfos, err := querySigningInfosPage(0, 0)
+	require.Nil(t, err)
+	require.Len(t, signingInfos, 2)
+
+	signingInfos, err = querySigningInfosPage(1, 1)
+	require.Nil(t, err)
+	require.Len(t, signingInfos, 1)
+	firstPage := signingInfos
+
+	signingInfos, err = querySigningInfosPage(2, 1)
+	require.Nil(t, err)
+	require.Len(t, signingInfos, 1)
+	require.NotContains(t, firstPage, signingInfos[0])
+
+	signingInfos, err = querySigningInfosPage(3, 1)
+	require.Nil(t, err)
+	require.Empty(t, signingInfos)
+
+	_, err = querySigningInfosPage(-1, 2)
+	require.NotNil(t, err)
+}
@@ -42,6 +42,30 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(GetValidatorSigningInfoKey(address), bz)
 }
 
+// Whether a validator has been tombstoned for double signing
+// Stored by *validator* address (not operator address)
+func (k Keeper) IsTombstoned(ctx sdk.Context, address sdk.ConsAddress) bool {
+	info, found := k.getValidatorSigningInfo(ctx, address)
+	return found && info.Tombstoned
+}
+
+// Tombstone a validator, jailing it forever so it can never be unjailed.
+// A validator may only be tombstoned once.
+// Stored by *validator* address (not operator address)
+func (k Keeper) Tombstone(ctx sdk.Context, address sdk.ConsAddress) {
+	info, found := k.getValidatorSigningInfo(ctx, address)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", address))
+	}
+	if info.Tombstoned {
+		panic(fmt.Sprintf("Validator %s is already tombstoned", address))
+	}
+
+	info.Tombstoned = true
+	info.JailedUntil = DoubleSignJailEndTime
+	k.SetValidatorSigningInfo(ctx, address, info)
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) getValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -90,6 +114,100 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 	}
 }
 
+// ResetValidatorMissedBlocks forgets the blocks missed by the validator and
+// restarts its signing info at height zero, e.g. for a chain restarting from
+// an exported state
+func (k Keeper) ResetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddress) {
+	info, found := k.getValidatorSigningInfo(ctx, address)
+	if !found {
+		return
+	}
+	k.clearValidatorMissedBlockBitArray(ctx, address)
+	info.StartHeight = 0
+	info.IndexOffset = 0
+	info.MissedBlocksCounter = 0
+	k.SetValidatorSigningInfo(ctx, address, info)
+}
+
+// Get the signed blocks window the missed block bit arrays are indexed with
+func (k Keeper) getMissedBlockBitArrayWindow(ctx sdk.Context) (window int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(MissedBlockBitArrayWindowKey)
+	if bz == nil {
+		return 0, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &window)
+	return window, true
+}
+
+// Set the signed blocks window the missed block bit arrays are indexed with
+func (k Keeper) setMissedBlockBitArrayWindow(ctx sdk.Context, window int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(window)
+	store.Set(MissedBlockBitArrayWindowKey, bz)
+}
+
+// Rebase the missed block bit array of every validator once the signed blocks
+// window parameter has changed, as the arrays are indexed modulo the window
+func (k Keeper) resizeMissedBlockBitArrays(ctx sdk.Context) {
+	newWindow := k.SignedBlocksWindow(ctx)
+	oldWindow, found := k.getMissedBlockBitArrayWindow(ctx)
+	if found && oldWindow == newWindow {
+		return
+	}
+
+	if found {
+		var addresses []sdk.ConsAddress
+		var infos []ValidatorSigningInfo
+		k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
+			addresses = append(addresses, address)
+			infos = append(infos, info)
+			return false
+		})
+
+		for i, address := range addresses {
+			info := k.rebaseValidatorMissedBlockBitArray(ctx, address, infos[i], oldWindow, newWindow)
+			k.SetValidatorSigningInfo(ctx, address, info)
+		}
+	}
+
+	k.setMissedBlockBitArrayWindow(ctx, newWindow)
+}
+
+// Rebase the missed block bit array of a validator from the old window onto
+// the new one, keeping its most recent blocks which fit in both windows
+// Stored by *validator* address (not operator address)
+func (k Keeper) rebaseValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress,
+	info ValidatorSigningInfo, oldWindow, newWindow int64) ValidatorSigningInfo {
+
+	kept := info.IndexOffset
+	if kept > oldWindow {
+		kept = oldWindow
+	}
+	if kept > newWindow {
+		kept = newWindow
+	}
+
+	// read the kept blocks, oldest first
+	missed := make([]bool, kept)
+	for i := int64(0); i < kept; i++ {
+		offset := info.IndexOffset - kept + i
+		missed[i] = k.getValidatorMissedBlockBitArray(ctx, address, offset%oldWindow)
+	}
+
+	k.clearValidatorMissedBlockBitArray(ctx, address)
+	info.MissedBlocksCounter = 0
+	for i, m := range missed {
+		if m {
+			k.setValidatorMissedBlockBitArray(ctx, address, int64(i), true)
+			info.MissedBlocksCounter++
+		}
+	}
+	info.IndexOffset = kept
+
+	return info
+}
+
 // Construct a new `ValidatorSigningInfo` struct
 func NewValidatorSigningInfo(startHeight int64, indexOffset int64, jailedUntil time.Time, tombstoned bool, missedBlocksCounter int64) ValidatorSigningInfo {
 	return ValidatorSigningInfo{
@@ -110,8 +228,14 @@ type ValidatorSigningInfo struct {
 	MissedBlocksCounter int64     `json:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
 }
 
+// Signing info of a validator along with its consensus address
+type SigningInfo struct {
+	Address              sdk.ConsAddress      `json:"address"`
+	ValidatorSigningInfo ValidatorSigningInfo `json:"validator_signing_info"`
+}
+
 // Return human readable signing info
 func (i ValidatorSigningInfo) HumanReadableString() string {
-	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, missed blocks counter: %d",
-		i.StartHeight, i.IndexOffset, i.JailedUntil, i.MissedBlocksCounter)
+	return fmt.Sprintf("Start height: %d, index offset: %d, jailed until: %v, tombstoned: %t, missed blocks counter: %d",
+		i.StartHeight, i.IndexOffset, i.JailedUntil, i.Tombstoned, i.MissedBlocksCounter)
 }
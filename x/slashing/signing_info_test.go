@@ -36,3 +36,64 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = keeper.getValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrs[0]), 0)
 	require.True(t, missed) // now should be missed
 }
+
+func TestTombstoned(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
+	require.Panics(t, func() { keeper.Tombstone(ctx, sdk.ConsAddress(addrs[0])) })
+	require.False(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(addrs[0])))
+
+	newInfo := NewValidatorSigningInfo(int64(4), int64(3), time.Unix(0, 0), false, int64(10))
+	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]), newInfo)
+	require.False(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(addrs[0])))
+
+	keeper.Tombstone(ctx, sdk.ConsAddress(addrs[0]))
+	require.True(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(addrs[0])))
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]))
+	require.True(t, found)
+	require.Equal(t, DoubleSignJailEndTime.UTC(), info.JailedUntil)
+
+	// a validator can only be tombstoned once
+	require.Panics(t, func() { keeper.Tombstone(ctx, sdk.ConsAddress(addrs[0])) })
+}
+
+func TestResizeMissedBlockBitArrays(t *testing.T) {
+	ctx, _, _, paramstore, keeper := createTestInput(t, DefaultParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	require.Equal(t, int64(100), keeper.SignedBlocksWindow(ctx))
+
+	// 120 blocks tracked in the window of 100, the validator missed the
+	// blocks at offsets 50 and 110 to 119
+	info := NewValidatorSigningInfo(0, 120, time.Unix(0, 0), false, 11)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 50, true)
+	for offset := int64(110); offset < 120; offset++ {
+		keeper.setValidatorMissedBlockBitArray(ctx, consAddr, offset%100, true)
+	}
+
+	// unchanged window
+	keeper.resizeMissedBlockBitArrays(ctx)
+	info, _ = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(120), info.IndexOffset)
+	require.Equal(t, int64(11), info.MissedBlocksCounter)
+
+	// shrinking the window keeps the 20 most recent blocks
+	paramstore.Set(ctx, KeySignedBlocksWindow, int64(20))
+	keeper.resizeMissedBlockBitArrays(ctx)
+	info, _ = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(20), info.IndexOffset)
+	require.Equal(t, int64(10), info.MissedBlocksCounter)
+	for index := int64(0); index < 100; index++ {
+		missed := keeper.getValidatorMissedBlockBitArray(ctx, consAddr, index)
+		require.Equal(t, index >= 10 && index < 20, missed, "index %d", index)
+	}
+
+	// growing the window keeps all the tracked blocks
+	paramstore.Set(ctx, KeySignedBlocksWindow, int64(50))
+	keeper.resizeMissedBlockBitArrays(ctx)
+	info, _ = keeper.getValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(20), info.IndexOffset)
+	require.Equal(t, int64(10), info.MissedBlocksCounter)
+	window, found := keeper.getMissedBlockBitArrayWindow(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(50), window)
+}
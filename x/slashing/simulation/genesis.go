@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+// RandomizedParams returns random parameters of the slashing module, evidence
+// being kept for the given maximum age
+func RandomizedParams(r *rand.Rand, maxEvidenceAge time.Duration) slashing.Params {
+	return slashing.Params{
+		MaxEvidenceAge:          maxEvidenceAge,
+		SignedBlocksWindow:      int64(simulation.RandIntBetween(r, 10, 1000)),
+		MinSignedPerWindow:      sdk.NewDecWithPrec(int64(r.Intn(10)), 1),
+		DowntimeJailDuration:    time.Duration(simulation.RandIntBetween(r, 60, 60*60*24)) * time.Second,
+		SlashFractionDoubleSign: sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(50) + 1))),
+		SlashFractionDowntime:   sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1))),
+	}
+}
+
+// RandomizedGenState generates a random genesis state of the slashing module,
+// keeping evidence for the unbonding time of the simulation
+func RandomizedGenState(simState *simulation.GenesisState) {
+	slashingGenesis := slashing.GenesisState{
+		Params: RandomizedParams(simState.Rand, simState.UnbondTime),
+	}
+	fmt.Printf("Selected randomly generated slashing parameters:\n\t%+v\n", slashingGenesis)
+
+	simState.SetGenesis(slashing.ModuleName, slashingGenesis)
+}
@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// slashing module
+type AppModule struct {
+	keeper slashing.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper slashing.Keeper) AppModule {
+	return AppModule{keeper: keeper}
+}
+
+// module name
+func (AppModule) Name() string {
+	return slashing.ModuleName
+}
+
+// randomized genesis state, to be generated after the one of the staking
+// module which sets the unbonding time
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 100, Op: SimulateMsgUnjail(am.keeper)},
+	}
+}
@@ -14,7 +14,7 @@ import (
 func SimulateMsgUnjail(k slashing.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, event func(string)) (action string, fOp []simulation.FutureOperation, err error) {
 		acc := simulation.RandomAcc(r, accs)
-		address := sdk.ValAddress(acc.Address)
+		address := sdk.ValAddressFromAccAddress(acc.Address)
 		msg := slashing.NewMsgUnjail(address)
 		if msg.ValidateBasic() != nil {
 			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,8 +14,9 @@ import (
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// getMockApp returns an initialized mock application for this module.
-func getMockApp(t *testing.T) (*mock.App, Keeper) {
+// getMockApp returns a mock application running the staking module, with the
+// given genesis accounts.
+func getMockApp(t *testing.T, accs []auth.Account) (*mock.App, Keeper) {
 	mApp := mock.NewApp()
 
 	RegisterCodec(mApp.Cdc)
@@ -26,43 +28,17 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 	keeper := NewKeeper(mApp.Cdc, keyStaking, tkeyStaking, bankKeeper, mApp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
 
 	mApp.Router().AddRoute(RouterKey, NewHandler(keeper))
-	mApp.SetEndBlocker(getEndBlocker(keeper))
-	mApp.SetInitChainer(getInitChainer(mApp, keeper))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, tkeyStaking))
-	return mApp, keeper
-}
-
-// getEndBlocker returns a staking endblocker.
-func getEndBlocker(keeper Keeper) sdk.EndBlocker {
-	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-		validatorUpdates, tags := EndBlocker(ctx, keeper)
-
-		return abci.ResponseEndBlock{
-			ValidatorUpdates: validatorUpdates,
-			Tags:             tags,
-		}
-	}
-}
+	stakingGenesis := DefaultGenesisState()
+	stakingGenesis.Pool.LooseTokens = sdk.NewInt(100000)
 
-// getInitChainer initializes the chainer of the mock app and sets the genesis
-// state. It returns an empty ResponseInitChain.
-func getInitChainer(mapp *mock.App, keeper Keeper) sdk.InitChainer {
-	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
-		mapp.InitChainer(ctx, req)
-
-		stakingGenesis := DefaultGenesisState()
-		stakingGenesis.Pool.LooseTokens = sdk.NewInt(100000)
-
-		validators, err := InitGenesis(ctx, keeper, stakingGenesis)
-		if err != nil {
-			panic(err)
-		}
-
-		return abci.ResponseInitChain{
-			Validators: validators,
-		}
-	}
+	tApp := mock.NewTestApp(t, mApp, mock.TestAppConfig{
+		Modules:         []sdk.AppModule{NewAppModule(mApp.Cdc, keeper)},
+		StoreKeys:       []sdk.StoreKey{keyStaking, tkeyStaking},
+		Genesis:         map[string]json.RawMessage{ModuleName: mApp.Cdc.MustMarshalJSON(stakingGenesis)},
+		GenesisAccounts: accs,
+	})
+	return tApp.App, keeper
 }
 
 //__________________________________________________________________________________________
@@ -95,7 +71,6 @@ func checkDelegation(
 }
 
 func TestStakingMsgs(t *testing.T) {
-	mApp, keeper := getMockApp(t)
 
 	genCoin := sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 42)
 	bondCoin := sdk.NewInt64Coin(stakingTypes.DefaultBondDenom, 10)
@@ -110,7 +85,7 @@ func TestStakingMsgs(t *testing.T) {
 	}
 	accs := []auth.Account{acc1, acc2}
 
-	mock.SetGenesis(mApp, accs)
+	mApp, keeper := getMockApp(t, accs)
 	mock.CheckBalance(t, mApp, addr1, sdk.Coins{genCoin})
 	mock.CheckBalance(t, mApp, addr2, sdk.Coins{genCoin})
 
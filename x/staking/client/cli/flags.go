@@ -16,6 +16,8 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesFraction      = "shares-fraction"
+	FlagMax                 = "max"
+	FlagCreationHeight      = "creation-height"
 
 	FlagMoniker  = "moniker"
 	FlagIdentity = "identity"
@@ -26,6 +28,10 @@ const (
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
+	FlagPage   = "page"
+	FlagLimit  = "limit"
+	FlagStatus = "status"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -36,6 +42,8 @@ var (
 	FsPk                = flag.NewFlagSet("", flag.ContinueOnError)
 	FsAmount            = flag.NewFlagSet("", flag.ContinueOnError)
 	fsShares            = flag.NewFlagSet("", flag.ContinueOnError)
+	fsUnbond            = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCancelUnbond      = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionCreate = flag.NewFlagSet("", flag.ContinueOnError)
 	FsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
 	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
@@ -43,6 +51,8 @@ var (
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
+	fsPagination        = flag.NewFlagSet("", flag.ContinueOnError)
+	fsStatus            = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -50,6 +60,10 @@ func init() {
 	FsAmount.String(FlagAmount, "", "Amount of coins to bond")
 	fsShares.String(FlagSharesAmount, "", "Amount of source-shares to either unbond or redelegate as a positive integer or decimal")
 	fsShares.String(FlagSharesFraction, "", "Fraction of source-shares to either unbond or redelegate as a positive integer or decimal >0 and <=1")
+	fsUnbond.String(FlagAmount, "", "Amount of coins to unbond, converted to source-shares")
+	fsUnbond.Bool(FlagMax, false, "Unbond the entire delegation")
+	fsCancelUnbond.String(FlagAmount, "", "Amount of unbonding coins to bond back to the validator")
+	fsCancelUnbond.Int64(FlagCreationHeight, 0, "Height at which the unbonding delegation entry was created")
 	fsDescriptionCreate.String(FlagMoniker, "", "The validator's name")
 	fsDescriptionCreate.String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionCreate.String(FlagWebsite, "", "The validator's (optional) website")
@@ -66,4 +80,7 @@ func init() {
 	fsDelegator.String(FlagAddressDelegator, "", "The Bech32 address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "The Bech32 address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "The Bech32 address of the destination validator")
+	fsPagination.Int(FlagPage, 0, "Query a specific page of paginated results (requires --limit)")
+	fsPagination.Int(FlagLimit, 0, "Maximum number of results per page (0 returns all the results)")
+	fsStatus.String(FlagStatus, "", "Filter by validator status (bonded, unbonding or unbonded)")
 }
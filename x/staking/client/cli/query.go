@@ -70,19 +70,25 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 		Use:   "validators",
 		Short: "Query for all validators",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			key := staking.ValidatorsKey
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params := staking.NewQueryValidatorsParams(
+				viper.GetInt(FlagPage), viper.GetInt(FlagLimit), viper.GetString(FlagStatus),
+			)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, staking.QueryValidators), bz)
 			if err != nil {
 				return err
 			}
 
 			// parse out the validators
 			var validators []staking.Validator
-			for _, kv := range resKVs {
-				validator := types.MustUnmarshalValidator(cdc, kv.Value)
-				validators = append(validators, validator)
+			if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+				return err
 			}
 
 			switch viper.Get(cli.OutputFlag) {
@@ -110,6 +116,9 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(fsPagination)
+	cmd.Flags().AddFlagSet(fsStatus)
+
 	return cmd
 }
 
@@ -254,19 +263,26 @@ func GetCmdQueryDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			key := staking.GetDelegationsKey(delegatorAddr)
+			params := staking.NewQueryDelegatorParams(delegatorAddr)
+			params.Page, params.Limit = viper.GetInt(FlagPage), viper.GetInt(FlagLimit)
+			params.Status = viper.GetString(FlagStatus)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, err := cliCtx.QuerySubspace(key, storeName)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, staking.QueryDelegatorDelegations), bz)
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
+			// parse out the delegations
 			var delegations []staking.Delegation
-			for _, kv := range resKVs {
-				delegation := types.MustUnmarshalDelegation(cdc, kv.Value)
-				delegations = append(delegations, delegation)
+			if err := cdc.UnmarshalJSON(res, &delegations); err != nil {
+				return err
 			}
 
 			output, err := codec.MarshalJSONIndent(cdc, delegations)
@@ -281,6 +297,9 @@ func GetCmdQueryDelegations(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(fsPagination)
+	cmd.Flags().AddFlagSet(fsStatus)
+
 	return cmd
 }
 
@@ -298,6 +317,7 @@ func GetCmdQueryValidatorDelegations(storeKey string, cdc *codec.Codec) *cobra.C
 			}
 
 			params := staking.NewQueryValidatorParams(validatorAddr)
+			params.Page, params.Limit = viper.GetInt(FlagPage), viper.GetInt(FlagLimit)
 
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
@@ -316,6 +336,8 @@ func GetCmdQueryValidatorDelegations(storeKey string, cdc *codec.Codec) *cobra.C
 		},
 	}
 
+	cmd.Flags().AddFlagSet(fsPagination)
+
 	return cmd
 }
 
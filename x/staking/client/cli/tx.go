@@ -213,7 +213,7 @@ func GetCmdRedelegate(storeName string, cdc *codec.Codec) *cobra.Command {
 func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unbond",
-		Short: "unbond shares from a validator",
+		Short: "unbond shares, an amount of coins or the entire delegation from a validator",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
 			cliCtx := context.NewCLIContext().
@@ -230,19 +230,11 @@ func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			// get the shares amount
-			sharesAmountStr := viper.GetString(FlagSharesAmount)
-			sharesFractionStr := viper.GetString(FlagSharesFraction)
-			sharesAmount, err := getShares(
-				storeName, cdc, sharesAmountStr, sharesFractionStr,
-				delAddr, valAddr,
-			)
+			msg, err := buildUndelegateMsg(storeName, cdc, delAddr, valAddr)
 			if err != nil {
 				return err
 			}
 
-			msg := staking.NewMsgUndelegate(delAddr, valAddr, sharesAmount)
-
 			if cliCtx.GenerateOnly {
 				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
 			}
@@ -252,6 +244,50 @@ func GetCmdUnbond(storeName string, cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsShares)
+	cmd.Flags().AddFlagSet(fsUnbond)
+	cmd.Flags().AddFlagSet(fsValidator)
+
+	return cmd
+}
+
+// GetCmdCancelUnbond implements the cancel unbonding delegation command.
+func GetCmdCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unbond",
+		Short: "cancel an unbonding delegation entry and bond its coins back to the validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			delAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			creationHeight := viper.GetInt64(FlagCreationHeight)
+			msg := staking.NewMsgCancelUnbondingDelegation(delAddr, valAddr, amount, creationHeight)
+
+			if cliCtx.GenerateOnly {
+				return utils.PrintUnsignedStdTx(os.Stdout, txBldr, cliCtx, []sdk.Msg{msg}, false)
+			}
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.CompleteAndBroadcastTxCli(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsCancelUnbond)
 	cmd.Flags().AddFlagSet(fsValidator)
 
 	return cmd
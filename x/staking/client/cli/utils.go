@@ -2,6 +2,7 @@ package cli
 
 import (
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -63,6 +64,46 @@ func getShares(
 	return
 }
 
+// buildUndelegateMsg creates an undelegation message from either the --max,
+// the --amount or the shares flags, of which only one may be given.
+func buildUndelegateMsg(
+	storeName string, cdc *codec.Codec, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (msg staking.MsgUndelegate, err error) {
+
+	sharesAmountStr := viper.GetString(FlagSharesAmount)
+	sharesFractionStr := viper.GetString(FlagSharesFraction)
+	amountStr := viper.GetString(FlagAmount)
+	sharesGiven := sharesAmountStr != "" || sharesFractionStr != ""
+
+	switch {
+	case viper.GetBool(FlagMax):
+		if sharesGiven || amountStr != "" {
+			return msg, errors.Errorf("cannot specify an amount or shares when unbonding the entire delegation")
+		}
+		return staking.NewMsgUndelegateMax(delAddr, valAddr), nil
+
+	case amountStr != "":
+		if sharesGiven {
+			return msg, errors.Errorf("can either specify the amount of coins OR of the shares, not both")
+		}
+		amount, err := sdk.ParseCoin(amountStr)
+		if err != nil {
+			return msg, err
+		}
+		return staking.NewMsgUndelegateAmount(delAddr, valAddr, amount), nil
+
+	default:
+		sharesAmount, err := getShares(
+			storeName, cdc, sharesAmountStr, sharesFractionStr,
+			delAddr, valAddr,
+		)
+		if err != nil {
+			return msg, err
+		}
+		return staking.NewMsgUndelegate(delAddr, valAddr, sharesAmount), nil
+	}
+}
+
 func buildCommissionMsg(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionMsg, err error) {
 	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
 		return commission, errors.Errorf("must specify all validator commission parameters")
@@ -105,12 +105,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 
 // HTTP request handler to query a delegator delegations
 func delegatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorDelegations")
+	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorDelegations", true)
 }
 
 // HTTP request handler to query a delegator unbonding delegations
 func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorUnbondingDelegations")
+	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorUnbondingDelegations", false)
 }
 
 // HTTP request handler to query all staking txs (msgs) from a delegator
@@ -192,6 +192,11 @@ func unbondingDelegationHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) h
 // HTTP request handler to query redelegations
 func redelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		var params staking.QueryRedelegationParams
 
 		bechDelegatorAddr := r.URL.Query().Get("delegator")
@@ -231,12 +236,14 @@ func redelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Ha
 			return
 		}
 
-		res, err := cliCtx.QueryWithData("custom/staking/redelegations", bz)
+		res, height, err := cliCtx.QueryWithHeight("custom/staking/redelegations", bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
 
@@ -247,7 +254,7 @@ func delegationHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handl
 
 // HTTP request handler to query all delegator bonded validators
 func delegatorValidatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorValidators")
+	return queryDelegator(cliCtx, cdc, "custom/staking/delegatorValidators", false)
 }
 
 // HTTP request handler to get information from a currently bonded validator
@@ -258,12 +265,32 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) ht
 // HTTP request handler to query list of validators
 func validatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, err := cliCtx.QueryWithData("custom/staking/validators", nil)
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		pagination, page, ok := utils.ParseQuerierPaginationOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		params := staking.NewQueryValidatorsParams(page, pagination.Limit, r.URL.Query().Get("status"))
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithHeight("custom/staking/validators", bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQuerierPageResponse(w, cliCtx, pagination, res)
 	}
 }
 
@@ -274,34 +301,48 @@ func validatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryValidator(cliCtx, cdc, "custom/staking/validatorDelegations")
+	return queryValidatorList(cliCtx, cdc, "custom/staking/validatorDelegations", true)
 }
 
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryValidator(cliCtx, cdc, "custom/staking/validatorUnbondingDelegations")
+	return queryValidatorList(cliCtx, cdc, "custom/staking/validatorUnbondingDelegations", false)
 }
 
 // HTTP request handler to query the pool information
 func poolHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, err := cliCtx.QueryWithData("custom/staking/pool", nil)
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithHeight("custom/staking/pool", nil)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
 
 // HTTP request handler to query the staking params values
 func paramsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, err := cliCtx.QueryWithData("custom/staking/parameters", nil)
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithHeight("custom/staking/parameters", nil)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
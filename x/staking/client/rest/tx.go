@@ -27,6 +27,10 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cdc, kb, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -50,6 +54,16 @@ type (
 		DelegatorAddr sdk.AccAddress `json:"delegator_addr"` // in bech32
 		ValidatorAddr sdk.ValAddress `json:"validator_addr"` // in bech32
 		SharesAmount  sdk.Dec        `json:"shares"`
+		Amount        sdk.Coin       `json:"amount"`
+		Max           bool           `json:"max"`
+	}
+
+	msgCancelUnbondingDelegationInput struct {
+		BaseReq        utils.BaseReq  `json:"base_req"`
+		DelegatorAddr  sdk.AccAddress `json:"delegator_addr"` // in bech32
+		ValidatorAddr  sdk.ValAddress `json:"validator_addr"` // in bech32
+		Amount         sdk.Coin       `json:"amount"`
+		CreationHeight int64          `json:"creation_height"`
 	}
 )
 
@@ -153,7 +167,44 @@ func postUnbondingDelegationsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx
 			return
 		}
 
-		msg := staking.NewMsgUndelegate(req.DelegatorAddr, req.ValidatorAddr, req.SharesAmount)
+		msg := buildUndelegateMsg(req)
+		err = msg.ValidateBasic()
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, req.BaseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+func postCancelUnbondingDelegationHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgCancelUnbondingDelegationInput
+
+		err := utils.ReadRESTReq(w, r, cdc, &req)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		info, err := kb.Get(req.BaseReq.Name)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		if !bytes.Equal(info.GetPubKey().Address(), req.DelegatorAddr) {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, "Must use own delegator address")
+			return
+		}
+
+		msg := staking.NewMsgCancelUnbondingDelegation(req.DelegatorAddr, req.ValidatorAddr, req.Amount, req.CreationHeight)
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -163,3 +214,17 @@ func postUnbondingDelegationsHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx
 		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, req.BaseReq, []sdk.Msg{msg}, cdc)
 	}
 }
+
+// buildUndelegateMsg creates the undelegation message for the given request,
+// which may unbond a number of shares, a token amount or the whole delegation.
+func buildUndelegateMsg(req msgUndelegateInput) staking.MsgUndelegate {
+	msg := staking.NewMsgUndelegate(req.DelegatorAddr, req.ValidatorAddr, sdk.ZeroDec())
+	if !req.SharesAmount.IsNil() {
+		msg.SharesAmount = req.SharesAmount
+	}
+	if !req.Amount.Amount.IsNil() {
+		msg.Amount = req.Amount
+	}
+	msg.Max = req.Max
+	return msg
+}
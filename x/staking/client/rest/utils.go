@@ -52,6 +52,11 @@ func queryTxs(node rpcclient.Client, cliCtx context.CLIContext, cdc *codec.Codec
 
 func queryRedelegations(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32delegator := vars["delegatorAddr"]
 		bech32srcValidator := vars["srcValidatorAddr"]
@@ -85,17 +90,24 @@ func queryRedelegations(cliCtx context.CLIContext, cdc *codec.Codec, endpoint st
 			return
 		}
 
-		res, err := cliCtx.QueryWithData(endpoint, bz)
+		res, height, err := cliCtx.QueryWithHeight(endpoint, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
 
 func queryBonds(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32delegator := vars["delegatorAddr"]
 		bech32validator := vars["validatorAddr"]
@@ -115,17 +127,27 @@ func queryBonds(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) ht
 			return
 		}
 
-		res, err := cliCtx.QueryWithData(endpoint, bz)
+		res, height, err := cliCtx.QueryWithHeight(endpoint, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
 	}
 }
 
-func queryDelegator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+// queryDelegator returns a handler listing the results of a query about a
+// delegator. They are paginated by the querier of the endpoint if paginated is
+// true, by the REST server otherwise.
+func queryDelegator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, paginated bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32delegator := vars["delegatorAddr"]
 
@@ -135,7 +157,16 @@ func queryDelegator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 			return
 		}
 
+		pagination, page, ok := parsePagination(w, r, paginated)
+		if !ok {
+			return
+		}
+
 		params := staking.NewQueryDelegatorParams(delegatorAddr)
+		params.Status = r.URL.Query().Get("status")
+		if paginated {
+			params.Page, params.Limit = page, pagination.Limit
+		}
 
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
@@ -143,17 +174,24 @@ func queryDelegator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 			return
 		}
 
-		res, err := cliCtx.QueryWithData(endpoint, bz)
+		res, height, err := cliCtx.QueryWithHeight(endpoint, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		postProcessPage(w, cliCtx, pagination, res, paginated)
 	}
 }
 
 func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 		bech32validatorAddr := vars["validatorAddr"]
 
@@ -171,11 +209,79 @@ func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string
 			return
 		}
 
-		res, err := cliCtx.QueryWithData(endpoint, bz)
+		res, height, err := cliCtx.QueryWithHeight(endpoint, bz)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+		cliCtx = cliCtx.WithHeight(height)
+
+		utils.PostProcessQueryResponse(w, cliCtx, res)
+	}
+}
+
+// queryValidatorList returns a handler listing the results of a query about a
+// validator. They are paginated by the querier of the endpoint if paginated is
+// true, by the REST server otherwise.
+func queryValidatorList(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string, paginated bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := utils.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		bech32validatorAddr := vars["validatorAddr"]
+
+		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pagination, page, ok := parsePagination(w, r, paginated)
+		if !ok {
+			return
+		}
+
+		params := staking.NewQueryValidatorParams(validatorAddr)
+		if paginated {
+			params.Page, params.Limit = page, pagination.Limit
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithHeight(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		postProcessPage(w, cliCtx, pagination, res, paginated)
+	}
+}
+
+// parsePagination parses the pagination query parameters of a request, along
+// with the page to query if the querier paginates the results
+func parsePagination(w http.ResponseWriter, r *http.Request, paginated bool) (p utils.Pagination, page int, ok bool) {
+	if paginated {
+		return utils.ParseQuerierPaginationOrReturnBadRequest(w, r)
+	}
+	p, ok = utils.ParsePaginationOrReturnBadRequest(w, r)
+	return p, 0, ok
+}
+
+// postProcessPage writes the page of the results of a query, as returned by
+// the querier if it paginates them
+func postProcessPage(w http.ResponseWriter, cliCtx context.CLIContext, p utils.Pagination, res []byte, paginated bool) {
+	if paginated {
+		utils.PostProcessQuerierPageResponse(w, cliCtx, p, res)
+		return
 	}
+	utils.PostProcessPaginatedQueryResponse(w, cliCtx, p, res)
 }
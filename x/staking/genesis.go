@@ -34,7 +34,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 		// Manually set indices for the first time
 		keeper.SetValidatorByConsAddr(ctx, validator)
 		keeper.SetValidatorByPowerIndex(ctx, validator)
-		keeper.AfterValidatorCreated(ctx, validator.OperatorAddr)
+
+		// Call the creation hook if not exported, the state of the hooked
+		// modules being imported from their own genesis
+		if !data.Exported {
+			keeper.AfterValidatorCreated(ctx, validator.OperatorAddr)
+		}
 
 		// Set timeslice if necessary
 		if validator.Status == sdk.Unbonding {
@@ -43,9 +48,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 	}
 
 	for _, delegation := range data.Bonds {
-		keeper.BeforeDelegationCreated(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		// Call the delegation hooks if not exported
+		if !data.Exported {
+			keeper.BeforeDelegationCreated(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		}
 		keeper.SetDelegation(ctx, delegation)
-		keeper.AfterDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		if !data.Exported {
+			keeper.AfterDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		}
 	}
 
 	for _, ubd := range data.UnbondingDelegations {
@@ -70,7 +80,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 			if !found {
 				panic("expected validator, not found")
 			}
-			update := validator.ABCIValidatorUpdate()
+			update := validator.ABCIValidatorUpdate(data.Params.PowerReduction)
 			update.Power = lv.Power.Int64() // keep the next-val-set offset, use the last power for the first block
 			res = append(res, update)
 		}
@@ -124,7 +134,7 @@ func WriteValidators(ctx sdk.Context, keeper Keeper) (vals []tmtypes.GenesisVali
 	keeper.IterateLastValidators(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
 		vals = append(vals, tmtypes.GenesisValidator{
 			PubKey: validator.GetConsPubKey(),
-			Power:  validator.GetPower().Int64(),
+			Power:  keeper.TokensToConsensusPower(ctx, validator.GetPower()),
 			Name:   validator.GetMoniker(),
 		})
 
@@ -150,10 +160,7 @@ func ValidateGenesis(data types.GenesisState) error {
 }
 
 func validateParams(params types.Params) error {
-	if params.BondDenom == "" {
-		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
-	}
-	return nil
+	return params.Validate()
 }
 
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
@@ -61,7 +61,7 @@ func TestInitGenesis(t *testing.T) {
 
 	abcivals := make([]abci.ValidatorUpdate, len(vals))
 	for i, val := range validators {
-		abcivals[i] = val.ABCIValidatorUpdate()
+		abcivals[i] = val.ABCIValidatorUpdate(keeper.PowerReduction(ctx))
 	}
 
 	require.Equal(t, abcivals, vals)
@@ -100,7 +100,7 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 
 	abcivals := make([]abci.ValidatorUpdate, 100)
 	for i, val := range validators[:100] {
-		abcivals[i] = val.ABCIValidatorUpdate()
+		abcivals[i] = val.ABCIValidatorUpdate(keeper.PowerReduction(ctx))
 	}
 
 	require.Equal(t, abcivals, vals)
@@ -133,6 +133,10 @@ func TestValidateGenesis(t *testing.T) {
 			(*data).Validators[0].Jailed = true
 			(*data).Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate params
+		{"zero power reduction", func(data *types.GenesisState) {
+			(*data).Params.PowerReduction = sdk.ZeroInt()
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -28,6 +28,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginRedelegate(ctx, msg, k)
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("invalid message parse in staking module").Result()
 		}
@@ -217,7 +219,12 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 }
 
 func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keeper) sdk.Result {
-	completionTime, err := k.Undelegate(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount)
+	shares, err := getUndelegateShares(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	completionTime, err := k.Undelegate(ctx, msg.DelegatorAddr, msg.ValidatorAddr, shares)
 	if err != nil {
 		return err.Result()
 	}
@@ -232,6 +239,42 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 	return sdk.Result{Data: finishTime, Tags: tags}
 }
 
+func handleMsgCancelUnbondingDelegation(ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper) sdk.Result {
+	err := k.CancelUnbondingDelegation(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.CreationHeight, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
+	)
+
+	return sdk.Result{Tags: tags}
+}
+
+// getUndelegateShares resolves the delegator shares unbonded by the message,
+// whether it was given as shares, as a token amount or as the whole delegation.
+func getUndelegateShares(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keeper) (sdk.Dec, sdk.Error) {
+	switch {
+	case msg.Max:
+		delegation, found := k.GetDelegation(ctx, msg.DelegatorAddr, msg.ValidatorAddr)
+		if !found {
+			return sdk.ZeroDec(), types.ErrNoDelegatorForAddress(k.Codespace())
+		}
+		return delegation.Shares, nil
+
+	case !msg.SharesAmount.IsNil() && msg.SharesAmount.IsPositive():
+		return msg.SharesAmount, nil
+
+	default:
+		if msg.Amount.Denom != k.GetParams(ctx).BondDenom {
+			return sdk.ZeroDec(), types.ErrBadDenom(k.Codespace())
+		}
+		return k.ValidateUnbondAmount(ctx, msg.DelegatorAddr, msg.ValidatorAddr, msg.Amount.Amount)
+	}
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
 	completionTime, err := k.BeginRedelegation(ctx, msg.DelegatorAddr, msg.ValidatorSrcAddr,
 		msg.ValidatorDstAddr, msg.SharesAmount)
@@ -571,6 +571,93 @@ func TestMultipleMsgDelegate(t *testing.T) {
 	}
 }
 
+func TestUndelegateAmountAndMax(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	_ = setInstantUnbondPeriod(keeper, ctx)
+	bondDenom := keeper.BondDenom(ctx)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, 10)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+	// unbond an amount of tokens
+	msgUndelegate := NewMsgUndelegateAmount(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 4))
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	bond, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), bond.Shares)
+
+	// cannot unbond more tokens than delegated or in another denomination
+	msgUndelegate = NewMsgUndelegateAmount(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 7))
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.False(t, got.IsOK(), "expected msg to fail")
+	msgUndelegate = NewMsgUndelegateAmount(delegatorAddr, validatorAddr, sdk.NewInt64Coin("foocoin", 1))
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.False(t, got.IsOK(), "expected msg to fail")
+
+	// unbond the rest of the delegation
+	msgUndelegate = NewMsgUndelegateMax(delegatorAddr, validatorAddr)
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	_, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+}
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	bondDenom := keeper.BondDenom(ctx)
+	ctx = ctx.WithBlockHeight(5)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, 10)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	msgUndelegate := NewMsgUndelegate(delegatorAddr, validatorAddr, sdk.NewDec(6))
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+
+	// partially cancel the unbonding
+	msgCancel := NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 2), 5)
+	got = handleMsgCancelUnbondingDelegation(ctx, msgCancel, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	bond, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), bond.Shares)
+	ubd, found := keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, int64(4), ubd.Entries[0].Balance.Amount.Int64())
+
+	// cannot cancel more than the entry balance or a non existing entry
+	msgCancel = NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 5), 5)
+	got = handleMsgCancelUnbondingDelegation(ctx, msgCancel, keeper)
+	require.False(t, got.IsOK(), "expected msg to fail")
+	msgCancel = NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 4), 4)
+	got = handleMsgCancelUnbondingDelegation(ctx, msgCancel, keeper)
+	require.False(t, got.IsOK(), "expected msg to fail")
+
+	// cancel the rest of the unbonding
+	msgCancel = NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewInt64Coin(bondDenom, 4), 5)
+	got = handleMsgCancelUnbondingDelegation(ctx, msgCancel, keeper)
+	require.True(t, got.IsOK(), "expected msg to be ok, got %v", got)
+	bond, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), bond.Shares)
+	_, found = keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+}
+
 func TestJailValidator(t *testing.T) {
 	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
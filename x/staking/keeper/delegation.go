@@ -39,17 +39,47 @@ func (k Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegati
 
 // return all delegations to a specific validator. Useful for querier.
 func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) {
+	k.IterateValidatorDelegations(ctx, valAddr, func(_ int64, delegation types.Delegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	return delegations
+}
+
+// iterate through all of the delegations to a validator, using the
+// by-validator index
+func (k Keeper) IterateValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress,
+	fn func(index int64, delegation types.Delegation) (stop bool)) {
+
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, DelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetDelegationsByValIndexKey(valAddr))
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		key := GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegation := types.MustUnmarshalDelegation(k.cdc, store.Get(key))
+		if stop := fn(i, delegation); stop {
+			break
+		}
+		i++
+	}
+}
+
+// iterate through all of the delegations from a delegator
+func (k Keeper) IterateDelegatorDelegations(ctx sdk.Context, delAddr sdk.AccAddress,
+	fn func(index int64, delegation types.Delegation) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetDelegationsKey(delAddr))
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
 		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
-		if delegation.GetValidatorAddr().Equals(valAddr) {
-			delegations = append(delegations, delegation)
+		if stop := fn(i, delegation); stop {
+			break
 		}
+		i++
 	}
-	return delegations
 }
 
 // return a given amount of all the delegations from a delegator
@@ -72,19 +102,20 @@ func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddres
 	return delegations[:i] // trim if the array length < maxRetrieve
 }
 
-// set the delegation
+// set the delegation and associated index
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr), b)
-	k.AfterDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+	store.Set(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr), []byte{}) // index, store empty bytes
 }
 
-// remove a delegation from store
+// remove a delegation and associated index from store
 func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
+	store.Delete(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
 }
 
 //_____________________________________________________________________________________
@@ -469,6 +500,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Co
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
+	k.AfterDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
 
 	return newShares, nil
 }
@@ -513,6 +545,7 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	} else {
 		// update the delegation
 		k.SetDelegation(ctx, delegation)
+		k.AfterDelegationModified(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
 	}
 
 	// remove the coins from the validator
@@ -555,6 +588,31 @@ func (k Keeper) getBeginInfo(ctx sdk.Context, valSrcAddr sdk.ValAddress) (
 	}
 }
 
+// ValidateUnbondAmount returns the delegator shares worth the given amount of
+// tokens, failing if the delegation is worth less than that amount.
+func (k Keeper) ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, amt sdk.Int) (shares sdk.Dec, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shares, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return shares, types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+
+	shares, err = validator.SharesFromTokens(amt)
+	if err != nil {
+		return shares, err
+	}
+	if shares.GT(delegation.Shares) {
+		return shares, types.ErrNotEnoughDelegationShares(k.Codespace(), delegation.Shares.String())
+	}
+	return shares, nil
+}
+
 // begin unbonding an unbonding record
 func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress,
 	valAddr sdk.ValAddress, sharesAmount sdk.Dec) (completionTime time.Time, sdkErr sdk.Error) {
@@ -567,6 +625,7 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress,
 		return completionTime, err
 	}
 	balance := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	k.AfterUnbondingInitiated(ctx, delAddr, valAddr, balance)
 
 	// no need to create the ubd object just complete now
 	if completeNow {
@@ -620,6 +679,65 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// CancelUnbondingDelegation cancels the given amount of the immature
+// unbonding delegation entry created at the given height and bonds the tokens
+// back to the validator they were unbonding from.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin) sdk.Error {
+
+	if amount.Denom != k.BondDenom(ctx) {
+		return types.ErrBadDenom(k.Codespace())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+	if validator.Jailed && !bytes.Equal(validator.OperatorAddr, delAddr) {
+		return types.ErrValidatorJailed(k.Codespace())
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+
+	ctxTime := ctx.BlockHeader().Time
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight != creationHeight || entry.IsMature(ctxTime) {
+			continue
+		}
+		if entry.Balance.Amount.LT(amount.Amount) {
+			return types.ErrNotEnoughUnbondingBalance(k.Codespace(), entry.Balance.String())
+		}
+
+		// the unbonding tokens are still held by the pool, so they are bonded
+		// back without touching the delegator account
+		_, err := k.Delegate(ctx, delAddr, amount, validator, false)
+		if err != nil {
+			return err
+		}
+
+		entry.Balance = entry.Balance.Minus(amount)
+		entry.InitialBalance = entry.InitialBalance.Minus(amount)
+		if entry.Balance.IsZero() {
+			ubd.RemoveEntry(int64(i))
+		} else {
+			ubd.Entries[i] = entry
+		}
+
+		// set the unbonding delegation or remove it if there are no more entries
+		if len(ubd.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubd)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubd)
+		}
+		return nil
+	}
+
+	return types.ErrNoUnbondingDelegationEntry(k.Codespace())
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (
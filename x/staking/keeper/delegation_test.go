@@ -122,6 +122,11 @@ func TestDelegation(t *testing.T) {
 	resBonds = keeper.GetAllDelegatorDelegations(ctx, addrDels[1])
 	require.Equal(t, 2, len(resBonds))
 
+	// the validator index must be updated as well
+	resDels := keeper.GetValidatorDelegations(ctx, addrVals[2])
+	require.Len(t, resDels, 1)
+	require.True(t, bond1to3.Equal(resDels[0]))
+
 	// delete all the records from delegator 2
 	keeper.RemoveDelegation(ctx, bond2to1)
 	keeper.RemoveDelegation(ctx, bond2to2)
@@ -131,6 +136,16 @@ func TestDelegation(t *testing.T) {
 	require.False(t, found)
 	resBonds = keeper.GetDelegatorDelegations(ctx, addrDels[1], 5)
 	require.Equal(t, 0, len(resBonds))
+
+	for i := 0; i < 3; i++ {
+		var iterated []types.Delegation
+		keeper.IterateValidatorDelegations(ctx, addrVals[i], func(_ int64, del types.Delegation) bool {
+			iterated = append(iterated, del)
+			return false
+		})
+		require.Len(t, iterated, 1)
+		require.Equal(t, addrDels[0], iterated[0].DelegatorAddr)
+	}
 }
 
 // tests Get/Set/Remove UnbondingDelegation
@@ -36,6 +36,12 @@ func (k Keeper) AfterValidatorPowerDidChange(ctx sdk.Context, consAddr sdk.ConsA
 	}
 }
 
+func (k Keeper) AfterValidatorBondedDelta(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress, delta int64) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorBondedDelta(ctx, consAddr, valAddr, delta)
+	}
+}
+
 func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
 		k.hooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
@@ -66,6 +72,12 @@ func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 	}
 }
 
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, balance sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterUnbondingInitiated(ctx, delAddr, valAddr, balance)
+	}
+}
+
 func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
 	if k.hooks != nil {
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
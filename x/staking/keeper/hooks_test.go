@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mockHooks records the calls to the bonded delta and unbonding hooks
+type mockHooks struct {
+	deltas    []int64
+	unbonding []sdk.Coin
+	fractions []sdk.Dec
+}
+
+var _ sdk.StakingHooks = &mockHooks{}
+
+// nolint
+func (h *mockHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                    {}
+func (h *mockHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                  {}
+func (h *mockHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+func (h *mockHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
+func (h *mockHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+}
+func (h *mockHooks) AfterValidatorPowerDidChange(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+}
+func (h *mockHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h *mockHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {
+}
+func (h *mockHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h *mockHooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+func (h *mockHooks) AfterValidatorBondedDelta(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress, delta int64) {
+	h.deltas = append(h.deltas, delta)
+}
+func (h *mockHooks) AfterUnbondingInitiated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, balance sdk.Coin) {
+	h.unbonding = append(h.unbonding, balance)
+}
+func (h *mockHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, fraction sdk.Dec) {
+	h.fractions = append(h.fractions, fraction)
+}
+
+func TestHooksBondedDeltaAndUnbonding(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	hooks := &mockHooks{}
+	keeper.SetHooks(hooks)
+
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewInt(20)
+
+	// bonding the validator reports its whole power
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	require.Equal(t, []int64{10}, hooks.deltas)
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, delegation)
+
+	// unbonding reports the unbonded balance, then the power decrease
+	_, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(6))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin(keeper.BondDenom(ctx), 6)}, hooks.unbonding)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []int64{10, -6}, hooks.deltas)
+
+	// slashing reports the fraction of the validator's tokens being slashed
+	keeper.Slash(ctx, validator.ConsAddress(), ctx.BlockHeight(), 4, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, 1, len(hooks.fractions))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), hooks.fractions[0])
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NonNegativePowerInvariant checks that all stored validators have >= 0 power.
+func NonNegativePowerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		iterator := k.ValidatorsPowerStoreIterator(ctx)
+
+		for ; iterator.Valid(); iterator.Next() {
+			validator, found := k.GetValidator(ctx, iterator.Value())
+			if !found {
+				panic(fmt.Sprintf("validator record not found for address: %X\n", iterator.Value()))
+			}
+
+			powerKey := GetValidatorsByPowerIndexKey(validator)
+
+			if !bytes.Equal(iterator.Key(), powerKey) {
+				return fmt.Errorf("power store invariance:\n\tvalidator.Power: %v"+
+					"\n\tkey should be: %v\n\tkey in store: %v", validator.GetPower(), powerKey, iterator.Key())
+			}
+
+			if validator.Tokens.IsNegative() {
+				return fmt.Errorf("negative tokens for validator: %v", validator)
+			}
+		}
+		iterator.Close()
+		return nil
+	}
+}
@@ -89,3 +89,11 @@ func TestGetREDByValSrcIndexKey(t *testing.T) {
 		assert.Equal(t, tt.wantHex, got, "Keys did not match on test case %d", i)
 	}
 }
+
+func TestGetDelegationKeyFromValIndexKey(t *testing.T) {
+	delAddr, valAddr := sdk.AccAddress(addr1), sdk.ValAddress(addr2)
+
+	indexKey := GetDelegationByValIndexKey(delAddr, valAddr)
+	assert.Equal(t, GetDelegationsByValIndexKey(valAddr), indexKey[:1+sdk.AddrLen])
+	assert.Equal(t, GetDelegationKey(delAddr, valAddr), GetDelegationKeyFromValIndexKey(indexKey))
+}
@@ -15,7 +15,11 @@ const (
 
 // ParamTable for staking module
 func ParamTypeTable() params.TypeTable {
-	return params.NewTypeTable().RegisterParamSet(&types.Params{})
+	return params.NewTypeTable().RegisterParamSet(&types.Params{}).
+		RegisterValidator(types.KeyUnbondingTime, types.ValidateUnbondingTime).
+		RegisterValidator(types.KeyMaxValidators, types.ValidateMaxValidators).
+		RegisterValidator(types.KeyBondDenom, types.ValidateBondDenom).
+		RegisterValidator(types.KeyPowerReduction, types.ValidatePowerReduction)
 }
 
 // UnbondingTime
@@ -36,11 +40,30 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// PowerReduction - Amount of bonded tokens per unit of consensus power
+func (k Keeper) PowerReduction(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyPowerReduction, &res)
+	return
+}
+
+// TokensToConsensusPower converts an amount of bonded tokens to the consensus
+// power reported to Tendermint using the current power reduction parameter
+func (k Keeper) TokensToConsensusPower(ctx sdk.Context, tokens sdk.Int) int64 {
+	return types.TokensToConsensusPower(tokens, k.PowerReduction(ctx))
+}
+
+// TokensFromConsensusPower converts a consensus power to an amount of bonded
+// tokens using the current power reduction parameter
+func (k Keeper) TokensFromConsensusPower(ctx sdk.Context, power int64) sdk.Int {
+	return types.TokensFromConsensusPower(power, k.PowerReduction(ctx))
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (res types.Params) {
 	res.UnbondingTime = k.UnbondingTime(ctx)
 	res.MaxValidators = k.MaxValidators(ctx)
 	res.BondDenom = k.BondDenom(ctx)
+	res.PowerReduction = k.PowerReduction(ctx)
 	return
 }
 
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -51,17 +55,10 @@ func (k Keeper) GetDelegatorValidator(ctx sdk.Context, delegatorAddr sdk.AccAddr
 func (k Keeper) GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) (
 	delegations []types.Delegation) {
 
-	store := ctx.KVStore(k.storeKey)
-	delegatorPrefixKey := GetDelegationsKey(delegator)
-	iterator := sdk.KVStorePrefixIterator(store, delegatorPrefixKey) //smallest to largest
-	defer iterator.Close()
-
-	i := 0
-	for ; iterator.Valid(); iterator.Next() {
-		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
+	k.IterateDelegatorDelegations(ctx, delegator, func(_ int64, delegation types.Delegation) (stop bool) {
 		delegations = append(delegations, delegation)
-		i++
-	}
+		return false
+	})
 	return delegations
 }
 
@@ -105,3 +102,86 @@ func (k Keeper) GetAllRedelegations(ctx sdk.Context, delegator sdk.AccAddress, s
 	}
 	return redelegations
 }
+
+//_____________________________________________________________________________________
+// queues
+
+// queueIterator iterates over the timeslices of the queue stored under the
+// prefix maturing up to and including endTime, or over the whole queue if
+// endTime is zero.
+func (k Keeper) queueIterator(ctx sdk.Context, prefix []byte, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	if endTime.IsZero() {
+		return sdk.KVStorePrefixIterator(store, prefix)
+	}
+	endKey := append(prefix, sdk.FormatTimeBytes(endTime)...)
+	return store.Iterator(prefix, sdk.InclusiveEndBytes(endKey))
+}
+
+// queueTime returns the maturity time of a queue timeslice key.
+func queueTime(key []byte) time.Time {
+	t, err := sdk.ParseTimeBytes(key[1:])
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// GetValidatorQueue returns the unbonding validators maturing up to and
+// including endTime, or the whole queue if endTime is zero.
+func (k Keeper) GetValidatorQueue(ctx sdk.Context, endTime time.Time) (entries []types.ValidatorQueueEntry) {
+	iterator := k.queueIterator(ctx, ValidatorQueueKey, endTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var valAddrs []sdk.ValAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &valAddrs)
+		entries = append(entries, types.ValidatorQueueEntry{
+			CompletionTime: queueTime(iterator.Key()),
+			ValidatorAddrs: valAddrs,
+		})
+	}
+	return entries
+}
+
+// GetUBDQueue returns the unbonding delegations maturing up to and including
+// endTime, or the whole queue if endTime is zero.
+func (k Keeper) GetUBDQueue(ctx sdk.Context, endTime time.Time) (entries []types.UBDQueueEntry) {
+	iterator := k.queueIterator(ctx, UnbondingQueueKey, endTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var dvPairs []types.DVPair
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &dvPairs)
+		entries = append(entries, types.UBDQueueEntry{
+			CompletionTime: queueTime(iterator.Key()),
+			Pairs:          dvPairs,
+		})
+	}
+	return entries
+}
+
+// GetRedelegationQueue returns the redelegations maturing up to and including
+// endTime, or the whole queue if endTime is zero.
+func (k Keeper) GetRedelegationQueue(ctx sdk.Context, endTime time.Time) (entries []types.RedelegationQueueEntry) {
+	iterator := k.queueIterator(ctx, RedelegationQueueKey, endTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var dvvTriplets []types.DVVTriplet
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &dvvTriplets)
+		entries = append(entries, types.RedelegationQueueEntry{
+			CompletionTime: queueTime(iterator.Key()),
+			Triplets:       dvvTriplets,
+		})
+	}
+	return entries
+}
+
+// GetPendingValidatorUpdates returns the validator set updates the next
+// EndBlock would send to Tendermint given the current state, without
+// persisting any of the resulting state changes.
+func (k Keeper) GetPendingValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.ApplyAndReturnValidatorSetUpdates(cacheCtx)
+}
@@ -26,7 +26,9 @@ import (
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate) {
 
 	store := ctx.KVStore(k.storeKey)
-	maxValidators := k.GetParams(ctx).MaxValidators
+	params := k.GetParams(ctx)
+	maxValidators := params.MaxValidators
+	powerReduction := params.PowerReduction
 	totalPower := sdk.ZeroInt()
 
 	// Retrieve the last validator set.
@@ -47,9 +49,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			panic("should never retrieve a jailed validator from the power store")
 		}
 
-		// if we get to a zero-power validator (which we don't bond),
-		// there are no more possible bonded validators
-		if validator.Tokens.IsZero() {
+		// if we get to a validator without any consensus power (which we
+		// don't bond), there are no more possible bonded validators
+		if validator.Tokens.LT(powerReduction) {
 			break
 		}
 
@@ -71,17 +73,19 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		oldPowerBytes, found := last[valAddrBytes]
 
 		// calculate the new power bytes
-		newPower := validator.BondedTokens().Int64()
+		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(sdk.NewInt(newPower))
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			// Assert that the validator had updated its ValidatorDistInfo.FeePoolWithdrawalHeight.
 			// This hook is extremely useful, otherwise lazy accum bugs will be difficult to solve.
 			if k.hooks != nil {
 				k.hooks.AfterValidatorPowerDidChange(ctx, validator.ConsAddress(), valAddr)
 			}
+			k.AfterValidatorBondedDelta(ctx, validator.ConsAddress(), valAddr,
+				newPower-k.lastPowerFromBytes(oldPowerBytes))
 
 			// set validator power on lookup index.
 			k.SetLastValidatorPower(ctx, valAddr, sdk.NewInt(newPower))
@@ -108,7 +112,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		k.bondedToUnbonding(ctx, validator)
 
 		// delete from the bonded validator index
+		oldPower := k.GetLastValidatorPower(ctx, sdk.ValAddress(valAddrBytes))
 		k.DeleteLastValidatorPower(ctx, sdk.ValAddress(valAddrBytes))
+		k.AfterValidatorBondedDelta(ctx, validator.ConsAddress(), validator.OperatorAddr, -oldPower.Int64())
 
 		// update the validator set
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
@@ -249,6 +255,17 @@ func (k Keeper) completeUnbondingValidator(ctx sdk.Context, validator types.Vali
 // map of operator addresses to serialized power
 type validatorsByAddr map[[sdk.AddrLen]byte][]byte
 
+// lastPowerFromBytes decodes a serialized last validator power, treating a
+// missing entry as zero power.
+func (k Keeper) lastPowerFromBytes(bz []byte) int64 {
+	if bz == nil {
+		return 0
+	}
+	var power sdk.Int
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &power)
+	return power.Int64()
+}
+
 // get the last validator set
 func (k Keeper) getLastValidatorsByAddr(ctx sdk.Context) validatorsByAddr {
 	last := make(validatorsByAddr)
@@ -16,6 +16,29 @@ import (
 
 //_______________________________________________________
 
+func TestApplyAndReturnValidatorSetUpdatesPowerReduction(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	params := keeper.GetParams(ctx)
+	params.PowerReduction = sdk.NewInt(10)
+	keeper.SetParams(ctx, params)
+
+	// the second validator has less than one unit of consensus power
+	amts := []int64{25, 5}
+	var validators [2]types.Validator
+	for i, amt := range amts {
+		pool := keeper.GetPool(ctx)
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, sdk.NewInt(amt))
+		keeper.SetPool(ctx, pool)
+		validators[i] = TestingUpdateValidator(keeper, ctx, validators[i], true)
+	}
+
+	require.Equal(t, sdk.Bonded, validators[0].Status)
+	require.Equal(t, sdk.Unbonded, validators[1].Status)
+	require.Equal(t, int64(2), validators[0].ConsensusPower(keeper.PowerReduction(ctx)))
+	require.Equal(t, int64(2), validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)).Power)
+}
+
 func TestSetValidator(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 10)
 	pool := keeper.GetPool(ctx)
@@ -38,7 +61,7 @@ func TestSetValidator(t *testing.T) {
 	validator, found := keeper.GetValidator(ctx, valAddr)
 	require.True(t, found)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 
 	// after the save the validator should be bonded
 	require.Equal(t, sdk.Bonded, validator.Status)
@@ -628,8 +651,8 @@ func TestApplyAndReturnValidatorSetUpdatesAllNone(t *testing.T) {
 	assert.Equal(t, 2, len(updates))
 	validators[0], _ = keeper.GetValidator(ctx, validators[0].OperatorAddr)
 	validators[1], _ = keeper.GetValidator(ctx, validators[1].OperatorAddr)
-	assert.Equal(t, validators[0].ABCIValidatorUpdate(), updates[1])
-	assert.Equal(t, validators[1].ABCIValidatorUpdate(), updates[0])
+	assert.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
+	assert.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesIdentical(t *testing.T) {
@@ -678,7 +701,7 @@ func TestApplyAndReturnValidatorSetUpdatesSingleValueChange(t *testing.T) {
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
@@ -707,8 +730,8 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 2, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
@@ -733,7 +756,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[2], _ = keeper.GetValidator(ctx, validators[2].OperatorAddr)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 
 	// test validtor added at the beginning
 	//  tendermintUpdate set: {} -> {c0}
@@ -742,7 +765,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[3], _ = keeper.GetValidator(ctx, validators[3].OperatorAddr)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[3].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[3].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 
 	// test validtor added at the end
 	//  tendermintUpdate set: {} -> {c0}
@@ -751,7 +774,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[4], _ = keeper.GetValidator(ctx, validators[4].OperatorAddr)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[4].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[4].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
@@ -791,7 +814,7 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 	validators[2], _ = keeper.GetValidator(ctx, validators[2].OperatorAddr)
 	require.Equal(t, 2, len(updates), "%v", updates)
 	require.Equal(t, validators[0].ABCIValidatorUpdateZero(), updates[1])
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
@@ -829,8 +852,8 @@ func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
 	// Tendermint updates should reflect power change
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 2, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
@@ -862,8 +885,8 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	require.Equal(t, len(validators), len(updates))
 	validators[0], _ = keeper.GetValidator(ctx, validators[0].OperatorAddr)
 	validators[1], _ = keeper.GetValidator(ctx, validators[1].OperatorAddr)
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 
 	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
@@ -911,9 +934,9 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	validators[0], _ = keeper.GetValidator(ctx, validators[0].OperatorAddr)
 	validators[1], _ = keeper.GetValidator(ctx, validators[1].OperatorAddr)
 	require.Equal(t, len(validators)+1, len(updates))
-	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[1])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[2])
+	require.Equal(t, validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[2])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
@@ -945,8 +968,8 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	require.Equal(t, 2, len(updates))
 	validators[2], _ = keeper.GetValidator(ctx, validators[2].OperatorAddr)
 	validators[1], _ = keeper.GetValidator(ctx, validators[1].OperatorAddr)
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 
 	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
@@ -992,7 +1015,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	// verify initial Tendermint updates are correct
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
 
 	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 }
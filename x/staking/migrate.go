@@ -0,0 +1,132 @@
+package staking
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterGenesisMigration registers the migration of the staking genesis
+// state of the previous release, keyed by "stake". Its token amounts and
+// powers were decimals, its unbonding delegations and redelegations had a
+// single entry, and its params lacked the power reduction.
+func RegisterGenesisMigration(m *sdk.GenesisMigrator) {
+	m.RenameModule("stake", ModuleName)
+	m.RegisterMigration(ModuleName, migrateGenesis)
+}
+
+func migrateGenesis(cdc *codec.Codec, state json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(state, &fields); err != nil {
+		return nil, err
+	}
+
+	var pool map[string]json.RawMessage
+	if err := unmarshalField(fields, "pool", &pool); err != nil {
+		return nil, err
+	}
+	truncateDecField(pool, "loose_tokens")
+	truncateDecField(pool, "bonded_tokens")
+	if err := marshalField(fields, "pool", pool); err != nil {
+		return nil, err
+	}
+	truncateDecField(fields, "last_total_power")
+
+	// the decimal field of the objects of the lists
+	decFields := map[string]string{
+		"last_validator_powers": "Power",
+		"validators":            "tokens",
+	}
+	for name, decField := range decFields {
+		var objects []map[string]json.RawMessage
+		if err := unmarshalField(fields, name, &objects); err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			truncateDecField(object, decField)
+		}
+		if err := marshalField(fields, name, objects); err != nil {
+			return nil, err
+		}
+	}
+
+	// the fields of the single entry moved to the entries
+	entryFields := map[string][]string{
+		"unbonding_delegations": {"creation_height", "initial_balance", "balance"},
+		"redelegations":         {"creation_height", "initial_balance", "balance", "shares_src", "shares_dst"},
+	}
+	for name, moved := range entryFields {
+		var objects []map[string]json.RawMessage
+		if err := unmarshalField(fields, name, &objects); err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			if _, ok := object["entries"]; ok {
+				continue
+			}
+			entry := make(map[string]json.RawMessage)
+			for _, field := range moved {
+				entry[field] = object[field]
+				delete(object, field)
+			}
+			entry["completion_time"] = object["min_time"]
+			delete(object, "min_time")
+
+			entries, err := json.Marshal([]map[string]json.RawMessage{entry})
+			if err != nil {
+				return nil, err
+			}
+			object["entries"] = entries
+		}
+		if err := marshalField(fields, name, objects); err != nil {
+			return nil, err
+		}
+	}
+
+	migrated, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.DefaultsGenesisMigration(DefaultGenesisState())(cdc, migrated)
+}
+
+// unmarshalField unmarshals the field if present and not null
+func unmarshalField(fields map[string]json.RawMessage, name string, ptr interface{}) error {
+	value, ok := fields[name]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(value, ptr); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+// marshalField marshals the field if present
+func marshalField(fields map[string]json.RawMessage, name string, value interface{}) error {
+	if _, ok := fields[name]; !ok {
+		return nil
+	}
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[name] = bz
+	return nil
+}
+
+// truncateDecField truncates the decimal string field, if any, to an integer
+func truncateDecField(fields map[string]json.RawMessage, name string) {
+	var value string
+	if err := json.Unmarshal(fields[name], &value); err != nil || !strings.Contains(value, ".") {
+		return
+	}
+	dec, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return
+	}
+	fields[name], _ = json.Marshal(dec.TruncateInt().String())
+}
@@ -1,6 +1,9 @@
 package querier
 
 import (
+	"strings"
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,6 +28,10 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryValidatorQueue                = "validatorQueue"
+	QueryUnbondingQueue                = "unbondingQueue"
+	QueryRedelegationQueue             = "redelegationQueue"
+	QueryPendingValidatorUpdates       = "pendingValidatorUpdates"
 )
 
 // creates a querier for staking REST endpoints
@@ -32,7 +39,7 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryValidators:
-			return queryValidators(ctx, cdc, k)
+			return queryValidators(ctx, cdc, req, k)
 		case QueryValidator:
 			return queryValidator(ctx, cdc, req, k)
 		case QueryValidatorDelegations:
@@ -57,19 +64,49 @@ func NewQuerier(k keep.Keeper, cdc *codec.Codec) sdk.Querier {
 			return queryPool(ctx, cdc, k)
 		case QueryParameters:
 			return queryParameters(ctx, cdc, k)
+		case QueryValidatorQueue:
+			return queryValidatorQueue(ctx, cdc, req, k)
+		case QueryUnbondingQueue:
+			return queryUnbondingQueue(ctx, cdc, req, k)
+		case QueryRedelegationQueue:
+			return queryRedelegationQueue(ctx, cdc, req, k)
+		case QueryPendingValidatorUpdates:
+			return queryPendingValidatorUpdates(ctx, cdc, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
 	}
 }
 
+// defines the params for the following queries:
+// - 'custom/staking/validators'
+type QueryValidatorsParams struct {
+	Page   int
+	Limit  int
+	Status string
+}
+
+func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
+	return QueryValidatorsParams{
+		Page:   page,
+		Limit:  limit,
+		Status: status,
+	}
+}
+
 // defines the params for the following queries:
 // - 'custom/staking/delegatorDelegations'
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+//
+// Page, Limit and Status are only taken into account by
+// 'custom/staking/delegatorDelegations'.
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
+	Page          int
+	Limit         int
+	Status        string
 }
 
 func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
@@ -83,8 +120,13 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+//
+// Page and Limit are only taken into account by
+// 'custom/staking/validatorDelegations'.
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
+	Page          int
+	Limit         int
 }
 
 func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
@@ -125,12 +167,49 @@ func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress, srcValidatorAddr s
 	}
 }
 
-func queryValidators(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
-	stakingParams := k.GetParams(ctx)
-	validators := k.GetValidators(ctx, stakingParams.MaxValidators)
+// defines the params for the following queries:
+// - 'custom/staking/validatorQueue'
+// - 'custom/staking/unbondingQueue'
+// - 'custom/staking/redelegationQueue'
+//
+// A zero EndTime returns the whole queue.
+type QueryQueueParams struct {
+	EndTime time.Time
+}
+
+func NewQueryQueueParams(endTime time.Time) QueryQueueParams {
+	return QueryQueueParams{
+		EndTime: endTime,
+	}
+}
+
+func queryValidators(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorsParams
+
+	// an empty request returns all the validators
+	if len(req.Data) != 0 {
+		errRes := cdc.UnmarshalJSON(req.Data, &params)
+		if errRes != nil {
+			return []byte{}, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+		}
+	}
+
+	if err := validatePagination(params.Page, params.Limit, params.Status); err != nil {
+		return []byte{}, err
+	}
+
+	var validators []types.Validator
+	for _, validator := range k.GetAllValidators(ctx) {
+		if matchesStatus(validator.Status, params.Status) {
+			validators = append(validators, validator)
+		}
+	}
+
+	start, end := pageBounds(len(validators), params.Page, params.Limit)
+	validators = validators[start:end]
 
 	res, errRes := codec.MarshalJSONIndent(cdc, validators)
-	if err != nil {
+	if errRes != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
 	}
 	return res, nil
@@ -164,8 +243,15 @@ func queryValidatorDelegations(ctx sdk.Context, cdc *codec.Codec, req abci.Reque
 		return []byte{}, sdk.ErrUnknownAddress("")
 	}
 
+	if err := validatePagination(params.Page, params.Limit, ""); err != nil {
+		return []byte{}, err
+	}
+
 	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
 
+	start, end := pageBounds(len(delegations), params.Page, params.Limit)
+	delegations = delegations[start:end]
+
 	res, errRes = codec.MarshalJSONIndent(cdc, delegations)
 	if errRes != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
@@ -198,7 +284,23 @@ func queryDelegatorDelegations(ctx sdk.Context, cdc *codec.Codec, req abci.Reque
 		return []byte{}, sdk.ErrUnknownAddress("")
 	}
 
-	delegations := k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr)
+	if err := validatePagination(params.Page, params.Limit, params.Status); err != nil {
+		return []byte{}, err
+	}
+
+	var delegations []types.Delegation
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr) {
+		if len(params.Status) != 0 {
+			validator, found := k.GetValidator(ctx, delegation.ValidatorAddr)
+			if !found || !matchesStatus(validator.Status, params.Status) {
+				continue
+			}
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	start, end := pageBounds(len(delegations), params.Page, params.Limit)
+	delegations = delegations[start:end]
 
 	res, errRes = codec.MarshalJSONIndent(cdc, delegations)
 	if errRes != nil {
@@ -351,3 +453,116 @@ func queryParameters(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []by
 	}
 	return res, nil
 }
+
+func queryValidatorQueue(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := parseQueueParams(cdc, req)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, k.GetValidatorQueue(ctx, params.EndTime))
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryUnbondingQueue(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := parseQueueParams(cdc, req)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, k.GetUBDQueue(ctx, params.EndTime))
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryRedelegationQueue(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, k keep.Keeper) (res []byte, err sdk.Error) {
+	params, err := parseQueueParams(cdc, req)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(cdc, k.GetRedelegationQueue(ctx, params.EndTime))
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+func queryPendingValidatorUpdates(ctx sdk.Context, cdc *codec.Codec, k keep.Keeper) (res []byte, err sdk.Error) {
+	updates := k.GetPendingValidatorUpdates(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(cdc, updates)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
+// parseQueueParams decodes the params of a queue query, an empty request
+// returning the whole queue.
+func parseQueueParams(cdc *codec.Codec, req abci.RequestQuery) (params QueryQueueParams, err sdk.Error) {
+	if len(req.Data) == 0 {
+		return params, nil
+	}
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return params, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", errRes.Error()))
+	}
+	return params, nil
+}
+
+//______________________________________________________________________________
+// pagination helpers
+
+// validatePagination checks the page, limit and status filter of a paginated
+// query. A zero page and limit disable pagination and an empty status disables
+// the status filter.
+func validatePagination(page, limit int, status string) sdk.Error {
+	if page < 0 || limit < 0 {
+		return sdk.ErrUnknownRequest("page and limit cannot be negative")
+	}
+	switch {
+	case len(status) == 0,
+		strings.EqualFold(status, sdk.BondStatusBonded),
+		strings.EqualFold(status, sdk.BondStatusUnbonding),
+		strings.EqualFold(status, sdk.BondStatusUnbonded):
+		return nil
+	default:
+		return sdk.ErrUnknownRequest("invalid validator status " + status)
+	}
+}
+
+// matchesStatus returns true if the status filter is empty or if it matches the
+// given validator bond status (case insensitive).
+func matchesStatus(bondStatus sdk.BondStatus, status string) bool {
+	return len(status) == 0 || strings.EqualFold(sdk.BondStatusToString(bondStatus), status)
+}
+
+// pageBounds returns the start and end indices of the requested page within a
+// list of numObjs results. Pages are 1-indexed and a zero page defaults to the
+// first one. A zero limit returns the whole list.
+func pageBounds(numObjs, page, limit int) (start, end int) {
+	if limit == 0 {
+		return 0, numObjs
+	}
+	if page == 0 {
+		page = 1
+	}
+
+	start = (page - 1) * limit
+	if start >= numObjs {
+		return numObjs, numObjs
+	}
+
+	end = start + limit
+	if end > numObjs {
+		end = numObjs
+	}
+	return start, end
+}
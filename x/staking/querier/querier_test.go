@@ -2,6 +2,7 @@ package querier
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -134,7 +135,7 @@ func TestQueryValidators(t *testing.T) {
 	// Query Validators
 	queriedValidators := keeper.GetValidators(ctx, params.MaxValidators)
 
-	res, err := queryValidators(ctx, cdc, keeper)
+	res, err := queryValidators(ctx, cdc, abci.RequestQuery{}, keeper)
 	require.Nil(t, err)
 
 	var validatorsResp []types.Validator
@@ -163,6 +164,89 @@ func TestQueryValidators(t *testing.T) {
 	require.Equal(t, queriedValidators[0], validator)
 }
 
+func TestQueryValidatorsPagination(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
+	pool := keeper.GetPool(ctx)
+
+	// Create Validators, the last one being bonded
+	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8), sdk.NewInt(7)}
+	var validators [3]types.Validator
+	for i, amt := range amts {
+		validators[i] = types.NewValidator(sdk.ValAddress(keep.Addrs[i]), keep.PKs[i], types.Description{})
+		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, amt)
+	}
+	validators[2], pool = validators[2].UpdateStatus(pool, sdk.Bonded)
+	keeper.SetPool(ctx, pool)
+	for _, validator := range validators {
+		keeper.SetValidator(ctx, validator)
+	}
+
+	queryValidatorsPage := func(page, limit int, status string) ([]types.Validator, sdk.Error) {
+		bz, errRes := cdc.MarshalJSON(NewQueryValidatorsParams(page, limit, status))
+		require.Nil(t, errRes)
+
+		res, err := queryValidators(ctx, cdc, abci.RequestQuery{Data: bz}, keeper)
+		if err != nil {
+			return nil, err
+		}
+
+		var validatorsResp []types.Validator
+		require.Nil(t, cdc.UnmarshalJSON(res, &validatorsResp))
+		return validatorsResp, nil
+	}
+
+	allValidators := keeper.GetAllValidators(ctx)
+
+	validatorsResp, err := queryValidatorsPage(1, 2, "")
+	require.Nil(t, err)
+	require.Equal(t, allValidators[:2], validatorsResp)
+
+	validatorsResp, err = queryValidatorsPage(2, 2, "")
+	require.Nil(t, err)
+	require.Equal(t, allValidators[2:], validatorsResp)
+
+	validatorsResp, err = queryValidatorsPage(3, 2, "")
+	require.Nil(t, err)
+	require.Len(t, validatorsResp, 0)
+
+	validatorsResp, err = queryValidatorsPage(0, 0, "bonded")
+	require.Nil(t, err)
+	require.Equal(t, []types.Validator{validators[2]}, validatorsResp)
+
+	validatorsResp, err = queryValidatorsPage(1, 1, "Unbonded")
+	require.Nil(t, err)
+	require.Len(t, validatorsResp, 1)
+	require.Equal(t, sdk.Unbonded, validatorsResp[0].Status)
+
+	_, err = queryValidatorsPage(1, 1, "jailed")
+	require.NotNil(t, err)
+
+	_, err = queryValidatorsPage(-1, 1, "")
+	require.NotNil(t, err)
+}
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		numObjs, page, limit int
+		start, end           int
+	}{
+		{10, 0, 0, 0, 10},
+		{10, 5, 0, 0, 10},
+		{10, 0, 3, 0, 3},
+		{10, 1, 3, 0, 3},
+		{10, 4, 3, 9, 10},
+		{10, 5, 3, 10, 10},
+		{0, 1, 3, 0, 0},
+	}
+
+	for i, tc := range tests {
+		start, end := pageBounds(tc.numObjs, tc.page, tc.limit)
+		require.Equal(t, tc.start, start, "test case %d", i)
+		require.Equal(t, tc.end, end, "test case %d", i)
+	}
+}
+
 func TestQueryDelegation(t *testing.T) {
 	cdc := codec.New()
 	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
@@ -427,3 +511,70 @@ func TestQueryRedelegations(t *testing.T) {
 
 	require.Equal(t, redelegation, redsRes[0])
 }
+
+func TestQueryQueues(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper := keep.CreateTestInput(t, false, 10000)
+
+	// Create Validators and Delegation
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	keeper.Delegate(ctx, addrAcc2, sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(100)), val1, true)
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	completionTime, err := keeper.Undelegate(ctx, addrAcc2, val1.GetOperator(), sdk.NewDec(20))
+	require.Nil(t, err)
+	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// the whole unbonding queue
+	res, err := queryUnbondingQueue(ctx, cdc, abci.RequestQuery{Path: "/custom/staking/unbondingQueue"}, keeper)
+	require.Nil(t, err)
+
+	var ubdQueue []types.UBDQueueEntry
+	require.Nil(t, cdc.UnmarshalJSON(res, &ubdQueue))
+	require.Equal(t, 1, len(ubdQueue))
+	require.True(t, completionTime.Equal(ubdQueue[0].CompletionTime))
+	require.Equal(t, []types.DVPair{{addrAcc2, addrVal1}}, ubdQueue[0].Pairs)
+
+	// nothing matures before the completion time
+	bz, errRes := cdc.MarshalJSON(NewQueryQueueParams(completionTime.Add(-time.Second)))
+	require.Nil(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/unbondingQueue",
+		Data: bz,
+	}
+
+	res, err = queryUnbondingQueue(ctx, cdc, query, keeper)
+	require.Nil(t, err)
+	ubdQueue = nil
+	require.Nil(t, cdc.UnmarshalJSON(res, &ubdQueue))
+	require.Equal(t, 0, len(ubdQueue))
+
+	// unbonding validators
+	val2.UnbondingCompletionTime = completionTime
+	keeper.InsertValidatorQueue(ctx, val2)
+	res, err = queryValidatorQueue(ctx, cdc, abci.RequestQuery{Path: "/custom/staking/validatorQueue"}, keeper)
+	require.Nil(t, err)
+
+	var valQueue []types.ValidatorQueueEntry
+	require.Nil(t, cdc.UnmarshalJSON(res, &valQueue))
+	require.Equal(t, 1, len(valQueue))
+	require.Equal(t, []sdk.ValAddress{addrVal2}, valQueue[0].ValidatorAddrs)
+
+	// pending validator updates are not persisted
+	val2, _ = keeper.GetValidator(ctx, addrVal2)
+	keeper.Delegate(ctx, addrAcc1, sdk.NewCoin(types.DefaultBondDenom, sdk.NewInt(50)), val2, true)
+	res, err = queryPendingValidatorUpdates(ctx, cdc, keeper)
+	require.Nil(t, err)
+
+	var updates []abci.ValidatorUpdate
+	require.Nil(t, cdc.UnmarshalJSON(res, &updates))
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, int64(50), updates[0].Power)
+
+	val2, _ = keeper.GetValidator(ctx, addrVal2)
+	require.Equal(t, sdk.Unbonded, val2.Status)
+}
@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RandomizedParams returns random parameters of the staking module
+func RandomizedParams(r *rand.Rand) staking.Params {
+	return staking.Params{
+		UnbondingTime:  time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*3*2)) * time.Second,
+		MaxValidators:  uint16(r.Intn(250)),
+		BondDenom:      stakingTypes.DefaultBondDenom,
+		PowerReduction: stakingTypes.DefaultPowerReduction,
+	}
+}
+
+// RandomizedGenState generates a random genesis state of the staking module,
+// bonding the first NumBonded accounts as validators with their initial stake,
+// and sets the unbonding time of the simulation.
+func RandomizedGenState(simState *simulation.GenesisState) {
+	stakingGenesis := staking.GenesisState{
+		Pool:   staking.InitialPool(),
+		Params: RandomizedParams(simState.Rand),
+	}
+	fmt.Printf("Selected randomly generated staking parameters:\n\t%+v\n", stakingGenesis)
+
+	var validators []staking.Validator
+	var delegations []staking.Delegation
+
+	amount := simState.InitialStake
+	for i := 0; i < int(simState.NumBonded); i++ {
+		acc := simState.Accounts[i]
+		valAddr := sdk.ValAddressFromAccAddress(acc.Address)
+
+		validator := staking.NewValidator(valAddr, acc.PubKey, staking.Description{})
+		validator.Tokens = sdk.NewInt(amount)
+		validator.DelegatorShares = sdk.NewDec(amount)
+		delegation := staking.Delegation{DelegatorAddr: acc.Address, ValidatorAddr: valAddr, Shares: sdk.NewDec(amount)}
+		validators = append(validators, validator)
+		delegations = append(delegations, delegation)
+	}
+
+	numAccs := int64(len(simState.Accounts))
+	stakingGenesis.Pool.LooseTokens = sdk.NewInt((amount * numAccs) + (simState.NumBonded * amount))
+	stakingGenesis.Validators = validators
+	stakingGenesis.Bonds = delegations
+
+	simState.UnbondTime = stakingGenesis.Params.UnbondingTime
+	simState.SetGenesis(staking.ModuleName, stakingGenesis)
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var _ simulation.AppModuleSimulation = AppModule{}
+
+// AppModule implements the simulation.AppModuleSimulation interface for the
+// staking module
+type AppModule struct {
+	accountKeeper auth.AccountKeeper
+	keeper        staking.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper, keeper staking.Keeper) AppModule {
+	return AppModule{
+		accountKeeper: accountKeeper,
+		keeper:        keeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return staking.ModuleName
+}
+
+// randomized genesis state
+func (AppModule) RandomizedGenesis(simState *simulation.GenesisState) {
+	RandomizedGenState(simState)
+}
+
+// weighted operations
+func (am AppModule) WeightedOperations() []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 100, Op: SimulateMsgCreateValidator(am.accountKeeper, am.keeper)},
+		{Weight: 5, Op: SimulateMsgEditValidator(am.keeper)},
+		{Weight: 100, Op: SimulateMsgDelegate(am.accountKeeper, am.keeper)},
+		{Weight: 100, Op: SimulateMsgUndelegate(am.accountKeeper, am.keeper)},
+		{Weight: 100, Op: SimulateMsgBeginRedelegate(am.accountKeeper, am.keeper)},
+	}
+}
@@ -32,7 +32,7 @@ func SimulateMsgCreateValidator(m auth.AccountKeeper, k staking.Keeper) simulati
 		)
 
 		acc := simulation.RandomAcc(r, accs)
-		address := sdk.ValAddress(acc.Address)
+		address := sdk.ValAddressFromAccAddress(acc.Address)
 		amount := m.GetAccount(ctx, acc.Address).GetCoins().AmountOf(denom)
 		if amount.GT(sdk.ZeroInt()) {
 			amount = simulation.RandomAmount(r, amount)
@@ -159,10 +159,9 @@ func SimulateMsgUndelegate(m auth.AccountKeeper, k staking.Keeper) simulation.Op
 		if numShares.Equal(sdk.ZeroDec()) {
 			return "no-operation", nil, nil
 		}
-		msg := staking.MsgUndelegate{
-			DelegatorAddr: delegatorAddress,
-			ValidatorAddr: delegation.ValidatorAddr,
-			SharesAmount:  numShares,
+		msg := staking.NewMsgUndelegate(delegatorAddress, delegation.ValidatorAddr, numShares)
+		if r.Intn(10) == 0 {
+			msg = staking.NewMsgUndelegateMax(delegatorAddress, delegation.ValidatorAddr)
 		}
 		if msg.ValidateBasic() != nil {
 			return "", nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
@@ -24,10 +24,14 @@ type (
 	MsgUndelegate           = types.MsgUndelegate
 	MsgBeginRedelegate      = types.MsgBeginRedelegate
 	GenesisState            = types.GenesisState
+	QueryValidatorsParams   = querier.QueryValidatorsParams
 	QueryDelegatorParams    = querier.QueryDelegatorParams
 	QueryValidatorParams    = querier.QueryValidatorParams
 	QueryBondsParams        = querier.QueryBondsParams
 	QueryRedelegationParams = querier.QueryRedelegationParams
+	QueryQueueParams        = querier.QueryQueueParams
+
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
 )
 
 var (
@@ -83,12 +87,17 @@ var (
 	NewMsgEditValidator             = types.NewMsgEditValidator
 	NewMsgDelegate                  = types.NewMsgDelegate
 	NewMsgUndelegate                = types.NewMsgUndelegate
+	NewMsgUndelegateAmount          = types.NewMsgUndelegateAmount
+	NewMsgUndelegateMax             = types.NewMsgUndelegateMax
 	NewMsgBeginRedelegate           = types.NewMsgBeginRedelegate
-
-	NewQuerier              = querier.NewQuerier
-	NewQueryDelegatorParams = querier.NewQueryDelegatorParams
-	NewQueryValidatorParams = querier.NewQueryValidatorParams
-	NewQueryBondsParams     = querier.NewQueryBondsParams
+	NewMsgCancelUnbondingDelegation = types.NewMsgCancelUnbondingDelegation
+
+	NewQuerier               = querier.NewQuerier
+	NewQueryValidatorsParams = querier.NewQueryValidatorsParams
+	NewQueryDelegatorParams  = querier.NewQueryDelegatorParams
+	NewQueryValidatorParams  = querier.NewQueryValidatorParams
+	NewQueryQueueParams      = querier.NewQueryQueueParams
+	NewQueryBondsParams      = querier.NewQueryBondsParams
 )
 
 const (
@@ -152,6 +161,7 @@ var (
 
 	ErrBothShareMsgsGiven    = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven = types.ErrNeitherShareMsgsGiven
+	ErrMultipleUnbondAmounts = types.ErrMultipleUnbondAmounts
 	ErrMissingSignature      = types.ErrMissingSignature
 )
 
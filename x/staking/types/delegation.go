@@ -312,3 +312,15 @@ func (d Redelegation) HumanReadableString() (string, error) {
 	}
 	return resp, nil
 }
+
+// UBDQueueEntry - the unbonding delegations maturing at the same time
+type UBDQueueEntry struct {
+	CompletionTime time.Time `json:"completion_time"`
+	Pairs          []DVPair  `json:"pairs"`
+}
+
+// RedelegationQueueEntry - the redelegations maturing at the same time
+type RedelegationQueueEntry struct {
+	CompletionTime time.Time    `json:"completion_time"`
+	Triplets       []DVVTriplet `json:"triplets"`
+}
@@ -24,6 +24,17 @@ const (
 	CodeUnknownRequest    CodeType = sdk.CodeUnknownRequest
 )
 
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidValidator, "invalid validator")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidDelegation, "invalid delegation")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidInput, "invalid input")
+	sdk.RegisterCode(DefaultCodespace, CodeValidatorJailed, "validator jailed")
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidAddress, sdk.CodeToDefaultMsg(CodeInvalidAddress))
+	sdk.RegisterCode(DefaultCodespace, CodeUnauthorized, sdk.CodeToDefaultMsg(CodeUnauthorized))
+	sdk.RegisterCode(DefaultCodespace, CodeInternal, sdk.CodeToDefaultMsg(CodeInternal))
+	sdk.RegisterCode(DefaultCodespace, CodeUnknownRequest, sdk.CodeToDefaultMsg(CodeUnknownRequest))
+}
+
 //validator
 func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "validator address is nil")
@@ -149,6 +160,18 @@ func ErrNoUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "no unbonding delegation found")
 }
 
+func ErrNoUnbondingDelegationEntry(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no immature unbonding delegation entry found at that creation height")
+}
+
+func ErrNotEnoughUnbondingBalance(codespace sdk.CodespaceType, balance string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("not enough unbonding balance to cancel, only have %v", balance))
+}
+
+func ErrBadCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height cannot be negative")
+}
+
 func ErrExistingUnbondingDelegation(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "existing unbonding delegation found")
 }
@@ -191,6 +214,10 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "neither shares amount nor shares percent provided")
 }
 
+func ErrMultipleUnbondAmounts(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "only one of shares amount, token amount or max may be provided")
+}
+
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
@@ -28,7 +28,7 @@ func NewMsgCreateValidator(valAddr sdk.ValAddress, pubkey crypto.PubKey,
 	selfDelegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
 
 	return NewMsgCreateValidatorOnBehalfOf(
-		sdk.AccAddress(valAddr), valAddr, pubkey, selfDelegation, description, commission,
+		sdk.AccAddressFromValAddress(valAddr), valAddr, pubkey, selfDelegation, description, commission,
 	)
 }
 
@@ -57,7 +57,7 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 	if !bytes.Equal(msg.DelegatorAddr.Bytes(), msg.ValidatorAddr.Bytes()) {
 		// if validator addr is not same as delegator addr, validator must sign
 		// msg as well
-		addrs = append(addrs, sdk.AccAddress(msg.ValidatorAddr))
+		addrs = append(addrs, sdk.AccAddressFromValAddress(msg.ValidatorAddr))
 	}
 	return addrs
 }
@@ -91,7 +91,7 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.ValidatorAddr == nil {
 		return ErrNilValidatorAddr(DefaultCodespace)
 	}
-	if !(msg.Value.Amount.GT(sdk.ZeroInt())) {
+	if msg.Value.Amount.IsNil() || !(msg.Value.Amount.GT(sdk.ZeroInt())) {
 		return ErrBadDelegationAmount(DefaultCodespace)
 	}
 	if msg.Description == (Description{}) {
@@ -131,7 +131,7 @@ func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRat
 func (msg MsgEditValidator) Route() string { return RouterKey }
 func (msg MsgEditValidator) Type() string  { return "edit_validator" }
 func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+	return []sdk.AccAddress{sdk.AccAddressFromValAddress(msg.ValidatorAddr)}
 }
 
 // get the bytes for the message signer to sign on
@@ -203,7 +203,7 @@ func (msg MsgDelegate) ValidateBasic() sdk.Error {
 	if msg.ValidatorAddr == nil {
 		return ErrNilValidatorAddr(DefaultCodespace)
 	}
-	if !(msg.Value.Amount.GT(sdk.ZeroInt())) {
+	if msg.Value.Amount.IsNil() || !(msg.Value.Amount.GT(sdk.ZeroInt())) {
 		return ErrBadDelegationAmount(DefaultCodespace)
 	}
 	return nil
@@ -267,7 +267,7 @@ func (msg MsgBeginRedelegate) ValidateBasic() sdk.Error {
 	if msg.ValidatorDstAddr == nil {
 		return ErrNilValidatorAddr(DefaultCodespace)
 	}
-	if msg.SharesAmount.LTE(sdk.ZeroDec()) {
+	if msg.SharesAmount.IsNil() || msg.SharesAmount.LTE(sdk.ZeroDec()) {
 		return ErrBadSharesAmount(DefaultCodespace)
 	}
 	return nil
@@ -275,18 +275,47 @@ func (msg MsgBeginRedelegate) ValidateBasic() sdk.Error {
 
 //______________________________________________________________________
 
-// MsgUndelegate - struct for unbonding transactions
+// MsgUndelegate - struct for unbonding transactions. Exactly one of
+// SharesAmount, Amount or Max must be set: SharesAmount unbonds a number of
+// delegator shares, Amount unbonds the shares worth the given tokens and Max
+// unbonds the entire delegation without leaving any dust shares behind.
 type MsgUndelegate struct {
 	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
 	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
 	SharesAmount  sdk.Dec        `json:"shares_amount"`
+	Amount        sdk.Coin       `json:"amount"`
+	Max           bool           `json:"max"`
 }
 
+// NewMsgUndelegate creates a message unbonding the given delegator shares.
 func NewMsgUndelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) MsgUndelegate {
 	return MsgUndelegate{
 		DelegatorAddr: delAddr,
 		ValidatorAddr: valAddr,
 		SharesAmount:  sharesAmount,
+		Amount:        sdk.Coin{Amount: sdk.ZeroInt()},
+	}
+}
+
+// NewMsgUndelegateAmount creates a message unbonding the delegator shares
+// worth the given amount of tokens.
+func NewMsgUndelegateAmount(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgUndelegate {
+	return MsgUndelegate{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		SharesAmount:  sdk.ZeroDec(),
+		Amount:        amount,
+	}
+}
+
+// NewMsgUndelegateMax creates a message unbonding the entire delegation.
+func NewMsgUndelegateMax(delAddr sdk.AccAddress, valAddr sdk.ValAddress) MsgUndelegate {
+	return MsgUndelegate{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+		SharesAmount:  sdk.ZeroDec(),
+		Amount:        sdk.Coin{Amount: sdk.ZeroInt()},
+		Max:           true,
 	}
 }
 
@@ -295,16 +324,31 @@ func (msg MsgUndelegate) Route() string                { return RouterKey }
 func (msg MsgUndelegate) Type() string                 { return "begin_unbonding" }
 func (msg MsgUndelegate) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.DelegatorAddr} }
 
-// get the bytes for the message signer to sign on
+// get the bytes for the message signer to sign on. The amount and max are
+// omitted when they are not set, so that the messages unbonding shares keep
+// the sign bytes they had before they were added.
 func (msg MsgUndelegate) GetSignBytes() []byte {
+	sharesAmount := sdk.ZeroDec()
+	if !msg.SharesAmount.IsNil() {
+		sharesAmount = msg.SharesAmount
+	}
+	var amount *sdk.Coin
+	if (!msg.Amount.Amount.IsNil() && !msg.Amount.Amount.IsZero()) || msg.Amount.Denom != "" {
+		amount = &msg.Amount
+	}
+
 	b, err := MsgCdc.MarshalJSON(struct {
 		DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
 		ValidatorAddr sdk.ValAddress `json:"validator_addr"`
 		SharesAmount  string         `json:"shares_amount"`
+		Amount        *sdk.Coin      `json:"amount,omitempty"`
+		Max           bool           `json:"max,omitempty"`
 	}{
 		DelegatorAddr: msg.DelegatorAddr,
 		ValidatorAddr: msg.ValidatorAddr,
-		SharesAmount:  msg.SharesAmount.String(),
+		SharesAmount:  sharesAmount.String(),
+		Amount:        amount,
+		Max:           msg.Max,
 	})
 	if err != nil {
 		panic(err)
@@ -320,8 +364,83 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	if msg.ValidatorAddr == nil {
 		return ErrNilValidatorAddr(DefaultCodespace)
 	}
-	if msg.SharesAmount.LTE(sdk.ZeroDec()) {
+
+	hasShares := !msg.SharesAmount.IsNil() && !msg.SharesAmount.IsZero()
+	hasAmount := !msg.Amount.Amount.IsNil() && !msg.Amount.Amount.IsZero()
+	switch {
+	case msg.Max && (hasShares || hasAmount), hasShares && hasAmount:
+		return ErrMultipleUnbondAmounts(DefaultCodespace)
+	case msg.Max:
+		return nil
+	case hasAmount:
+		if msg.Amount.Denom == "" {
+			return ErrBadDenom(DefaultCodespace)
+		}
+		if !msg.Amount.IsPositive() {
+			return ErrBadDelegationAmount(DefaultCodespace)
+		}
+		return nil
+	case hasShares:
+		if !msg.SharesAmount.IsPositive() {
+			return ErrBadSharesAmount(DefaultCodespace)
+		}
+		return nil
+	default:
 		return ErrBadSharesAmount(DefaultCodespace)
 	}
+}
+
+//______________________________________________________________________
+
+// MsgCancelUnbondingDelegation - struct for cancelling (part of) an immature
+// unbonding delegation entry and bonding its tokens back to the validator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddr  sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr  sdk.ValAddress `json:"validator_addr"`
+	Amount         sdk.Coin       `json:"amount"`
+	CreationHeight int64          `json:"creation_height"`
+}
+
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Coin, creationHeight int64) MsgCancelUnbondingDelegation {
+
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddr:  delAddr,
+		ValidatorAddr:  valAddr,
+		Amount:         amount,
+		CreationHeight: creationHeight,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+func (msg MsgCancelUnbondingDelegation) Type() string  { return "cancel_unbonding_delegation" }
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	b, err := MsgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	if msg.CreationHeight < 0 {
+		return ErrBadCreationHeight(DefaultCodespace)
+	}
 	return nil
 }
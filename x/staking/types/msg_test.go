@@ -197,3 +197,74 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		amount         sdk.Coin
+		creationHeight int64
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(addr1), addr2, sdk.NewInt64Coin("steak", 1), 10, true},
+		{"zero amount", sdk.AccAddress(addr1), addr2, sdk.NewInt64Coin("steak", 0), 10, false},
+		{"negative height", sdk.AccAddress(addr1), addr2, sdk.NewInt64Coin("steak", 1), -1, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), addr1, sdk.NewInt64Coin("steak", 1), 10, false},
+		{"empty validator", sdk.AccAddress(addr1), emptyAddr, sdk.NewInt64Coin("steak", 1), 10, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.creationHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgUndelegateAmountAndMax(t *testing.T) {
+	coin := sdk.NewInt64Coin("steak", 10)
+	tests := []struct {
+		name       string
+		msg        MsgUndelegate
+		expectPass bool
+	}{
+		{"amount", NewMsgUndelegateAmount(sdk.AccAddress(addr1), addr2, coin), true},
+		{"zero amount", NewMsgUndelegateAmount(sdk.AccAddress(addr1), addr2, sdk.NewInt64Coin("steak", 0)), false},
+		{"amount without denom", NewMsgUndelegateAmount(sdk.AccAddress(addr1), addr2, sdk.Coin{Amount: sdk.NewInt(10)}), false},
+		{"max", NewMsgUndelegateMax(sdk.AccAddress(addr1), addr2), true},
+		{"max and amount", MsgUndelegate{sdk.AccAddress(addr1), addr2, sdk.ZeroDec(), coin, true}, false},
+		{"max and shares", MsgUndelegate{sdk.AccAddress(addr1), addr2, sdk.OneDec(), sdk.Coin{}, true}, false},
+		{"amount and shares", MsgUndelegate{sdk.AccAddress(addr1), addr2, sdk.OneDec(), coin, false}, false},
+		{"nothing", MsgUndelegate{DelegatorAddr: sdk.AccAddress(addr1), ValidatorAddr: addr2}, false},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.Nil(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, tc.msg.ValidateBasic(), "test: %v", tc.name)
+		}
+		require.NotPanics(t, func() { tc.msg.GetSignBytes() }, "test: %v", tc.name)
+	}
+}
+
+func TestMsgUndelegateSignBytes(t *testing.T) {
+	// the messages unbonding shares keep the sign bytes they had before the
+	// amount and max were added
+	msg := NewMsgUndelegate(sdk.AccAddress(addr1), addr2, sdk.NewDecWithPrec(1, 1))
+	legacy, err := MsgCdc.MarshalJSON(struct {
+		DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+		ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+		SharesAmount  string         `json:"shares_amount"`
+	}{msg.DelegatorAddr, msg.ValidatorAddr, msg.SharesAmount.String()})
+	require.NoError(t, err)
+	require.Equal(t, string(sdk.MustSortJSON(legacy)), string(msg.GetSignBytes()))
+
+	// while the other messages sign their amount or max
+	coin := sdk.NewInt64Coin("steak", 10)
+	require.Contains(t, string(NewMsgUndelegateAmount(sdk.AccAddress(addr1), addr2, coin).GetSignBytes()), `"amount":{"amount":"10","denom":"steak"}`)
+	require.Contains(t, string(NewMsgUndelegateMax(sdk.AccAddress(addr1), addr2).GetSignBytes()), `"max":true`)
+}
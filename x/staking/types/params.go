@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -24,11 +25,16 @@ const (
 	DefaultBondDenom = "stake"
 )
 
+// DefaultPowerReduction is the default amount of staking tokens required for
+// one unit of consensus power. A value of one maps tokens to power one-to-one.
+var DefaultPowerReduction = sdk.OneInt()
+
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime  = []byte("UnbondingTime")
+	KeyMaxValidators  = []byte("MaxValidators")
+	KeyBondDenom      = []byte("BondDenom")
+	KeyPowerReduction = []byte("PowerReduction")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -37,8 +43,9 @@ var _ params.ParamSet = (*Params)(nil)
 type Params struct {
 	UnbondingTime time.Duration `json:"unbonding_time"`
 
-	MaxValidators uint16 `json:"max_validators"` // maximum number of validators
-	BondDenom     string `json:"bond_denom"`     // bondable coin denomination
+	MaxValidators  uint16  `json:"max_validators"`  // maximum number of validators
+	BondDenom      string  `json:"bond_denom"`      // bondable coin denomination
+	PowerReduction sdk.Int `json:"power_reduction"` // amount of bonded tokens per unit of consensus power
 }
 
 // Implements params.ParamSet
@@ -47,6 +54,7 @@ func (p *Params) KeyValuePairs() params.KeyValuePairs {
 		{KeyUnbondingTime, &p.UnbondingTime},
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyPowerReduction, &p.PowerReduction},
 	}
 }
 
@@ -60,10 +68,62 @@ func (p Params) Equal(p2 Params) bool {
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		UnbondingTime: defaultUnbondingTime,
-		MaxValidators: 100,
-		BondDenom:     DefaultBondDenom,
+		UnbondingTime:  defaultUnbondingTime,
+		MaxValidators:  100,
+		BondDenom:      DefaultBondDenom,
+		PowerReduction: DefaultPowerReduction,
+	}
+}
+
+// Validate the staking parameters, checking the same constraints as
+// parameter change proposals
+func (p Params) Validate() error {
+	if err := ValidateUnbondingTime(p.UnbondingTime); err != nil {
+		return err
+	}
+	if err := ValidateMaxValidators(p.MaxValidators); err != nil {
+		return err
+	}
+	if err := ValidateBondDenom(p.BondDenom); err != nil {
+		return err
+	}
+	return ValidatePowerReduction(p.PowerReduction)
+}
+
+// ValidateUnbondingTime validates the UnbondingTime parameter
+func ValidateUnbondingTime(i interface{}) error {
+	unbondingTime := i.(time.Duration)
+	if unbondingTime < 0 {
+		return fmt.Errorf("staking parameter UnbondingTime can't be negative, is %s", unbondingTime)
+	}
+	return nil
+}
+
+// ValidateMaxValidators validates the MaxValidators parameter
+func ValidateMaxValidators(i interface{}) error {
+	maxValidators := i.(uint16)
+	if maxValidators == 0 {
+		return fmt.Errorf("staking parameter MaxValidators must be positive")
+	}
+	return nil
+}
+
+// ValidateBondDenom validates the BondDenom parameter
+func ValidateBondDenom(i interface{}) error {
+	bondDenom := i.(string)
+	if bondDenom == "" {
+		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
 	}
+	return nil
+}
+
+// ValidatePowerReduction validates the PowerReduction parameter
+func ValidatePowerReduction(i interface{}) error {
+	powerReduction := i.(sdk.Int)
+	if powerReduction.IsNil() || !powerReduction.IsPositive() {
+		return fmt.Errorf("staking parameter PowerReduction must be positive, is %s", powerReduction)
+	}
+	return nil
 }
 
 // HumanReadableString returns a human readable string representation of the
@@ -74,9 +134,22 @@ func (p Params) HumanReadableString() string {
 	resp += fmt.Sprintf("Unbonding Time: %s\n", p.UnbondingTime)
 	resp += fmt.Sprintf("Max Validators: %d\n", p.MaxValidators)
 	resp += fmt.Sprintf("Bonded Coin Denomination: %s\n", p.BondDenom)
+	resp += fmt.Sprintf("Power Reduction: %s\n", p.PowerReduction)
 	return resp
 }
 
+// TokensToConsensusPower converts an amount of bonded tokens to the consensus
+// power reported to Tendermint, truncating any remainder.
+func TokensToConsensusPower(tokens, powerReduction sdk.Int) int64 {
+	return tokens.Div(powerReduction).Int64()
+}
+
+// TokensFromConsensusPower converts a consensus power to the corresponding
+// amount of bonded tokens.
+func TokensFromConsensusPower(power int64, powerReduction sdk.Int) sdk.Int {
+	return sdk.NewInt(power).Mul(powerReduction)
+}
+
 // unmarshal the current staking params value from store key or panic
 func MustUnmarshalParams(cdc *codec.Codec, value []byte) Params {
 	params, err := UnmarshalParams(cdc, value)
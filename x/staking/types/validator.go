@@ -250,12 +250,18 @@ func (d Description) EnsureLength() (Description, sdk.Error) {
 	return d, nil
 }
 
+// ConsensusPower returns the bonded tokens of the validator converted to the
+// consensus power reported to Tendermint
+func (v Validator) ConsensusPower(powerReduction sdk.Int) int64 {
+	return TokensToConsensusPower(v.BondedTokens(), powerReduction)
+}
+
 // ABCIValidatorUpdate returns an abci.ValidatorUpdate from a staked validator type
-// with the full validator power
-func (v Validator) ABCIValidatorUpdate() abci.ValidatorUpdate {
+// with the full validator consensus power
+func (v Validator) ABCIValidatorUpdate(powerReduction sdk.Int) abci.ValidatorUpdate {
 	return abci.ValidatorUpdate{
 		PubKey: tmtypes.TM2PB.PubKey(v.ConsPubKey),
-		Power:  v.BondedTokens().Int64(),
+		Power:  v.ConsensusPower(powerReduction),
 	}
 }
 
@@ -391,6 +397,18 @@ func (v Validator) DelegatorShareExRate() sdk.Dec {
 	return sdk.NewDecFromInt(v.Tokens).Quo(v.DelegatorShares)
 }
 
+// SharesFromTokens returns the (truncated) delegator shares worth the given
+// amount of tokens. It fails if the validator holds no tokens.
+func (v Validator) SharesFromTokens(amt sdk.Int) (sdk.Dec, sdk.Error) {
+	if v.Tokens.IsZero() {
+		return sdk.ZeroDec(), ErrInsufficientShares(DefaultCodespace)
+	}
+	if v.DelegatorShares.IsZero() {
+		return sdk.NewDecFromInt(amt), nil
+	}
+	return v.DelegatorShares.MulInt(amt).QuoInt(v.Tokens), nil
+}
+
 // Get the bonded tokens which the validator holds
 func (v Validator) BondedTokens() sdk.Int {
 	if v.Status == sdk.Bonded {
@@ -399,6 +417,12 @@ func (v Validator) BondedTokens() sdk.Int {
 	return sdk.ZeroInt()
 }
 
+// ValidatorQueueEntry - the unbonding validators maturing at the same time
+type ValidatorQueueEntry struct {
+	CompletionTime time.Time        `json:"completion_time"`
+	ValidatorAddrs []sdk.ValAddress `json:"validator_addrs"`
+}
+
 //______________________________________________________________________
 
 // ensure fulfills the sdk validator types
@@ -0,0 +1,42 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker halts the chain at the height of a scheduled upgrade, unless
+// the running binary registered a handler for it. In that case the binary is
+// the upgraded one, so the handler is run and the plan is cleared.
+//
+// NOTE: This must be the first BeginBlocker of the application, so that no
+// state is modified by the old binary at the upgrade height.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	logger := ctx.ModuleLogger("x/upgrade")
+	handler, ok := k.upgradeHandlers[plan.Name]
+
+	if ctx.BlockHeight() < plan.Height {
+		// the upgraded binary must not be started before the upgrade height
+		if ok {
+			panic(fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain", plan.Name))
+		}
+		return
+	}
+
+	if !ok {
+		msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		logger.Error(msg)
+		panic(msg)
+	}
+
+	logger.Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+	handler(ctx, plan)
+	k.setDone(ctx, plan.Name)
+	k.ClearUpgradePlan(ctx)
+}
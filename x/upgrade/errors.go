@@ -0,0 +1,22 @@
+//nolint
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = "UPGRADE"
+
+	CodeInvalidPlan sdk.CodeType = 1
+)
+
+func init() {
+	sdk.RegisterCode(DefaultCodespace, CodeInvalidPlan, "invalid upgrade plan")
+}
+
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
@@ -0,0 +1,108 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// StoreKey is the store key string for upgrade
+	StoreKey = "upgrade"
+)
+
+// Keys for upgrade store
+var (
+	PlanKey       = []byte{0x00} // key for the currently scheduled plan
+	DoneKeyPrefix = []byte{0x01} // prefix for the heights at which upgrades were applied
+)
+
+// GetDoneKey gets the key storing the height at which the named upgrade was applied
+func GetDoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
+
+// UpgradeHandler migrates the state of the application to the upgraded
+// binary. It is run once, in the BeginBlock of the upgrade height.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]UpgradeHandler
+	codespace       sdk.CodespaceType
+}
+
+// NewKeeper creates a new upgrade Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        key,
+		cdc:             cdc,
+		upgradeHandlers: make(map[string]UpgradeHandler),
+		codespace:       codespace,
+	}
+}
+
+// SetUpgradeHandler registers the migration run when the named upgrade is
+// reached. It must be called by the upgraded binary before the chain
+// restarts at the upgrade height.
+func (k Keeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	k.upgradeHandlers[name] = handler
+}
+
+// ScheduleUpgrade schedules an upgrade, replacing any previously scheduled plan
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return ErrInvalidPlan(k.codespace, err.Error())
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidPlan(k.codespace, fmt.Sprintf(
+			"upgrade height %d must be in the future, current height is %d", plan.Height, ctx.BlockHeight()))
+	}
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return ErrInvalidPlan(k.codespace, fmt.Sprintf("upgrade %s has already been applied", plan.Name))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PlanKey, k.cdc.MustMarshalBinaryLengthPrefixed(plan))
+	return nil
+}
+
+// GetUpgradePlan returns the currently scheduled plan, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan removes the currently scheduled plan, if any
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PlanKey)
+}
+
+// GetDoneHeight returns the height at which the named upgrade was applied,
+// or 0 if it was never applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDoneKey(name))
+	if bz == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// record the height at which the named upgrade was applied
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	store.Set(GetDoneKey(name), bz)
+}
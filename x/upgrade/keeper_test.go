@@ -0,0 +1,96 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	return ctx, NewKeeper(codec.New(), key, DefaultCodespace)
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	tests := []struct {
+		plan       Plan
+		expectPass bool
+	}{
+		{NewPlan("", 20, ""), false},
+		{NewPlan("v2", 0, ""), false},
+		{NewPlan("v2", 10, ""), false},
+		{NewPlan("v2", 20, "info"), true},
+		{NewPlan("v3", 30, ""), true},
+	}
+
+	for i, tc := range tests {
+		err := keeper.ScheduleUpgrade(ctx, tc.plan)
+		if !tc.expectPass {
+			require.NotNil(t, err, "tc #%d", i)
+			continue
+		}
+		require.Nil(t, err, "tc #%d", i)
+
+		// a new plan replaces the scheduled one
+		plan, found := keeper.GetUpgradePlan(ctx)
+		require.True(t, found, "tc #%d", i)
+		require.Equal(t, tc.plan, plan, "tc #%d", i)
+	}
+
+	keeper.ClearUpgradePlan(ctx)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	plan := NewPlan("v2", 20, "info")
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, plan))
+
+	// nothing happens before the upgrade height
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	// the old binary halts at the upgrade height
+	ctx = ctx.WithBlockHeight(20)
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+
+	// the upgraded binary must not run before the upgrade height
+	var applied []Plan
+	keeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan Plan) {
+		applied = append(applied, plan)
+	})
+	require.Panics(t, func() { BeginBlocker(ctx.WithBlockHeight(19), keeper) })
+
+	// the upgraded binary runs the migration once and clears the plan
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+	require.Equal(t, []Plan{plan}, applied)
+	require.Equal(t, int64(20), keeper.GetDoneHeight(ctx, "v2"))
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	require.NotPanics(t, func() { BeginBlocker(ctx.WithBlockHeight(21), keeper) })
+	require.Equal(t, 1, len(applied))
+
+	// an applied upgrade cannot be scheduled again
+	require.NotNil(t, keeper.ScheduleUpgrade(ctx, NewPlan("v2", 30, "")))
+}
@@ -0,0 +1,49 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Plan specifies information about a planned software upgrade and when it
+// should happen
+type Plan struct {
+	// Name of the upgrade. A handler registered under this name is run at the
+	// upgrade height by the upgraded binary.
+	Name string `json:"name"`
+
+	// Height of the block at which the chain halts to apply the upgrade
+	Height int64 `json:"height"`
+
+	// Any application specific upgrade info, e.g. the git commit or the
+	// location of the upgraded binary
+	Info string `json:"info"`
+}
+
+// NewPlan creates a new Plan instance
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic performs a stateless validity check of the plan
+func (p Plan) ValidateBasic() error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return fmt.Errorf("upgrade plan name cannot be blank")
+	}
+	if p.Height <= 0 {
+		return fmt.Errorf("upgrade plan height must be positive, got %d", p.Height)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
@@ -0,0 +1,19 @@
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeStoreLoader returns a baseapp.StoreLoader applying the given store
+// upgrades when the upgraded binary restarts the chain at the height of the
+// upgrade plan, the old binary having halted before committing it. Otherwise
+// the latest version is loaded unchanged.
+func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *sdk.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if upgradeHeight == ms.LastCommitID().Version+1 {
+			return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+		}
+		return baseapp.DefaultStoreLoader(ms)
+	}
+}
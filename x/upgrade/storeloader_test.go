@@ -0,0 +1,45 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	db := dbm.NewMemDB()
+	oldKey, newKey := sdk.NewKVStoreKey("old"), sdk.NewKVStoreKey("new")
+
+	// commit two blocks with the old store
+	var ms sdk.CommitMultiStore = store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(oldKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms.Commit()
+
+	upgrades := &sdk.StoreUpgrades{Added: []string{"new"}, Deleted: []string{"old"}}
+	newUpgradedStore := func() sdk.CommitMultiStore {
+		ms := store.NewCommitMultiStore(db)
+		ms.MountStoreWithDB(newKey, sdk.StoreTypeIAVL, nil)
+		return ms
+	}
+
+	// the upgrades are not applied before the upgrade height
+	require.Panics(t, func() { _ = UpgradeStoreLoader(4, upgrades)(newUpgradedStore()) })
+
+	// they are applied when restarting at the upgrade height
+	ms = newUpgradedStore()
+	require.NoError(t, UpgradeStoreLoader(3, upgrades)(ms))
+	require.Equal(t, int64(2), ms.LastCommitID().Version)
+	ms.Commit()
+
+	// and are not applied anymore after it
+	ms = newUpgradedStore()
+	require.NoError(t, UpgradeStoreLoader(3, upgrades)(ms))
+	require.Equal(t, int64(3), ms.LastCommitID().Version)
+}